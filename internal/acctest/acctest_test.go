@@ -1,12 +1,14 @@
 package acctest
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,6 +70,82 @@ func TestReverseDNS(t *testing.T) {
 	}
 }
 
+func TestPreCheckSkipError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		// Missing endpoints, GovCloud endpoints that aren't enabled and API calls unsupported in the region.
+		{name: "GovCloud access denied", err: awserr.New("AccessDeniedException", "", nil), expected: true},
+		{name: "missing endpoint", err: awserr.New("RequestError", "send request failed", nil), expected: true},
+		{name: "unknown operation", err: awserr.New("UnknownOperationException", "", nil), expected: true},
+		{name: "unsupported operation", err: awserr.New("UnsupportedOperation", "", nil), expected: true},
+		{name: "invalid input unknown operation", err: awserr.New("InvalidInputException", "Unknown operation", nil), expected: true},
+		{name: "invalid action", err: awserr.New("InvalidAction", "The action DescribeTransitGatewayAttachments is not valid for this web service", nil), expected: true},
+		{name: "unavailable operation", err: awserr.New("InvalidAction", "Unavailable Operation", nil), expected: true},
+		{name: "not supported in this region", err: awserr.New("InvalidAction", "Operation (ListPlatformApplications) is not supported in this region", nil), expected: true},
+
+		// Conditions that were not skipped.
+		{name: "other error", err: errors.New("test"), expected: false},
+		{name: "access denied", err: awserr.New("AccessDenied", "", nil), expected: false},
+		{name: "unauthorized operation", err: awserr.New("UnauthorizedOperation", "", nil), expected: false},
+		{name: "invalid action other message", err: awserr.New("InvalidAction", "Missing required parameter", nil), expected: false},
+		{name: "rds global databases", err: awserr.New("InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases", nil), expected: false},
+		{name: "apigateway vpc link", err: awserr.New("BadRequestException", "vpc link not supported for region us-gov-west-1", nil), expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := PreCheckSkipError(testCase.err); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestErrorCheckSkip(t *testing.T) {
+	testCases := []struct {
+		name        string
+		err         error
+		endpointIDs []string
+		expected    bool
+	}{
+		// The SDK testing framework's error strings, which name the code anywhere in the string, of API calls unsupported in the region.
+		{name: "not supported", err: errors.New("error creating: ValidationException: Feature is not supported in this region"), expected: true},
+		{name: "currently not supported", err: errors.New("error creating: BadRequest: Feature is currently not supported"), expected: true},
+		{name: "invalid action", err: errors.New("error creating: InvalidAction: Missing required parameter"), expected: true},
+		{name: "unknown operation", err: errors.New("error creating: InvalidInputException: Unknown operation"), expected: true},
+		{name: "unknown operation exception", err: errors.New("error creating: UnknownOperationException: test"), expected: true},
+		{name: "unsupported operation", err: errors.New("error creating: UnsupportedOperation: test"), expected: true},
+
+		// Conditions that were not skipped.
+		{name: "other error", err: errors.New("error creating: ValidationException: test"), expected: false},
+		{name: "missing endpoint", err: errors.New("error creating: RequestError: send request failed\ncaused by: dial tcp: lookup example.amazonaws.com: no such host"), expected: false},
+		{name: "access denied", err: errors.New("error creating: AccessDeniedException: test"), expected: false},
+		{name: "service specific other service", err: errors.New("error creating: InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases"), endpointIDs: []string{"ec2"}, expected: false},
+
+		// Service specific conditions are only skipped for the test's services.
+		{name: "service specific", err: errors.New("error creating: InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases"), endpointIDs: []string{"rds"}, expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			returned := false
+
+			// ErrorCheck skips the test running it, so run it in a subtest.
+			t.Run("ErrorCheck", func(t *testing.T) {
+				ErrorCheck(t, testCase.endpointIDs...)(testCase.err)
+				returned = true
+			})
+
+			if skipped := !returned; skipped != testCase.expected {
+				t.Errorf("got skipped %t, expected %t", skipped, testCase.expected)
+			}
+		})
+	}
+}
+
 func TestAccAcctestProvider_DefaultTags_emptyBlock(t *testing.T) {
 	var providers []*schema.Provider
 
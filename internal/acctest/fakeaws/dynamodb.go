@@ -0,0 +1,427 @@
+package fakeaws
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+)
+
+type dynamoDBTable struct {
+	Description map[string]interface{}
+	Items       map[string]map[string]interface{} // Keyed by the JSON encoding of the item's key.
+	KeySchema   []string
+	Tags        map[string]string
+}
+
+type dynamoDBState struct {
+	tables map[string]*dynamoDBTable // Keyed by name.
+}
+
+func (s *Server) dynamoDBOperations() map[string]operation {
+	s.dynamodb = &dynamoDBState{
+		tables: make(map[string]*dynamoDBTable),
+	}
+
+	return map[string]operation{
+		"CreateTable":               s.dynamoDBCreateTable,
+		"DeleteItem":                s.dynamoDBDeleteItem,
+		"DeleteTable":               s.dynamoDBDeleteTable,
+		"DescribeContinuousBackups": s.dynamoDBDescribeContinuousBackups,
+		"DescribeTable":             s.dynamoDBDescribeTable,
+		"DescribeTimeToLive":        s.dynamoDBDescribeTimeToLive,
+		"GetItem":                   s.dynamoDBGetItem,
+		"ListTables":                s.dynamoDBListTables,
+		"ListTagsOfResource":        s.dynamoDBListTagsOfResource,
+		"PutItem":                   s.dynamoDBPutItem,
+		"TagResource":               s.dynamoDBTagResource,
+		"UntagResource":             s.dynamoDBUntagResource,
+	}
+}
+
+type dynamoDBTag struct {
+	Key   string
+	Value string
+}
+
+func (s *Server) dynamoDBTable(name string) (*dynamoDBTable, error) {
+	table, ok := s.dynamodb.tables[name]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "ResourceNotFoundException", "Requested resource not found: Table: %s not found", name)
+	}
+
+	return table, nil
+}
+
+func (s *Server) dynamoDBTableByARN(arn string) (*dynamoDBTable, error) {
+	for _, table := range s.dynamodb.tables {
+		if table.Description["TableArn"] == arn {
+			return table, nil
+		}
+	}
+
+	return nil, newError(http.StatusBadRequest, "ResourceNotFoundException", "Requested resource not found: ResourcArn: %s not found", arn)
+}
+
+// itemKey returns the key of an item, or an error if the item does not contain every key attribute.
+func (table *dynamoDBTable) itemKey(item map[string]interface{}) (string, error) {
+	key := make(map[string]interface{}, len(table.KeySchema))
+
+	for _, name := range table.KeySchema {
+		v, ok := item[name]
+
+		if !ok {
+			return "", newError(http.StatusBadRequest, "ValidationException", "One of the required keys was not given a value")
+		}
+
+		key[name] = v
+	}
+
+	b, err := json.Marshal(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func (s *Server) dynamoDBCreateTable(r *request) (interface{}, error) {
+	var input map[string]interface{}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	name, _ := input["TableName"].(string)
+
+	if _, ok := s.dynamodb.tables[name]; ok {
+		return nil, newError(http.StatusBadRequest, "ResourceInUseException", "Table already exists: %s", name)
+	}
+
+	table := &dynamoDBTable{
+		Description: make(map[string]interface{}),
+		Items:       make(map[string]map[string]interface{}),
+		Tags:        make(map[string]string),
+	}
+
+	// The table description echoes the table's configuration.
+	for k, v := range input {
+		switch k {
+		case "BillingMode", "Tags":
+		default:
+			table.Description[k] = v
+		}
+	}
+
+	keySchema, _ := input["KeySchema"].([]interface{})
+
+	for _, v := range keySchema {
+		if element, ok := v.(map[string]interface{}); ok {
+			attributeName, _ := element["AttributeName"].(string)
+			table.KeySchema = append(table.KeySchema, attributeName)
+		}
+	}
+
+	billingMode, _ := input["BillingMode"].(string)
+
+	if billingMode == "" {
+		billingMode = "PROVISIONED"
+	}
+
+	provisionedThroughput, _ := input["ProvisionedThroughput"].(map[string]interface{})
+
+	if provisionedThroughput == nil {
+		provisionedThroughput = map[string]interface{}{}
+	}
+
+	provisionedThroughput["NumberOfDecreasesToday"] = 0
+
+	for _, k := range []string{"ReadCapacityUnits", "WriteCapacityUnits"} {
+		if _, ok := provisionedThroughput[k]; !ok {
+			provisionedThroughput[k] = 0
+		}
+	}
+
+	table.Description["BillingModeSummary"] = map[string]interface{}{"BillingMode": billingMode}
+	table.Description["CreationDateTime"] = float64(time.Now().Unix())
+	table.Description["ItemCount"] = 0
+	table.Description["ProvisionedThroughput"] = provisionedThroughput
+	table.Description["TableArn"] = s.arn("dynamodb", r.Region, "table/"+name)
+	table.Description["TableId"] = name
+	table.Description["TableSizeBytes"] = 0
+	table.Description["TableStatus"] = "ACTIVE"
+
+	tags, _ := input["Tags"].([]interface{})
+
+	for _, v := range tags {
+		if t, ok := v.(map[string]interface{}); ok {
+			key, _ := t["Key"].(string)
+			value, _ := t["Value"].(string)
+			table.Tags[key] = value
+		}
+	}
+
+	s.dynamodb.tables[name] = table
+
+	return map[string]interface{}{"TableDescription": table.Description}, nil
+}
+
+func (s *Server) dynamoDBDeleteItem(r *request) (interface{}, error) {
+	var input struct {
+		Key       map[string]interface{}
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(table.Items, key)
+
+	return nil, nil
+}
+
+func (s *Server) dynamoDBDeleteTable(r *request) (interface{}, error) {
+	var input struct {
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.dynamodb.tables, input.TableName)
+
+	description := make(map[string]interface{}, len(table.Description))
+
+	for k, v := range table.Description {
+		description[k] = v
+	}
+
+	description["TableStatus"] = "DELETING"
+
+	return map[string]interface{}{"TableDescription": description}, nil
+}
+
+func (s *Server) dynamoDBDescribeContinuousBackups(r *request) (interface{}, error) {
+	var input struct {
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.dynamoDBTable(input.TableName); err != nil {
+		return nil, newError(http.StatusBadRequest, "TableNotFoundException", "Table not found: %s", input.TableName)
+	}
+
+	return map[string]interface{}{
+		"ContinuousBackupsDescription": map[string]interface{}{
+			"ContinuousBackupsStatus": "ENABLED",
+			"PointInTimeRecoveryDescription": map[string]interface{}{
+				"PointInTimeRecoveryStatus": "DISABLED",
+			},
+		},
+	}, nil
+}
+
+func (s *Server) dynamoDBDescribeTable(r *request) (interface{}, error) {
+	var input struct {
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.Description["ItemCount"] = len(table.Items)
+
+	return map[string]interface{}{"Table": table.Description}, nil
+}
+
+func (s *Server) dynamoDBDescribeTimeToLive(r *request) (interface{}, error) {
+	var input struct {
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.dynamoDBTable(input.TableName); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"TimeToLiveDescription": map[string]interface{}{
+			"TimeToLiveStatus": "DISABLED",
+		},
+	}, nil
+}
+
+func (s *Server) dynamoDBGetItem(r *request) (interface{}, error) {
+	var input struct {
+		Key       map[string]interface{}
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := table.Items[key]
+
+	if !ok {
+		return nil, nil
+	}
+
+	return map[string]interface{}{"Item": item}, nil
+}
+
+func (s *Server) dynamoDBListTables(r *request) (interface{}, error) {
+	names := []string{}
+
+	for name := range s.dynamodb.tables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return map[string]interface{}{"TableNames": names}, nil
+}
+
+func (s *Server) dynamoDBListTagsOfResource(r *request) (interface{}, error) {
+	var input struct {
+		ResourceArn string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTableByARN(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []dynamoDBTag{}
+
+	for _, k := range sortedKeys(table.Tags) {
+		tags = append(tags, dynamoDBTag{Key: k, Value: table.Tags[k]})
+	}
+
+	return map[string]interface{}{"Tags": tags}, nil
+}
+
+func (s *Server) dynamoDBPutItem(r *request) (interface{}, error) {
+	var input struct {
+		Item      map[string]interface{}
+		TableName string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTable(input.TableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := table.itemKey(input.Item)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table.Items[key] = input.Item
+
+	return nil, nil
+}
+
+func (s *Server) dynamoDBTagResource(r *request) (interface{}, error) {
+	var input struct {
+		ResourceArn string
+		Tags        []dynamoDBTag
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTableByARN(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range input.Tags {
+		table.Tags[t.Key] = t.Value
+	}
+
+	return nil, nil
+}
+
+func (s *Server) dynamoDBUntagResource(r *request) (interface{}, error) {
+	var input struct {
+		ResourceArn string
+		TagKeys     []string
+	}
+
+	if err := r.decode(&input); err != nil {
+		return nil, err
+	}
+
+	table, err := s.dynamoDBTableByARN(input.ResourceArn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range input.TagKeys {
+		delete(table.Tags, k)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,431 @@
+package fakeaws_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func newClient(t *testing.T) (*fakeaws.Server, *conns.AWSClient) {
+	t.Helper()
+
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := server.Config().Client()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return server, client.(*conns.AWSClient)
+}
+
+func TestServerConfig(t *testing.T) {
+	_, client := newClient(t)
+
+	if got, expected := client.AccountID, fakeaws.DefaultAccountID; got != expected {
+		t.Errorf("got account ID %q, expected %q", got, expected)
+	}
+
+	if got, expected := client.Region, fakeaws.DefaultRegion; got != expected {
+		t.Errorf("got region %q, expected %q", got, expected)
+	}
+}
+
+func TestServerProviderConfig(t *testing.T) {
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	config := server.ProviderConfig()
+
+	for _, v := range []string{
+		`access_key                  = "` + fakeaws.AccessKey + `"`,
+		`secret_key                  = "` + fakeaws.SecretKey + `"`,
+		`region                      = "` + fakeaws.DefaultRegion + `"`,
+	} {
+		if !strings.Contains(config, v) {
+			t.Errorf("expected provider configuration to contain %q, got:\n%s", v, config)
+		}
+	}
+
+	matches := regexp.MustCompile(`(?m)^\s+(\w+)\s+= "([^"]+)"$`).FindAllStringSubmatch(config[strings.Index(config, "endpoints {"):], -1)
+
+	if got, expected := len(matches), len(server.Services()); got != expected {
+		t.Fatalf("got %d endpoints, expected %d:\n%s", got, expected, config)
+	}
+
+	for _, match := range matches {
+		if _, err := conns.ServiceForHCLKey(match[1]); err != nil {
+			t.Errorf("endpoint %q: %s", match[1], err)
+		}
+
+		if got, expected := match[2], server.URL; got != expected {
+			t.Errorf("endpoint %q: got URL %q, expected %q", match[1], got, expected)
+		}
+	}
+}
+
+func TestServerDynamoDB(t *testing.T) {
+	_, client := newClient(t)
+	conn := client.DynamoDBConn()
+
+	output, err := conn.CreateTable(&dynamodb.CreateTableInput{
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{{AttributeName: aws.String("id"), AttributeType: aws.String(dynamodb.ScalarAttributeTypeS)}},
+		BillingMode:          aws.String(dynamodb.BillingModePayPerRequest),
+		KeySchema:            []*dynamodb.KeySchemaElement{{AttributeName: aws.String("id"), KeyType: aws.String(dynamodb.KeyTypeHash)}},
+		TableName:            aws.String("test"),
+		Tags:                 []*dynamodb.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	arn := aws.StringValue(output.TableDescription.TableArn)
+
+	if _, err := conn.PutItem(&dynamodb.PutItemInput{
+		Item:      map[string]*dynamodb.AttributeValue{"id": {S: aws.String("1")}, "v": {N: aws.String("42")}},
+		TableName: aws.String("test"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	item, err := conn.GetItem(&dynamodb.GetItemInput{
+		Key:       map[string]*dynamodb.AttributeValue{"id": {S: aws.String("1")}},
+		TableName: aws.String("test"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(item.Item["v"].N), "42"; got != expected {
+		t.Errorf("got item value %q, expected %q", got, expected)
+	}
+
+	tags, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{ResourceArn: aws.String(arn)})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(tags.Tags), 1; got != expected {
+		t.Errorf("got %d tags, expected %d", got, expected)
+	}
+
+	if _, err := conn.DeleteTable(&dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		t.Errorf("got error %v, expected %s", err, dynamodb.ErrCodeResourceNotFoundException)
+	}
+}
+
+func TestServerIAM(t *testing.T) {
+	_, client := newClient(t)
+	conn := client.IAMConn()
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(&iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 aws.String("test"),
+		Tags:                     []*iam.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output, err := conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(output.Role.Arn), "arn:aws:iam::123456789012:role/test"; got != expected {
+		t.Errorf("got ARN %q, expected %q", got, expected)
+	}
+
+	if got := aws.StringValue(output.Role.AssumeRolePolicyDocument); got == policy {
+		t.Errorf("expected URL-encoded policy, got %q", got)
+	}
+
+	if got, expected := len(output.Role.Tags), 1; got != expected {
+		t.Errorf("got %d tags, expected %d", got, expected)
+	}
+
+	if _, err := conn.DeleteRole(&iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetRole(&iam.GetRoleInput{RoleName: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		t.Errorf("got error %v, expected %s", err, iam.ErrCodeNoSuchEntityException)
+	}
+}
+
+func TestServerS3(t *testing.T) {
+	_, client := newClient(t)
+	conn := client.S3Conn()
+
+	if _, err := conn.CreateBucket(&s3.CreateBucketInput{
+		Bucket:                    aws.String("test"),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{LocationConstraint: aws.String(fakeaws.DefaultRegion)},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	location, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(location.LocationConstraint), fakeaws.DefaultRegion; got != expected {
+		t.Errorf("got location %q, expected %q", got, expected)
+	}
+
+	_, err = conn.GetBucketCors(&s3.GetBucketCorsInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NoSuchCORSConfiguration") {
+		t.Errorf("got error %v, expected NoSuchCORSConfiguration", err)
+	}
+
+	if _, err := conn.PutBucketVersioning(&s3.PutBucketVersioningInput{
+		Bucket:                  aws.String("test"),
+		VersioningConfiguration: &s3.VersioningConfiguration{Status: aws.String(s3.BucketVersioningStatusEnabled)},
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	versioning, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String("test")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(versioning.Status), s3.BucketVersioningStatusEnabled; got != expected {
+		t.Errorf("got versioning status %q, expected %q", got, expected)
+	}
+
+	if _, err := conn.PutObject(&s3.PutObjectInput{
+		Body:   strings.NewReader("content"),
+		Bucket: aws.String("test"),
+		Key:    aws.String("path/to/key"),
+	}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	object, err := conn.GetObject(&s3.GetObjectInput{Bucket: aws.String("test"), Key: aws.String("path/to/key")})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer object.Body.Close()
+
+	body, err := ioutil.ReadAll(object.Body)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := string(body), "content"; got != expected {
+		t.Errorf("got object body %q, expected %q", got, expected)
+	}
+
+	_, err = conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("got error %v, expected BucketNotEmpty", err)
+	}
+
+	if _, err := conn.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String("test"), Key: aws.String("path/to/key")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, err := conn.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("test")}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("test")})
+
+	if !tfawserr.ErrCodeEquals(err, "NotFound") {
+		t.Errorf("got error %v, expected NotFound", err)
+	}
+}
+
+func TestServerSNS(t *testing.T) {
+	_, client := newClient(t)
+	conn := client.SNSConn()
+
+	output, err := conn.CreateTopic(&sns.CreateTopicInput{
+		Attributes: map[string]*string{"DisplayName": aws.String("Test")},
+		Name:       aws.String("test"),
+		Tags:       []*sns.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	arn := output.TopicArn
+
+	if got, expected := aws.StringValue(arn), "arn:aws:sns:us-west-2:123456789012:test"; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got ARN %q, expected %q", got, expected)
+	}
+
+	attributes, err := conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: arn})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(attributes.Attributes["DisplayName"]), "Test"; got != expected {
+		t.Errorf("got display name %q, expected %q", got, expected)
+	}
+
+	if _, err := conn.UntagResource(&sns.UntagResourceInput{ResourceArn: arn, TagKeys: aws.StringSlice([]string{"k"})}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tags, err := conn.ListTagsForResource(&sns.ListTagsForResourceInput{ResourceArn: arn})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(tags.Tags), 0; got != expected {
+		t.Errorf("got %d tags, expected %d", got, expected)
+	}
+
+	if _, err := conn.DeleteTopic(&sns.DeleteTopicInput{TopicArn: arn}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(&sns.GetTopicAttributesInput{TopicArn: arn})
+
+	if !tfawserr.ErrCodeEquals(err, sns.ErrCodeNotFoundException) {
+		t.Errorf("got error %v, expected %s", err, sns.ErrCodeNotFoundException)
+	}
+}
+
+func TestServerSQS(t *testing.T) {
+	_, client := newClient(t)
+	conn := client.SQSConn()
+
+	output, err := conn.CreateQueue(&sqs.CreateQueueInput{
+		Attributes: map[string]*string{sqs.QueueAttributeNameVisibilityTimeout: aws.String("60")},
+		QueueName:  aws.String("test"),
+		Tags:       map[string]*string{"k": aws.String("v")},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	queueURL := output.QueueUrl
+
+	attributes, err := conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       queueURL,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(attributes.Attributes[sqs.QueueAttributeNameVisibilityTimeout]), "60"; got != expected {
+		t.Errorf("got visibility timeout %q, expected %q", got, expected)
+	}
+
+	if got, expected := aws.StringValue(attributes.Attributes[sqs.QueueAttributeNameQueueArn]), "arn:aws:sqs:us-west-2:123456789012:test"; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got queue ARN %q, expected %q", got, expected)
+	}
+
+	tags, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{QueueUrl: queueURL})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(tags.Tags["k"]), "v"; got != expected {
+		t.Errorf("got tag value %q, expected %q", got, expected)
+	}
+
+	if _, err := conn.DeleteQueue(&sqs.DeleteQueueInput{QueueUrl: queueURL}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameAll}),
+		QueueUrl:       queueURL,
+	})
+
+	if !tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		t.Errorf("got error %v, expected %s", err, sqs.ErrCodeQueueDoesNotExist)
+	}
+}
+
+func TestServerSetError(t *testing.T) {
+	server, client := newClient(t)
+	conn := client.SQSConn()
+
+	server.SetError("sqs", "CreateQueue", &fakeaws.Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       "InvalidAttributeName",
+		Message:    "test",
+	})
+
+	_, err := conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("test")})
+
+	if !tfawserr.ErrMessageContains(err, "InvalidAttributeName", "test") {
+		t.Errorf("got error %v, expected InvalidAttributeName", err)
+	}
+
+	server.SetError("sqs", "CreateQueue", nil)
+
+	if _, err := conn.CreateQueue(&sqs.CreateQueueInput{QueueName: aws.String("test")}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestServerHandleFunc(t *testing.T) {
+	server, client := newClient(t)
+	conn := client.SNSConn()
+
+	server.HandleFunc("sns", "ListTopics", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<ListTopicsResponse><ListTopicsResult><Topics><member><TopicArn>arn:aws:sns:us-west-2:123456789012:scripted</TopicArn></member></Topics></ListTopicsResult></ListTopicsResponse>`)) //nolint:errcheck // lintignore:AWSAT003,AWSAT005
+	})
+
+	output, err := conn.ListTopics(&sns.ListTopicsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(output.Topics), 1; got != expected {
+		t.Fatalf("got %d topics, expected %d", got, expected)
+	}
+
+	requests := server.Requests()
+
+	if got, expected := requests[len(requests)-1], "sns:ListTopics"; got != expected {
+		t.Errorf("got last request %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,280 @@
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type iamRole struct {
+	Arn                      string `xml:"Arn"`
+	AssumeRolePolicyDocument string `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string `xml:"CreateDate"`
+	Description              string `xml:"Description,omitempty"`
+	MaxSessionDuration       int    `xml:"MaxSessionDuration"`
+	Path                     string `xml:"Path"`
+	RoleID                   string `xml:"RoleId"`
+	RoleName                 string `xml:"RoleName"`
+	Tags                     []tag  `xml:"Tags>member,omitempty"`
+
+	tags map[string]string
+}
+
+type iamState struct {
+	roles  map[string]*iamRole // Keyed by name.
+	nextID int
+}
+
+func (s *Server) iamOperations() map[string]operation {
+	s.iam = &iamState{
+		roles: make(map[string]*iamRole),
+	}
+
+	return map[string]operation{
+		"CreateRole":                  s.iamCreateRole,
+		"DeleteRole":                  s.iamDeleteRole,
+		"GetRole":                     s.iamGetRole,
+		"ListAttachedRolePolicies":    s.iamListAttachedRolePolicies,
+		"ListInstanceProfilesForRole": s.iamListInstanceProfilesForRole,
+		"ListRolePolicies":            s.iamListRolePolicies,
+		"ListRoleTags":                s.iamListRoleTags,
+		"ListRoles":                   s.iamListRoles,
+		"TagRole":                     s.iamTagRole,
+		"UntagRole":                   s.iamUntagRole,
+		"UpdateAssumeRolePolicy":      s.iamUpdateAssumeRolePolicy,
+		"UpdateRole":                  s.iamUpdateRole,
+		"UpdateRoleDescription":       s.iamUpdateRoleDescription,
+	}
+}
+
+func (s *Server) iamRole(r *request) (*iamRole, error) {
+	name := r.Form.Get("RoleName")
+	role, ok := s.iam.roles[name]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NoSuchEntity", "The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+// output returns the role as returned by the IAM API, with its tags and URL-encoded assume role policy.
+func (role *iamRole) output() *iamRole {
+	output := *role
+	output.AssumeRolePolicyDocument = url.QueryEscape(role.AssumeRolePolicyDocument)
+	output.Tags = tagList(role.tags)
+
+	return &output
+}
+
+func (s *Server) iamCreateRole(r *request) (interface{}, error) {
+	name := r.Form.Get("RoleName")
+
+	if _, ok := s.iam.roles[name]; ok {
+		return nil, newError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	path := r.Form.Get("Path")
+
+	if path == "" {
+		path = "/"
+	}
+
+	maxSessionDuration := 3600
+
+	if v := r.Form.Get("MaxSessionDuration"); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "ValidationError", "Invalid MaxSessionDuration: %s", v)
+		}
+
+		maxSessionDuration = n
+	}
+
+	s.iam.nextID++
+
+	role := &iamRole{
+		Arn:                      s.arn("iam", "", "role"+path+name),
+		AssumeRolePolicyDocument: r.Form.Get("AssumeRolePolicyDocument"),
+		CreateDate:               time.Now().UTC().Format(time.RFC3339),
+		Description:              r.Form.Get("Description"),
+		MaxSessionDuration:       maxSessionDuration,
+		Path:                     path,
+		RoleID:                   fmt.Sprintf("AROA%016d", s.iam.nextID),
+		RoleName:                 name,
+		tags:                     formMap(r.Form, "Tags.member", "Key", "Value"),
+	}
+
+	s.iam.roles[name] = role
+
+	return struct {
+		Role *iamRole `xml:"Role"`
+	}{Role: role.output()}, nil
+}
+
+func (s *Server) iamDeleteRole(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.iam.roles, role.RoleName)
+
+	return nil, nil
+}
+
+func (s *Server) iamGetRole(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Role *iamRole `xml:"Role"`
+	}{Role: role.output()}, nil
+}
+
+func (s *Server) iamListAttachedRolePolicies(r *request) (interface{}, error) {
+	if _, err := s.iamRole(r); err != nil {
+		return nil, err
+	}
+
+	return struct {
+		AttachedPolicies string `xml:"AttachedPolicies"`
+		IsTruncated      bool   `xml:"IsTruncated"`
+	}{}, nil
+}
+
+func (s *Server) iamListInstanceProfilesForRole(r *request) (interface{}, error) {
+	if _, err := s.iamRole(r); err != nil {
+		return nil, err
+	}
+
+	return struct {
+		InstanceProfiles string `xml:"InstanceProfiles"`
+		IsTruncated      bool   `xml:"IsTruncated"`
+	}{}, nil
+}
+
+func (s *Server) iamListRolePolicies(r *request) (interface{}, error) {
+	if _, err := s.iamRole(r); err != nil {
+		return nil, err
+	}
+
+	return struct {
+		PolicyNames string `xml:"PolicyNames"`
+		IsTruncated bool   `xml:"IsTruncated"`
+	}{}, nil
+}
+
+func (s *Server) iamListRoleTags(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Tags        []tag `xml:"Tags>member"`
+		IsTruncated bool  `xml:"IsTruncated"`
+	}{Tags: tagList(role.tags)}, nil
+}
+
+func (s *Server) iamListRoles(r *request) (interface{}, error) {
+	var roles []*iamRole
+
+	for _, role := range s.iam.roles {
+		roles = append(roles, role.output())
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].RoleName < roles[j].RoleName })
+
+	return struct {
+		Roles       []*iamRole `xml:"Roles>member"`
+		IsTruncated bool       `xml:"IsTruncated"`
+	}{Roles: roles}, nil
+}
+
+func (s *Server) iamTagRole(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range formMap(r.Form, "Tags.member", "Key", "Value") {
+		role.tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamUntagRole(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range formList(r.Form, "TagKeys.member") {
+		delete(role.tags, k)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamUpdateAssumeRolePolicy(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.AssumeRolePolicyDocument = r.Form.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *Server) iamUpdateRole(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := r.Form["Description"]; ok {
+		role.Description = r.Form.Get("Description")
+	}
+
+	if v := r.Form.Get("MaxSessionDuration"); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "ValidationError", "Invalid MaxSessionDuration: %s", v)
+		}
+
+		role.MaxSessionDuration = n
+	}
+
+	return nil, nil
+}
+
+func (s *Server) iamUpdateRoleDescription(r *request) (interface{}, error) {
+	role, err := s.iamRole(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role.Description = r.Form.Get("Description")
+
+	return struct {
+		Role *iamRole `xml:"Role"`
+	}{Role: role.output()}, nil
+}
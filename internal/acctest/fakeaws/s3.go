@@ -0,0 +1,493 @@
+package fakeaws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type s3Object struct {
+	Body         []byte
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+}
+
+type s3Bucket struct {
+	CreationDate time.Time
+	Name         string
+	Objects      map[string]*s3Object // Keyed by key.
+	Region       string
+	Subresources map[string][]byte // Bucket configuration, keyed by subresource, as last put.
+}
+
+type s3State struct {
+	buckets map[string]*s3Bucket // Keyed by name.
+}
+
+// s3Subresource is a bucket configuration, e.g. `?versioning`, that is put, got and deleted as a whole.
+type s3Subresource struct {
+	// Operation is the operation name without its Get, Put or Delete prefix.
+	Operation string
+	// Default is the configuration of a new bucket. If nil, getting the configuration of a new bucket fails with NotFound.
+	Default  []byte
+	NotFound *Error
+}
+
+var s3Subresources = map[string]s3Subresource{
+	"accelerate": {
+		Operation: "BucketAccelerateConfiguration",
+		Default:   []byte(`<AccelerateConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></AccelerateConfiguration>`),
+	},
+	"acl": {
+		Operation: "BucketAcl",
+	},
+	"cors": {
+		Operation: "BucketCors",
+		NotFound:  newError(http.StatusNotFound, "NoSuchCORSConfiguration", "The CORS configuration does not exist"),
+	},
+	"encryption": {
+		Operation: "BucketEncryption",
+		NotFound:  newError(http.StatusNotFound, "ServerSideEncryptionConfigurationNotFoundError", "The server side encryption configuration was not found"),
+	},
+	"lifecycle": {
+		Operation: "BucketLifecycleConfiguration",
+		NotFound:  newError(http.StatusNotFound, "NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist"),
+	},
+	"logging": {
+		Operation: "BucketLogging",
+		Default:   []byte(`<BucketLoggingStatus xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></BucketLoggingStatus>`),
+	},
+	"object-lock": {
+		Operation: "ObjectLockConfiguration",
+		NotFound:  newError(http.StatusNotFound, "ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket"),
+	},
+	"policy": {
+		Operation: "BucketPolicy",
+		NotFound:  newError(http.StatusNotFound, "NoSuchBucketPolicy", "The bucket policy does not exist"),
+	},
+	"replication": {
+		Operation: "BucketReplication",
+		NotFound:  newError(http.StatusNotFound, "ReplicationConfigurationNotFoundError", "The replication configuration was not found"),
+	},
+	"requestPayment": {
+		Operation: "BucketRequestPayment",
+		Default:   []byte(`<RequestPaymentConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`),
+	},
+	"tagging": {
+		Operation: "BucketTagging",
+		NotFound:  newError(http.StatusNotFound, "NoSuchTagSet", "The TagSet does not exist"),
+	},
+	"versioning": {
+		Operation: "BucketVersioning",
+		Default:   []byte(`<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"></VersioningConfiguration>`),
+	},
+	"website": {
+		Operation: "BucketWebsite",
+		NotFound:  newError(http.StatusNotFound, "NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration"),
+	},
+}
+
+// s3Path returns the bucket and key of a path-style S3 request.
+func s3Path(r *http.Request) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// s3OperationName returns the name of the AWS Go SDK method that made a path-style S3 request.
+func s3OperationName(r *http.Request) string {
+	bucket, key := s3Path(r)
+	query := r.URL.Query()
+
+	var prefix string
+
+	switch r.Method {
+	case http.MethodDelete:
+		prefix = "Delete"
+	case http.MethodGet:
+		prefix = "Get"
+	case http.MethodHead:
+		prefix = "Head"
+	case http.MethodPut:
+		prefix = "Put"
+	default:
+		return r.Method
+	}
+
+	switch {
+	case bucket == "":
+		return "ListBuckets"
+	case key != "":
+		return prefix + "Object"
+	}
+
+	for subresource, v := range s3Subresources {
+		if _, ok := query[subresource]; ok {
+			return prefix + v.Operation
+		}
+	}
+
+	if _, ok := query["location"]; ok {
+		return "GetBucketLocation"
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if query.Get("list-type") == "2" {
+			return "ListObjectsV2"
+		}
+
+		return "ListObjects"
+	case http.MethodPut:
+		return "CreateBucket"
+	}
+
+	return prefix + "Bucket"
+}
+
+func (s *Server) s3Operations() map[string]operation {
+	s.s3 = &s3State{
+		buckets: make(map[string]*s3Bucket),
+	}
+
+	operations := map[string]operation{
+		"CreateBucket":      s.s3CreateBucket,
+		"DeleteBucket":      s.s3DeleteBucket,
+		"DeleteObject":      s.s3DeleteObject,
+		"GetBucketLocation": s.s3GetBucketLocation,
+		"GetObject":         s.s3GetObject,
+		"HeadBucket":        s.s3HeadBucket,
+		"HeadObject":        s.s3HeadObject,
+		"ListBuckets":       s.s3ListBuckets,
+		"ListObjects":       s.s3ListObjects,
+		"ListObjectsV2":     s.s3ListObjects,
+		"PutObject":         s.s3PutObject,
+	}
+
+	for subresource, v := range s3Subresources {
+		subresource := subresource
+
+		operations["Delete"+v.Operation] = func(r *request) (interface{}, error) {
+			return s.s3DeleteSubresource(r, subresource)
+		}
+		operations["Get"+v.Operation] = func(r *request) (interface{}, error) {
+			return s.s3GetSubresource(r, subresource)
+		}
+		operations["Put"+v.Operation] = func(r *request) (interface{}, error) {
+			return s.s3PutSubresource(r, subresource)
+		}
+	}
+
+	return operations
+}
+
+func (s *Server) s3Bucket(r *request) (*s3Bucket, error) {
+	name, _ := s3Path(r.Request)
+	bucket, ok := s.s3.buckets[name]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+	}
+
+	return bucket, nil
+}
+
+func (s *Server) s3Object(r *request) (*s3Object, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, key := s3Path(r.Request)
+	object, ok := bucket.Objects[key]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+	}
+
+	return object, nil
+}
+
+func (s *Server) s3CreateBucket(r *request) (interface{}, error) {
+	name, _ := s3Path(r.Request)
+
+	if bucket, ok := s.s3.buckets[name]; ok {
+		if bucket.Region == r.Region {
+			return nil, newError(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+		}
+
+		return nil, newError(http.StatusConflict, "BucketAlreadyExists", "The requested bucket name is not available.")
+	}
+
+	var configuration struct {
+		LocationConstraint string
+	}
+
+	if len(r.Body) > 0 {
+		if err := xml.Unmarshal(r.Body, &configuration); err != nil {
+			return nil, newError(http.StatusBadRequest, "MalformedXML", "%s", err)
+		}
+	}
+
+	region := configuration.LocationConstraint
+
+	if region == "" {
+		region = "us-east-1" //lintignore:AWSAT003
+	}
+
+	s.s3.buckets[name] = &s3Bucket{
+		CreationDate: time.Now().UTC(),
+		Name:         name,
+		Objects:      make(map[string]*s3Object),
+		Region:       region,
+		Subresources: make(map[string][]byte),
+	}
+
+	return &rawResponse{Header: http.Header{"Location": {"/" + name}}}, nil
+}
+
+func (s *Server) s3DeleteBucket(r *request) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bucket.Objects) > 0 {
+		return nil, newError(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	delete(s.s3.buckets, bucket.Name)
+
+	return &rawResponse{StatusCode: http.StatusNoContent}, nil
+}
+
+func (s *Server) s3DeleteObject(r *request) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, key := s3Path(r.Request)
+	delete(bucket.Objects, key)
+
+	return &rawResponse{StatusCode: http.StatusNoContent}, nil
+}
+
+func (s *Server) s3DeleteSubresource(r *request, subresource string) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(bucket.Subresources, subresource)
+
+	return &rawResponse{StatusCode: http.StatusNoContent}, nil
+}
+
+func (s *Server) s3GetBucketLocation(r *request) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	location := bucket.Region
+
+	if location == "us-east-1" { //lintignore:AWSAT003
+		location = ""
+	}
+
+	return struct {
+		XMLName            xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+		LocationConstraint string   `xml:",chardata"`
+	}{LocationConstraint: location}, nil
+}
+
+func (s *Server) s3GetObject(r *request) (interface{}, error) {
+	object, err := s.s3Object(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rawResponse{Body: object.Body, Header: object.header()}, nil
+}
+
+func (s *Server) s3GetSubresource(r *request, subresource string) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := bucket.Subresources[subresource]
+
+	if !ok {
+		v := s3Subresources[subresource]
+
+		switch {
+		case subresource == "acl":
+			body = []byte(`<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Owner><ID>` + s.AccountID + `</ID></Owner><AccessControlList><Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>` + s.AccountID + `</ID></Grantee><Permission>FULL_CONTROL</Permission></Grant></AccessControlList></AccessControlPolicy>`)
+		case v.Default != nil:
+			body = v.Default
+		default:
+			return nil, v.NotFound
+		}
+	}
+
+	contentType := "application/xml"
+
+	if subresource == "policy" {
+		contentType = "application/json"
+	}
+
+	return &rawResponse{Body: body, Header: http.Header{"Content-Type": {contentType}}}, nil
+}
+
+func (s *Server) s3HeadBucket(r *request) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rawResponse{Header: http.Header{"X-Amz-Bucket-Region": {bucket.Region}}}, nil
+}
+
+func (s *Server) s3HeadObject(r *request) (interface{}, error) {
+	object, err := s.s3Object(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rawResponse{Header: object.header()}, nil
+}
+
+func (s *Server) s3ListBuckets(r *request) (interface{}, error) {
+	type bucket struct {
+		CreationDate string `xml:"CreationDate"`
+		Name         string `xml:"Name"`
+	}
+
+	var buckets []bucket
+
+	for _, b := range s.s3.buckets {
+		buckets = append(buckets, bucket{CreationDate: b.CreationDate.Format(time.RFC3339), Name: b.Name})
+	}
+
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Name < buckets[j].Name })
+
+	return struct {
+		XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+		Buckets []bucket `xml:"Buckets>Bucket"`
+		OwnerID string   `xml:"Owner>ID"`
+	}{Buckets: buckets, OwnerID: s.AccountID}, nil
+}
+
+func (s *Server) s3ListObjects(r *request) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	type content struct {
+		ETag         string `xml:"ETag"`
+		Key          string `xml:"Key"`
+		LastModified string `xml:"LastModified"`
+		Size         int    `xml:"Size"`
+		StorageClass string `xml:"StorageClass"`
+	}
+
+	prefix := r.URL.Query().Get("prefix")
+
+	var contents []content
+
+	for key, object := range bucket.Objects {
+		if strings.HasPrefix(key, prefix) {
+			contents = append(contents, content{
+				ETag:         object.ETag,
+				Key:          key,
+				LastModified: object.LastModified.Format(time.RFC3339),
+				Size:         len(object.Body),
+				StorageClass: "STANDARD",
+			})
+		}
+	}
+
+	sort.Slice(contents, func(i, j int) bool { return contents[i].Key < contents[j].Key })
+
+	return struct {
+		XMLName     xml.Name  `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+		Contents    []content `xml:"Contents"`
+		IsTruncated bool      `xml:"IsTruncated"`
+		KeyCount    int       `xml:"KeyCount"`
+		Name        string    `xml:"Name"`
+		Prefix      string    `xml:"Prefix"`
+	}{Contents: contents, KeyCount: len(contents), Name: bucket.Name, Prefix: prefix}, nil
+}
+
+func (s *Server) s3PutObject(r *request) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, key := s3Path(r.Request)
+	sum := md5.Sum(r.Body)
+	object := &s3Object{
+		Body:         r.Body,
+		ContentType:  r.Header.Get("Content-Type"),
+		ETag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		LastModified: time.Now().UTC(),
+	}
+
+	bucket.Objects[key] = object
+
+	return &rawResponse{Header: http.Header{"Etag": {object.ETag}}}, nil
+}
+
+func (s *Server) s3PutSubresource(r *request, subresource string) (interface{}, error) {
+	bucket, err := s.s3Bucket(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Canned ACLs are set by header rather than body.
+	if len(r.Body) > 0 {
+		bucket.Subresources[subresource] = r.Body
+	}
+
+	return nil, nil
+}
+
+func (object *s3Object) header() http.Header {
+	header := http.Header{
+		"Content-Length": {strconv.Itoa(len(object.Body))},
+		"Etag":           {object.ETag},
+		"Last-Modified":  {object.LastModified.Format(http.TimeFormat)},
+	}
+
+	if object.ContentType != "" {
+		header.Set("Content-Type", object.ContentType)
+	}
+
+	return header
+}
@@ -0,0 +1,178 @@
+package fakeaws
+
+import (
+	"net/http"
+	"sort"
+)
+
+type snsTopic struct {
+	Arn        string
+	Attributes map[string]string
+	Tags       map[string]string
+}
+
+type snsState struct {
+	topics map[string]*snsTopic // Keyed by ARN.
+}
+
+func (s *Server) snsOperations() map[string]operation {
+	s.sns = &snsState{
+		topics: make(map[string]*snsTopic),
+	}
+
+	return map[string]operation{
+		"CreateTopic":         s.snsCreateTopic,
+		"DeleteTopic":         s.snsDeleteTopic,
+		"GetTopicAttributes":  s.snsGetTopicAttributes,
+		"ListTagsForResource": s.snsListTagsForResource,
+		"ListTopics":          s.snsListTopics,
+		"SetTopicAttributes":  s.snsSetTopicAttributes,
+		"TagResource":         s.snsTagResource,
+		"UntagResource":       s.snsUntagResource,
+	}
+}
+
+type snsAttribute struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func (s *Server) snsTopic(arn string) (*snsTopic, error) {
+	topic, ok := s.sns.topics[arn]
+
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (s *Server) snsCreateTopic(r *request) (interface{}, error) {
+	name := r.Form.Get("Name")
+
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Topic Name")
+	}
+
+	arn := s.arn("sns", r.Region, name)
+
+	if _, ok := s.sns.topics[arn]; !ok {
+		topic := &snsTopic{
+			Arn: arn,
+			Attributes: map[string]string{
+				"DisplayName":             "",
+				"Owner":                   s.AccountID,
+				"SubscriptionsConfirmed":  "0",
+				"SubscriptionsDeleted":    "0",
+				"SubscriptionsPending":    "0",
+				"TopicArn":                arn,
+				"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}`,
+			},
+			Tags: formMap(r.Form, "Tags.member", "Key", "Value"),
+		}
+
+		for k, v := range formMap(r.Form, "Attributes.entry", "key", "value") {
+			topic.Attributes[k] = v
+		}
+
+		s.sns.topics[arn] = topic
+	}
+
+	return struct {
+		TopicArn string `xml:"TopicArn"`
+	}{TopicArn: arn}, nil
+}
+
+func (s *Server) snsDeleteTopic(r *request) (interface{}, error) {
+	delete(s.sns.topics, r.Form.Get("TopicArn"))
+
+	return nil, nil
+}
+
+func (s *Server) snsGetTopicAttributes(r *request) (interface{}, error) {
+	topic, err := s.snsTopic(r.Form.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var attributes []snsAttribute
+
+	for _, k := range sortedKeys(topic.Attributes) {
+		attributes = append(attributes, snsAttribute{Key: k, Value: topic.Attributes[k]})
+	}
+
+	return struct {
+		Attributes []snsAttribute `xml:"Attributes>entry"`
+	}{Attributes: attributes}, nil
+}
+
+func (s *Server) snsListTagsForResource(r *request) (interface{}, error) {
+	topic, err := s.snsTopic(r.Form.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	return struct {
+		Tags []tag `xml:"Tags>member"`
+	}{Tags: tagList(topic.Tags)}, nil
+}
+
+func (s *Server) snsListTopics(r *request) (interface{}, error) {
+	type topic struct {
+		TopicArn string `xml:"TopicArn"`
+	}
+
+	var topics []topic
+
+	for arn := range s.sns.topics {
+		topics = append(topics, topic{TopicArn: arn})
+	}
+
+	sort.Slice(topics, func(i, j int) bool { return topics[i].TopicArn < topics[j].TopicArn })
+
+	return struct {
+		Topics []topic `xml:"Topics>member"`
+	}{Topics: topics}, nil
+}
+
+func (s *Server) snsSetTopicAttributes(r *request) (interface{}, error) {
+	topic, err := s.snsTopic(r.Form.Get("TopicArn"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	topic.Attributes[r.Form.Get("AttributeName")] = r.Form.Get("AttributeValue")
+
+	return nil, nil
+}
+
+func (s *Server) snsTagResource(r *request) (interface{}, error) {
+	topic, err := s.snsTopic(r.Form.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	for k, v := range formMap(r.Form, "Tags.member", "Key", "Value") {
+		topic.Tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) snsUntagResource(r *request) (interface{}, error) {
+	topic, err := s.snsTopic(r.Form.Get("ResourceArn"))
+
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	for _, k := range formList(r.Form, "TagKeys.member") {
+		delete(topic.Tags, k)
+	}
+
+	return nil, nil
+}
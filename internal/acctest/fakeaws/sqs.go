@@ -0,0 +1,224 @@
+package fakeaws
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type sqsQueue struct {
+	Attributes map[string]string
+	Name       string
+	Tags       map[string]string
+	URL        string
+}
+
+type sqsState struct {
+	queues map[string]*sqsQueue // Keyed by URL.
+}
+
+func (s *Server) sqsOperations() map[string]operation {
+	s.sqs = &sqsState{
+		queues: make(map[string]*sqsQueue),
+	}
+
+	return map[string]operation{
+		"CreateQueue":        s.sqsCreateQueue,
+		"DeleteQueue":        s.sqsDeleteQueue,
+		"GetQueueAttributes": s.sqsGetQueueAttributes,
+		"GetQueueUrl":        s.sqsGetQueueURL,
+		"ListQueueTags":      s.sqsListQueueTags,
+		"ListQueues":         s.sqsListQueues,
+		"SetQueueAttributes": s.sqsSetQueueAttributes,
+		"TagQueue":           s.sqsTagQueue,
+		"UntagQueue":         s.sqsUntagQueue,
+	}
+}
+
+type sqsAttribute struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+func (s *Server) sqsQueue(r *request) (*sqsQueue, error) {
+	queueURL := r.Form.Get("QueueUrl")
+	queue, ok := s.sqs.queues[queueURL]
+
+	if !ok {
+		return nil, newError(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	return queue, nil
+}
+
+func (s *Server) sqsCreateQueue(r *request) (interface{}, error) {
+	name := r.Form.Get("QueueName")
+
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "MissingParameter", "The request must contain the parameter QueueName.")
+	}
+
+	queueURL := s.URL + "/" + s.AccountID + "/" + name
+	attributes := formMap(r.Form, "Attribute", "Name", "Value")
+
+	if queue, ok := s.sqs.queues[queueURL]; ok {
+		for k, v := range attributes {
+			if queue.Attributes[k] != v {
+				return nil, newError(http.StatusBadRequest, "QueueAlreadyExists", "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+	} else {
+		queue := &sqsQueue{
+			Attributes: map[string]string{
+				"DelaySeconds":                  "0",
+				"MaximumMessageSize":            "262144",
+				"MessageRetentionPeriod":        "345600",
+				"QueueArn":                      s.arn("sqs", r.Region, name),
+				"ReceiveMessageWaitTimeSeconds": "0",
+				"VisibilityTimeout":             "30",
+			},
+			Name: name,
+			Tags: formMap(r.Form, "Tag", "Key", "Value"),
+			URL:  queueURL,
+		}
+
+		for k, v := range attributes {
+			queue.Attributes[k] = v
+		}
+
+		s.sqs.queues[queueURL] = queue
+	}
+
+	return struct {
+		QueueURL string `xml:"QueueUrl"`
+	}{QueueURL: queueURL}, nil
+}
+
+func (s *Server) sqsDeleteQueue(r *request) (interface{}, error) {
+	queue, err := s.sqsQueue(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.sqs.queues, queue.URL)
+
+	return nil, nil
+}
+
+func (s *Server) sqsGetQueueAttributes(r *request) (interface{}, error) {
+	queue, err := s.sqsQueue(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	names := formList(r.Form, "AttributeName")
+	all := false
+
+	for _, name := range names {
+		if name == "All" {
+			all = true
+		}
+	}
+
+	var attributes []sqsAttribute
+
+	for _, name := range sortedKeys(queue.Attributes) {
+		for _, n := range names {
+			if all || n == name {
+				attributes = append(attributes, sqsAttribute{Name: name, Value: queue.Attributes[name]})
+
+				break
+			}
+		}
+	}
+
+	return struct {
+		Attributes []sqsAttribute `xml:"Attribute"`
+	}{Attributes: attributes}, nil
+}
+
+func (s *Server) sqsGetQueueURL(r *request) (interface{}, error) {
+	queueURL := s.URL + "/" + s.AccountID + "/" + r.Form.Get("QueueName")
+
+	if _, ok := s.sqs.queues[queueURL]; !ok {
+		return nil, newError(http.StatusBadRequest, "AWS.SimpleQueueService.NonExistentQueue", "The specified queue does not exist for this wsdl version.")
+	}
+
+	return struct {
+		QueueURL string `xml:"QueueUrl"`
+	}{QueueURL: queueURL}, nil
+}
+
+func (s *Server) sqsListQueueTags(r *request) (interface{}, error) {
+	queue, err := s.sqsQueue(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return struct {
+		Tags []tag `xml:"Tag"`
+	}{Tags: tagList(queue.Tags)}, nil
+}
+
+func (s *Server) sqsListQueues(r *request) (interface{}, error) {
+	prefix := r.Form.Get("QueueNamePrefix")
+
+	var queueURLs []string
+
+	for _, queue := range s.sqs.queues {
+		if strings.HasPrefix(queue.Name, prefix) {
+			queueURLs = append(queueURLs, queue.URL)
+		}
+	}
+
+	sort.Strings(queueURLs)
+
+	return struct {
+		QueueURLs []string `xml:"QueueUrl"`
+	}{QueueURLs: queueURLs}, nil
+}
+
+func (s *Server) sqsSetQueueAttributes(r *request) (interface{}, error) {
+	queue, err := s.sqsQueue(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range formMap(r.Form, "Attribute", "Name", "Value") {
+		queue.Attributes[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) sqsTagQueue(r *request) (interface{}, error) {
+	queue, err := s.sqsQueue(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range formMap(r.Form, "Tag", "Key", "Value") {
+		queue.Tags[k] = v
+	}
+
+	return nil, nil
+}
+
+func (s *Server) sqsUntagQueue(r *request) (interface{}, error) {
+	queue, err := s.sqsQueue(r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range formList(r.Form, "TagKey") {
+		delete(queue.Tags, k)
+	}
+
+	return nil, nil
+}
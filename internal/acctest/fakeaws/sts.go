@@ -0,0 +1,19 @@
+package fakeaws
+
+func (s *Server) stsOperations() map[string]operation {
+	return map[string]operation{
+		"GetCallerIdentity": s.stsGetCallerIdentity,
+	}
+}
+
+func (s *Server) stsGetCallerIdentity(r *request) (interface{}, error) {
+	return struct {
+		Account string `xml:"Account"`
+		Arn     string `xml:"Arn"`
+		UserID  string `xml:"UserId"`
+	}{
+		Account: s.AccountID,
+		Arn:     s.arn("iam", "", "user/fakeaws"),
+		UserID:  "AIDACKCEVSQ6C2EXAMPLE",
+	}, nil
+}
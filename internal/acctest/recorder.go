@@ -0,0 +1,505 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// EnvVarRecordMode enables recording AWS API interactions to, or replaying them from, cassettes.
+	EnvVarRecordMode = "TF_ACC_RECORD_MODE"
+
+	RecordModeRecord = "record"
+	RecordModeReplay = "replay"
+
+	// CassetteDir is the directory, relative to the package under test, containing cassettes.
+	CassetteDir = "testdata/cassettes"
+)
+
+// Cassette is the recorded AWS API interactions of an acceptance test.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is an AWS API request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Body    string      `json:"body,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+}
+
+type RecordedResponse struct {
+	Body       string      `json:"body,omitempty"`
+	Headers    http.Header `json:"headers,omitempty"`
+	StatusCode int         `json:"status_code"`
+}
+
+// volatileHeaders are request headers that differ between otherwise identical requests
+// or that must not be written to cassettes.
+var volatileHeaders = []string{
+	"Amz-Sdk-Invocation-Id",
+	"Amz-Sdk-Request",
+	"Authorization",
+	"Content-Length",
+	"User-Agent",
+	"X-Amz-Content-Sha256",
+	"X-Amz-Date",
+	"X-Amz-Security-Token",
+}
+
+// volatileParameters are query string parameters and request body fields that differ between otherwise identical requests.
+var volatileParameters = map[string]bool{
+	"CallerReference":      true,
+	"ClientRequestToken":   true,
+	"ClientToken":          true,
+	"IdempotencyToken":     true,
+	"X-Amz-Algorithm":      true,
+	"X-Amz-Credential":     true,
+	"X-Amz-Date":           true,
+	"X-Amz-Expires":        true,
+	"X-Amz-Security-Token": true,
+	"X-Amz-Signature":      true,
+	"X-Amz-SignedHeaders":  true,
+	"clientRequestToken":   true,
+	"clientToken":          true,
+	"idempotencyToken":     true,
+}
+
+// randomNameRegexp matches resource names generated by sdkacctest.RandomWithPrefix(ResourcePrefix), capturing the random part.
+var randomNameRegexp = regexp.MustCompile(`(tf[-_]acc[-_]test[-_])(\d+)`)
+
+// secretRegexp matches temporary credentials in responses, such as those of sts:AssumeRole.
+var secretRegexp = regexp.MustCompile(`((?:<|")(?:SecretAccessKey|SessionToken)(?:>|"\s*:\s*"))[^<"]*`)
+
+var volatileXMLElementRegexp = regexp.MustCompile(`<(CallerReference|ClientToken|ClientRequestToken)>[^<]*</(CallerReference|ClientToken|ClientRequestToken)>`)
+
+// recorder records the AWS API interactions of a test to a cassette or replays them from one.
+type recorder struct {
+	testName string
+	mode     string
+	filename string
+	// reportError reports errors, such as unmatched requests, that must fail the test.
+	reportError func(error)
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// next is the index, for each request key, of the next interaction to replay.
+	next map[string]int
+	// names maps the random part of resource names in the cassette to those of the test run.
+	names map[string]string
+}
+
+func newRecorder(testName, mode, filename string, reportError func(error)) (*recorder, error) {
+	r := &recorder{
+		testName:    testName,
+		mode:        mode,
+		filename:    filename,
+		reportError: reportError,
+		cassette:    &Cassette{},
+		next:        make(map[string]int),
+		names:       make(map[string]string),
+	}
+
+	switch mode {
+	case RecordModeRecord:
+	case RecordModeReplay:
+		b, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading cassette (%s): %w", filename, err)
+		}
+
+		if err := json.Unmarshal(b, r.cassette); err != nil {
+			return nil, fmt.Errorf("error reading cassette (%s): %w", filename, err)
+		}
+	default:
+		return nil, fmt.Errorf("%s must be %q or %q, got %q", EnvVarRecordMode, RecordModeRecord, RecordModeReplay, mode)
+	}
+
+	return r, nil
+}
+
+// cassetteFilename returns the name of the cassette file of a test.
+func cassetteFilename(t *testing.T) string {
+	return filepath.Join(CassetteDir, strings.ReplaceAll(t.Name(), "/", "__")+".json")
+}
+
+// save writes the recorded interactions to the cassette file.
+func (r *recorder) save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.filename), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.filename, append(b, '\n'), 0644)
+}
+
+// roundTrip sends the request using `base` and records the interaction or, in replay mode, replays the matching interaction.
+func (r *recorder) roundTrip(base http.RoundTripper, req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == RecordModeRecord {
+		return r.record(base, req, body)
+	}
+
+	return r.replay(req, body)
+}
+
+func (r *recorder) record(base http.RoundTripper, req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := base.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	headers := req.Header.Clone()
+	for _, k := range volatileHeaders {
+		headers.Del(k)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: RecordedRequest{
+			Body:    string(body),
+			Headers: headers,
+			Method:  req.Method,
+			URL:     req.URL.String(),
+		},
+		Response: RecordedResponse{
+			Body:       secretRegexp.ReplaceAllString(string(respBody), "${1}REDACTED"),
+			Headers:    resp.Header.Clone(),
+			StatusCode: resp.StatusCode,
+		},
+	})
+
+	return resp, nil
+}
+
+func (r *recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	key := requestKey(req.Method, req.URL, req.Header, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var matches []*Interaction
+
+	for _, interaction := range r.cassette.Interactions {
+		u, err := url.Parse(interaction.Request.URL)
+
+		if err != nil {
+			continue
+		}
+
+		if requestKey(interaction.Request.Method, u, interaction.Request.Headers, []byte(interaction.Request.Body)) == key {
+			matches = append(matches, interaction)
+		}
+	}
+
+	if len(matches) == 0 {
+		err := &replayError{fmt.Sprintf("no interaction recorded in cassette (%s) for request: %s %s", r.filename, req.Method, req.URL)}
+
+		// The error may be retried or ignored by the caller, so fail the test here.
+		r.reportError(err)
+
+		return nil, err
+	}
+
+	// Identical requests, such as those of a waiter, are replayed in the order they were recorded.
+	// Once all have been replayed, the last one is repeated.
+	i := r.next[key]
+	if i < len(matches)-1 {
+		r.next[key] = i + 1
+	} else {
+		i = len(matches) - 1
+	}
+
+	interaction := matches[i]
+
+	r.mapRandomNames(interaction.Request.URL+interaction.Request.Body, req.URL.String()+string(body))
+
+	respBody := r.replaceRandomNames(interaction.Response.Body)
+	headers := make(http.Header, len(interaction.Response.Headers))
+
+	for k, vs := range interaction.Response.Headers {
+		for _, v := range vs {
+			headers.Add(k, r.replaceRandomNames(v))
+		}
+	}
+
+	// The response body may have changed length and no longer match its checksum.
+	headers.Del("Content-Length")
+	headers.Del("X-Amz-Crc32")
+
+	return &http.Response{
+		Body:          ioutil.NopCloser(strings.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Header:        headers,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       req,
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+	}, nil
+}
+
+// mapRandomNames maps the random parts of resource names in a recorded request to those in the matching request of the test run.
+func (r *recorder) mapRandomNames(recorded, current string) {
+	recordedNames := randomNameRegexp.FindAllStringSubmatch(recorded, -1)
+	currentNames := randomNameRegexp.FindAllStringSubmatch(current, -1)
+
+	for i := 0; i < len(recordedNames) && i < len(currentNames); i++ {
+		r.names[recordedNames[i][2]] = currentNames[i][2]
+	}
+}
+
+// replaceRandomNames replaces the random parts of resource names in a recorded response with those of the test run.
+func (r *recorder) replaceRandomNames(s string) string {
+	return randomNameRegexp.ReplaceAllStringFunc(s, func(name string) string {
+		m := randomNameRegexp.FindStringSubmatch(name)
+
+		if v, ok := r.names[m[2]]; ok {
+			return m[1] + v
+		}
+
+		return name
+	})
+}
+
+// replayError is returned for requests with no recorded interaction.
+// It is not temporary, so the AWS Go SDK does not retry the request.
+type replayError struct {
+	message string
+}
+
+func (e *replayError) Error() string {
+	return e.message
+}
+
+func (e *replayError) Temporary() bool {
+	return false
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// requestKey returns the part of a request used to match it to recorded interactions.
+// Signatures, dates, client tokens and the random part of resource names are ignored.
+func requestKey(method string, u *url.URL, header http.Header, body []byte) string {
+	query := u.Query()
+
+	for k := range query {
+		if volatileParameters[k] {
+			query.Del(k)
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString(method)
+	b.WriteString(" ")
+	b.WriteString(u.Host)
+	b.WriteString(u.EscapedPath())
+	b.WriteString("?")
+	b.WriteString(query.Encode())
+	b.WriteString("\n")
+	// AWS JSON protocol operations are identified by the X-Amz-Target header.
+	b.WriteString(header.Get("X-Amz-Target"))
+	b.WriteString("\n")
+	b.WriteString(normalizeBody(header.Get("Content-Type"), body))
+
+	return randomNameRegexp.ReplaceAllString(b.String(), "${1}RANDOM")
+}
+
+func normalizeBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+
+		if err != nil {
+			break
+		}
+
+		for k := range values {
+			if volatileParameters[k] {
+				values.Del(k)
+			}
+		}
+
+		return values.Encode()
+
+	case strings.Contains(mediaType, "json"):
+		var v interface{}
+
+		if err := json.Unmarshal(body, &v); err != nil {
+			break
+		}
+
+		// encoding/json marshals map keys in sorted order.
+		b, err := json.Marshal(removeVolatileFields(v))
+
+		if err != nil {
+			break
+		}
+
+		return string(b)
+
+	case strings.Contains(mediaType, "xml"):
+		return volatileXMLElementRegexp.ReplaceAllString(string(body), "")
+	}
+
+	return string(body)
+}
+
+func removeVolatileFields(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if volatileParameters[k] {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = removeVolatileFields(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = removeVolatileFields(e)
+		}
+	}
+
+	return v
+}
+
+var (
+	activeRecorder   *recorder
+	activeRecorderMu sync.Mutex
+)
+
+// recordMode returns the record/replay mode of acceptance tests, or "" if it is not enabled.
+func recordMode() string {
+	return os.Getenv(EnvVarRecordMode)
+}
+
+// wrapTransportForRecording is the HTTP transport wrapper of providers created for acceptance testing in record/replay mode.
+// It routes requests to the recorder of the running test.
+func wrapTransportForRecording(base http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		activeRecorderMu.Lock()
+		r := activeRecorder
+		activeRecorderMu.Unlock()
+
+		if r == nil {
+			return nil, &replayError{fmt.Sprintf("%s is set, but PreCheck has not been called: %s %s", EnvVarRecordMode, req.Method, req.URL)}
+		}
+
+		return r.roundTrip(base, req)
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// startRecording starts recording the AWS API interactions of the test to, or replaying them from,
+// its cassette. The recording is saved when the test finishes, if the test passed.
+// Interactions are routed to the running test, so tests must be run one at a time (`-parallel 1`).
+func startRecording(t *testing.T, mode string) {
+	r, err := newRecorder(t.Name(), mode, cassetteFilename(t), func(err error) {
+		t.Error(err)
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	activeRecorderMu.Lock()
+	defer activeRecorderMu.Unlock()
+
+	if activeRecorder != nil {
+		t.Fatalf("%s=%s requires tests to run one at a time (-parallel 1), but %s is running", EnvVarRecordMode, mode, activeRecorder.testName)
+	}
+
+	activeRecorder = r
+
+	t.Cleanup(func() {
+		activeRecorderMu.Lock()
+		activeRecorder = nil
+		activeRecorderMu.Unlock()
+
+		if mode != RecordModeRecord || t.Failed() {
+			return
+		}
+
+		if err := r.save(); err != nil {
+			t.Errorf("error saving cassette (%s): %s", r.filename, err)
+
+			return
+		}
+
+		log.Printf("[INFO] Saved %d AWS API interactions to cassette (%s)", len(r.cassette.Interactions), r.filename)
+	})
+}
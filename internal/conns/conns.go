@@ -3,7 +3,9 @@ package conns
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -282,6 +284,7 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -562,6 +565,14 @@ const (
 	XRay                          = "xray"
 )
 
+// s3URICleaningDisabled is the service client cache key for the S3 client
+// with REST protocol URI cleaning disabled.
+const s3URICleaningDisabled = "s3_uri_cleaning_disabled"
+
+// mediaConvertAccount is the service client cache key for the MediaConvert client
+// using the account-specific endpoint.
+const mediaConvertAccount = "mediaconvert_account"
+
 type ServiceDatum struct {
 	AWSClientName     string
 	AWSServiceName    string
@@ -857,23 +868,31 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN               string
-	AssumeRoleDurationSeconds   int
-	AssumeRoleExternalID        string
-	AssumeRolePolicy            string
-	AssumeRolePolicyARNs        []string
-	AssumeRoleSessionName       string
-	AssumeRoleTags              map[string]string
-	AssumeRoleTransitiveTagKeys []string
-
-	AllowedAccountIds   []string
-	ForbiddenAccountIds []string
-
-	DefaultTagsConfig *tftags.DefaultConfig
-	Endpoints         map[string]string
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Insecure          bool
-	HTTPProxy         string
+	AssumeRole                []AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
+
+	AllowedAccountIds      []string
+	AllowedOrganizationIds []string
+	AllowedRegions         []string
+	ForbiddenAccountIds    []string
+	ForbiddenRegions       []string
+
+	CustomCABundle                 string
+	DefaultTagsConfig              *tftags.DefaultConfig
+	EC2MetadataServiceEndpoint     string
+	EC2MetadataServiceEndpointMode string
+	Endpoints                      map[string]string
+	IgnoreTagsConfig               *tftags.IgnoreConfig
+	Insecure                       bool
+	HTTPProxy                      string
+	HTTPTransport                  func(http.RoundTripper) http.RoundTripper
+	NamingConfig                   *create.NamingConfig
+	NoProxy                        []string
+	RateLimits                     map[string]ServiceRateLimit
+	ReadOnly                       bool
+	RetryMode                      string
+	TagPolicyConfig                *tftags.PolicyConfig
+	UseOrganizationsTagPolicy      bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -882,291 +901,61 @@ type Config struct {
 	SkipMetadataApiCheck    bool
 	S3ForcePathStyle        bool
 
+	UseDualStackEndpoint bool
+	UseFIPSEndpoint      bool
+
 	TerraformVersion string
 }
 
+// AssumeRole is an IAM Role to assume.
+// Roles in Config.AssumeRole are assumed in order, each using the credentials of the previous one.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// AssumeRoleWithWebIdentity is an IAM Role to assume using a web identity (OIDC) token
+// in place of the provider's other credential sources.
+type AssumeRoleWithWebIdentity struct {
+	DurationSeconds      int
+	PolicyARNs           []string
+	RoleARN              string
+	SessionName          string
+	WebIdentityToken     string
+	WebIdentityTokenFile string
+}
+
 type AWSClient struct {
-	AccessAnalyzerConn                *accessanalyzer.AccessAnalyzer
-	AccountConn                       *account.Account
-	AccountID                         string
-	ACMConn                           *acm.ACM
-	ACMPCAConn                        *acmpca.ACMPCA
-	AlexaForBusinessConn              *alexaforbusiness.AlexaForBusiness
-	AMPConn                           *prometheusservice.PrometheusService
-	AmplifyBackendConn                *amplifybackend.AmplifyBackend
-	AmplifyConn                       *amplify.Amplify
-	APIGatewayConn                    *apigateway.APIGateway
-	APIGatewayV2Conn                  *apigatewayv2.ApiGatewayV2
-	AppAutoScalingConn                *applicationautoscaling.ApplicationAutoScaling
-	AppConfigConn                     *appconfig.AppConfig
-	AppFlowConn                       *appflow.Appflow
-	AppIntegrationsConn               *appintegrationsservice.AppIntegrationsService
-	ApplicationCostProfilerConn       *applicationcostprofiler.ApplicationCostProfiler
-	ApplicationDiscoveryConn          *applicationdiscoveryservice.ApplicationDiscoveryService
-	ApplicationInsightsConn           *applicationinsights.ApplicationInsights
-	AppMeshConn                       *appmesh.AppMesh
-	AppRegistryConn                   *appregistry.AppRegistry
-	AppRunnerConn                     *apprunner.AppRunner
-	AppStreamConn                     *appstream.AppStream
-	AppSyncConn                       *appsync.AppSync
-	AthenaConn                        *athena.Athena
-	AuditManagerConn                  *auditmanager.AuditManager
-	AugmentedAIRuntimeConn            *augmentedairuntime.AugmentedAIRuntime
-	AutoScalingConn                   *autoscaling.AutoScaling
-	AutoScalingPlansConn              *autoscalingplans.AutoScalingPlans
-	BackupConn                        *backup.Backup
-	BatchConn                         *batch.Batch
-	BraketConn                        *braket.Braket
-	BudgetsConn                       *budgets.Budgets
-	ChimeConn                         *chime.Chime
-	Cloud9Conn                        *cloud9.Cloud9
-	CloudControlConn                  *cloudcontrolapi.CloudControlApi
-	CloudDirectoryConn                *clouddirectory.CloudDirectory
-	CloudFormationConn                *cloudformation.CloudFormation
-	CloudFrontConn                    *cloudfront.CloudFront
-	CloudHSMV2Conn                    *cloudhsmv2.CloudHSMV2
-	CloudSearchConn                   *cloudsearch.CloudSearch
-	CloudSearchDomainConn             *cloudsearchdomain.CloudSearchDomain
-	CloudTrailConn                    *cloudtrail.CloudTrail
-	CloudWatchConn                    *cloudwatch.CloudWatch
-	CloudWatchLogsConn                *cloudwatchlogs.CloudWatchLogs
-	CodeArtifactConn                  *codeartifact.CodeArtifact
-	CodeBuildConn                     *codebuild.CodeBuild
-	CodeCommitConn                    *codecommit.CodeCommit
-	CodeDeployConn                    *codedeploy.CodeDeploy
-	CodeGuruProfilerConn              *codeguruprofiler.CodeGuruProfiler
-	CodeGuruReviewerConn              *codegurureviewer.CodeGuruReviewer
-	CodePipelineConn                  *codepipeline.CodePipeline
-	CodeStarConn                      *codestar.CodeStar
-	CodeStarConnectionsConn           *codestarconnections.CodeStarConnections
-	CodeStarNotificationsConn         *codestarnotifications.CodeStarNotifications
-	CognitoIdentityConn               *cognitoidentity.CognitoIdentity
-	CognitoIDPConn                    *cognitoidentityprovider.CognitoIdentityProvider
-	CognitoSyncConn                   *cognitosync.CognitoSync
-	ComprehendConn                    *comprehend.Comprehend
-	ComprehendMedicalConn             *comprehendmedical.ComprehendMedical
-	ConfigServiceConn                 *configservice.ConfigService
-	ConnectConn                       *connect.Connect
-	ConnectContactLensConn            *connectcontactlens.ConnectContactLens
-	ConnectParticipantConn            *connectparticipant.ConnectParticipant
-	CostExplorerConn                  *costexplorer.CostExplorer
-	CURConn                           *costandusagereportservice.CostandUsageReportService
-	DataExchangeConn                  *dataexchange.DataExchange
-	DataPipelineConn                  *datapipeline.DataPipeline
-	DataSyncConn                      *datasync.DataSync
-	DAXConn                           *dax.DAX
-	DefaultTagsConfig                 *tftags.DefaultConfig
-	DetectiveConn                     *detective.Detective
-	DeviceFarmConn                    *devicefarm.DeviceFarm
-	DevOpsGuruConn                    *devopsguru.DevOpsGuru
-	DirectConnectConn                 *directconnect.DirectConnect
-	DLMConn                           *dlm.DLM
-	DMSConn                           *databasemigrationservice.DatabaseMigrationService
-	DNSSuffix                         string
-	DocDBConn                         *docdb.DocDB
-	DSConn                            *directoryservice.DirectoryService
-	DynamoDBConn                      *dynamodb.DynamoDB
-	DynamoDBStreamsConn               *dynamodbstreams.DynamoDBStreams
-	EC2Conn                           *ec2.EC2
-	EC2InstanceConnectConn            *ec2instanceconnect.EC2InstanceConnect
-	ECRConn                           *ecr.ECR
-	ECRPublicConn                     *ecrpublic.ECRPublic
-	ECSConn                           *ecs.ECS
-	EFSConn                           *efs.EFS
-	EKSConn                           *eks.EKS
-	ElastiCacheConn                   *elasticache.ElastiCache
-	ElasticBeanstalkConn              *elasticbeanstalk.ElasticBeanstalk
-	ElasticInferenceConn              *elasticinference.ElasticInference
-	ElasticsearchConn                 *elasticsearch.ElasticsearchService
-	ElasticTranscoderConn             *elastictranscoder.ElasticTranscoder
-	ELBConn                           *elb.ELB
-	ELBV2Conn                         *elbv2.ELBV2
-	EMRConn                           *emr.EMR
-	EMRContainersConn                 *emrcontainers.EMRContainers
-	EventsConn                        *eventbridge.EventBridge
-	FinSpaceConn                      *finspace.Finspace
-	FinSpaceDataConn                  *finspacedata.FinSpaceData
-	FirehoseConn                      *firehose.Firehose
-	FISConn                           *fis.FIS
-	FMSConn                           *fms.FMS
-	ForecastConn                      *forecastservice.ForecastService
-	ForecastQueryConn                 *forecastqueryservice.ForecastQueryService
-	FraudDetectorConn                 *frauddetector.FraudDetector
-	FSxConn                           *fsx.FSx
-	GameLiftConn                      *gamelift.GameLift
-	GlacierConn                       *glacier.Glacier
-	GlobalAcceleratorConn             *globalaccelerator.GlobalAccelerator
-	GlueConn                          *glue.Glue
-	GlueDataBrewConn                  *gluedatabrew.GlueDataBrew
-	GreengrassConn                    *greengrass.Greengrass
-	GreengrassV2Conn                  *greengrassv2.GreengrassV2
-	GroundStationConn                 *groundstation.GroundStation
-	GuardDutyConn                     *guardduty.GuardDuty
-	HealthConn                        *health.Health
-	HealthLakeConn                    *healthlake.HealthLake
-	HoneycodeConn                     *honeycode.Honeycode
-	IAMConn                           *iam.IAM
-	IdentityStoreConn                 *identitystore.IdentityStore
-	IgnoreTagsConfig                  *tftags.IgnoreConfig
-	ImageBuilderConn                  *imagebuilder.Imagebuilder
-	InspectorConn                     *inspector.Inspector
-	IoT1ClickDevicesConn              *iot1clickdevicesservice.IoT1ClickDevicesService
-	IoT1ClickProjectsConn             *iot1clickprojects.IoT1ClickProjects
-	IoTAnalyticsConn                  *iotanalytics.IoTAnalytics
-	IoTConn                           *iot.IoT
-	IoTDataPlaneConn                  *iotdataplane.IoTDataPlane
-	IoTDeviceAdvisorConn              *iotdeviceadvisor.IoTDeviceAdvisor
-	IoTEventsConn                     *iotevents.IoTEvents
-	IoTEventsDataConn                 *ioteventsdata.IoTEventsData
-	IoTFleetHubConn                   *iotfleethub.IoTFleetHub
-	IoTJobsDataPlaneConn              *iotjobsdataplane.IoTJobsDataPlane
-	IoTSecureTunnelingConn            *iotsecuretunneling.IoTSecureTunneling
-	IoTSiteWiseConn                   *iotsitewise.IoTSiteWise
-	IoTThingsGraphConn                *iotthingsgraph.IoTThingsGraph
-	IoTWirelessConn                   *iotwireless.IoTWireless
-	KafkaConn                         *kafka.Kafka
-	KafkaConnectConn                  *kafkaconnect.KafkaConnect
-	KendraConn                        *kendra.Kendra
-	KinesisAnalyticsConn              *kinesisanalytics.KinesisAnalytics
-	KinesisAnalyticsV2Conn            *kinesisanalyticsv2.KinesisAnalyticsV2
-	KinesisConn                       *kinesis.Kinesis
-	KinesisVideoArchivedMediaConn     *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia
-	KinesisVideoConn                  *kinesisvideo.KinesisVideo
-	KinesisVideoMediaConn             *kinesisvideomedia.KinesisVideoMedia
-	KinesisVideoSignalingChannelsConn *kinesisvideosignalingchannels.KinesisVideoSignalingChannels
-	KMSConn                           *kms.KMS
-	LakeFormationConn                 *lakeformation.LakeFormation
-	LambdaConn                        *lambda.Lambda
-	LexModelsConn                     *lexmodelbuildingservice.LexModelBuildingService
-	LexModelsV2Conn                   *lexmodelsv2.LexModelsV2
-	LexRuntimeConn                    *lexruntimeservice.LexRuntimeService
-	LexRuntimeV2Conn                  *lexruntimev2.LexRuntimeV2
-	LicenseManagerConn                *licensemanager.LicenseManager
-	LightsailConn                     *lightsail.Lightsail
-	LocationConn                      *locationservice.LocationService
-	LookoutEquipmentConn              *lookoutequipment.LookoutEquipment
-	LookoutForVisionConn              *lookoutforvision.LookoutForVision
-	LookoutMetricsConn                *lookoutmetrics.LookoutMetrics
-	MachineLearningConn               *machinelearning.MachineLearning
-	Macie2Conn                        *macie2.Macie2
-	MacieConn                         *macie.Macie
-	ManagedBlockchainConn             *managedblockchain.ManagedBlockchain
-	MarketplaceCatalogConn            *marketplacecatalog.MarketplaceCatalog
-	MarketplaceCommerceAnalyticsConn  *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
-	MarketplaceEntitlementConn        *marketplaceentitlementservice.MarketplaceEntitlementService
-	MarketplaceMeteringConn           *marketplacemetering.MarketplaceMetering
-	MediaConnectConn                  *mediaconnect.MediaConnect
-	MediaConvertAccountConn           *mediaconvert.MediaConvert
-	MediaConvertConn                  *mediaconvert.MediaConvert
-	MediaLiveConn                     *medialive.MediaLive
-	MediaPackageConn                  *mediapackage.MediaPackage
-	MediaPackageVODConn               *mediapackagevod.MediaPackageVod
-	MediaStoreConn                    *mediastore.MediaStore
-	MediaStoreDataConn                *mediastoredata.MediaStoreData
-	MediaTailorConn                   *mediatailor.MediaTailor
-	MemoryDBConn                      *memorydb.MemoryDB
-	MgnConn                           *mgn.Mgn
-	MigrationHubConfigConn            *migrationhubconfig.MigrationHubConfig
-	MigrationHubConn                  *migrationhub.MigrationHub
-	MobileAnalyticsConn               *mobileanalytics.MobileAnalytics
-	MobileConn                        *mobile.Mobile
-	MQConn                            *mq.MQ
-	MTurkConn                         *mturk.MTurk
-	MWAAConn                          *mwaa.MWAA
-	NeptuneConn                       *neptune.Neptune
-	NetworkFirewallConn               *networkfirewall.NetworkFirewall
-	NetworkManagerConn                *networkmanager.NetworkManager
-	NimbleStudioConn                  *nimblestudio.NimbleStudio
-	OpsWorksCMConn                    *opsworkscm.OpsWorksCM
-	OpsWorksConn                      *opsworks.OpsWorks
-	OrganizationsConn                 *organizations.Organizations
-	OutpostsConn                      *outposts.Outposts
-	Partition                         string
-	PersonalizeConn                   *personalize.Personalize
-	PersonalizeEventsConn             *personalizeevents.PersonalizeEvents
-	PersonalizeRuntimeConn            *personalizeruntime.PersonalizeRuntime
-	PIConn                            *pi.PI
-	PinpointConn                      *pinpoint.Pinpoint
-	PinpointEmailConn                 *pinpointemail.PinpointEmail
-	PinpointSMSVoiceConn              *pinpointsmsvoice.PinpointSMSVoice
-	PollyConn                         *polly.Polly
-	PricingConn                       *pricing.Pricing
-	ProtonConn                        *proton.Proton
-	QLDBConn                          *qldb.QLDB
-	QLDBSessionConn                   *qldbsession.QLDBSession
-	QuickSightConn                    *quicksight.QuickSight
-	RAMConn                           *ram.RAM
-	RDSConn                           *rds.RDS
-	RDSDataConn                       *rdsdataservice.RDSDataService
-	RedshiftConn                      *redshift.Redshift
-	RedshiftDataConn                  *redshiftdataapiservice.RedshiftDataAPIService
-	Region                            string
-	RekognitionConn                   *rekognition.Rekognition
-	ResourceGroupsConn                *resourcegroups.ResourceGroups
-	ResourceGroupsTaggingAPIConn      *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	ReverseDNSPrefix                  string
-	RoboMakerConn                     *robomaker.RoboMaker
-	Route53Conn                       *route53.Route53
-	Route53DomainsConn                *route53domains.Route53Domains
-	Route53RecoveryControlConfigConn  *route53recoverycontrolconfig.Route53RecoveryControlConfig
-	Route53RecoveryReadinessConn      *route53recoveryreadiness.Route53RecoveryReadiness
-	Route53ResolverConn               *route53resolver.Route53Resolver
-	S3Conn                            *s3.S3
-	S3ConnURICleaningDisabled         *s3.S3
-	S3ControlConn                     *s3control.S3Control
-	S3OutpostsConn                    *s3outposts.S3Outposts
-	SageMakerConn                     *sagemaker.SageMaker
-	SageMakerEdgeManagerConn          *sagemakeredgemanager.SagemakerEdgeManager
-	SageMakerFeatureStoreRuntimeConn  *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime
-	SageMakerRuntimeConn              *sagemakerruntime.SageMakerRuntime
-	SavingsPlansConn                  *savingsplans.SavingsPlans
-	SchemasConn                       *schemas.Schemas
-	SecretsManagerConn                *secretsmanager.SecretsManager
-	SecurityHubConn                   *securityhub.SecurityHub
-	ServerlessRepoConn                *serverlessapplicationrepository.ServerlessApplicationRepository
-	ServiceCatalogConn                *servicecatalog.ServiceCatalog
-	ServiceDiscoveryConn              *servicediscovery.ServiceDiscovery
-	ServiceQuotasConn                 *servicequotas.ServiceQuotas
-	SESConn                           *ses.SES
-	SESV2Conn                         *sesv2.SESV2
-	SFNConn                           *sfn.SFN
-	ShieldConn                        *shield.Shield
-	SignerConn                        *signer.Signer
-	SimpleDBConn                      *simpledb.SimpleDB
-	SMSConn                           *sms.SMS
-	SnowballConn                      *snowball.Snowball
-	SNSConn                           *sns.SNS
-	SQSConn                           *sqs.SQS
-	SSMConn                           *ssm.SSM
-	SSMContactsConn                   *ssmcontacts.SSMContacts
-	SSMIncidentsConn                  *ssmincidents.SSMIncidents
-	SSOAdminConn                      *ssoadmin.SSOAdmin
-	SSOConn                           *sso.SSO
-	SSOOIDCConn                       *ssooidc.SSOOIDC
-	StorageGatewayConn                *storagegateway.StorageGateway
-	STSConn                           *sts.STS
-	SupportConn                       *support.Support
-	SupportedPlatforms                []string
-	SWFConn                           *swf.SWF
-	SyntheticsConn                    *synthetics.Synthetics
-	TerraformVersion                  string
-	TextractConn                      *textract.Textract
-	TimestreamQueryConn               *timestreamquery.TimestreamQuery
-	TimestreamWriteConn               *timestreamwrite.TimestreamWrite
-	TranscribeConn                    *transcribeservice.TranscribeService
-	TranscribeStreamingConn           *transcribestreamingservice.TranscribeStreamingService
-	TransferConn                      *transfer.Transfer
-	TranslateConn                     *translate.Translate
-	WAFConn                           *waf.WAF
-	WAFRegionalConn                   *wafregional.WAFRegional
-	WAFV2Conn                         *wafv2.WAFV2
-	WellArchitectedConn               *wellarchitected.WellArchitected
-	WorkDocsConn                      *workdocs.WorkDocs
-	WorkLinkConn                      *worklink.WorkLink
-	WorkMailConn                      *workmail.WorkMail
-	WorkMailMessageFlowConn           *workmailmessageflow.WorkMailMessageFlow
-	WorkSpacesConn                    *workspaces.WorkSpaces
-	XRayConn                          *xray.XRay
+	AccountID          string
+	DefaultTagsConfig  *tftags.DefaultConfig
+	DNSSuffix          string
+	IgnoreTagsConfig   *tftags.IgnoreConfig
+	NamingConfig       *create.NamingConfig
+	Partition          string
+	Region             string
+	ReverseDNSPrefix   string
+	SupportedPlatforms []string
+	TagPolicyConfig    *tftags.PolicyConfig
+	TerraformVersion   string
+
+	conns                       map[string]interface{}
+	connsLock                   sync.Mutex
+	endpoints                   map[string]string
+	mediaConvertAccountConnLock sync.Mutex
+	rateLimiters                *rateLimiters
+	readOnly                    bool
+	s3ForcePathStyle            bool
+	session                     *session.Session
+	tracedResource              *tracedResource
+	untraced                    *AWSClient
+	useDualStackEndpoint        bool
+	useFIPSEndpoint             bool
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -1183,664 +972,1757 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
 
-// Client configures and returns a fully initialized AWSClient
-func (c *Config) Client() (interface{}, error) {
-	// Get the auth and region. This can fail if keys/regions were not
-	// specified and we're attempting to use the environment.
-	if !c.SkipRegionValidation {
-		if err := awsbase.ValidateRegion(c.Region); err != nil {
-			return nil, err
-		}
-	}
+// WithDefaultTagsConfig returns a copy of the client with the specified default tags configuration,
+// sharing the client's cached service clients.
+func (client *AWSClient) WithDefaultTagsConfig(defaultTagsConfig *tftags.DefaultConfig) *AWSClient {
+	derived := client.derive()
+	derived.DefaultTagsConfig = defaultTagsConfig
 
-	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
-		AssumeRoleARN:               c.AssumeRoleARN,
-		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
-		AssumeRoleExternalID:        c.AssumeRoleExternalID,
-		AssumeRolePolicy:            c.AssumeRolePolicy,
-		AssumeRolePolicyARNs:        c.AssumeRolePolicyARNs,
-		AssumeRoleSessionName:       c.AssumeRoleSessionName,
-		AssumeRoleTags:              c.AssumeRoleTags,
-		AssumeRoleTransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
-		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
-		CallerName:                  "Terraform AWS Provider",
-		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints[IAM],
-		Insecure:                    c.Insecure,
-		HTTPProxy:                   c.HTTPProxy,
-		MaxRetries:                  c.MaxRetries,
-		Profile:                     c.Profile,
-		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
-		SkipCredsValidation:         c.SkipCredsValidation,
-		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
-		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints[STS],
-		Token:                       c.Token,
-		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
-	}
+	return derived
+}
 
-	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
-	if err != nil {
-		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
-	}
+// derive returns a copy of the client that shares the cached service clients of the client it was
+// first derived from, the untraced client.
+func (client *AWSClient) derive() *AWSClient {
+	untraced := client
 
-	if accountID == "" {
-		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
+	if client.untraced != nil {
+		untraced = client.untraced
 	}
 
-	if err := awsbase.ValidateAccountID(accountID, c.AllowedAccountIds, c.ForbiddenAccountIds); err != nil {
-		return nil, err
+	return &AWSClient{
+		AccountID:          client.AccountID,
+		DefaultTagsConfig:  client.DefaultTagsConfig,
+		DNSSuffix:          client.DNSSuffix,
+		IgnoreTagsConfig:   client.IgnoreTagsConfig,
+		NamingConfig:       client.NamingConfig,
+		Partition:          client.Partition,
+		Region:             client.Region,
+		ReverseDNSPrefix:   client.ReverseDNSPrefix,
+		SupportedPlatforms: client.SupportedPlatforms,
+		TagPolicyConfig:    client.TagPolicyConfig,
+		TerraformVersion:   client.TerraformVersion,
+
+		endpoints:            client.endpoints,
+		rateLimiters:         client.rateLimiters,
+		readOnly:             client.readOnly,
+		s3ForcePathStyle:     client.s3ForcePathStyle,
+		session:              client.session,
+		tracedResource:       client.tracedResource,
+		untraced:             untraced,
+		useDualStackEndpoint: client.useDualStackEndpoint,
+		useFIPSEndpoint:      client.useFIPSEndpoint,
 	}
+}
 
-	DNSSuffix := "amazonaws.com"
-	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
-		DNSSuffix = p.DNSSuffix()
+// conn returns the cached service client for key, calling newConn with a copy
+// of the provider session configured for that service to create it on first use.
+func (client *AWSClient) conn(key string, newConn func(sess *session.Session) interface{}) interface{} {
+	if client.untraced != nil {
+		conn := client.untraced.conn(key, newConn)
+
+		if client.tracedResource == nil {
+			return conn
+		}
+
+		return client.tracedResource.tracedConn(conn)
 	}
 
-	client := &AWSClient{
-		AccessAnalyzerConn:                accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AccessAnalyzer])})),
-		AccountConn:                       account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Account])})),
-		AccountID:                         accountID,
-		ACMConn:                           acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACM])})),
-		ACMPCAConn:                        acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ACMPCA])})),
-		AlexaForBusinessConn:              alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AlexaForBusiness])})),
-		AMPConn:                           prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AMP])})),
-		AmplifyBackendConn:                amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AmplifyBackend])})),
-		AmplifyConn:                       amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Amplify])})),
-		APIGatewayConn:                    apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGateway])})),
-		APIGatewayV2Conn:                  apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[APIGatewayV2])})),
-		AppAutoScalingConn:                applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppAutoScaling])})),
-		AppConfigConn:                     appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppConfig])})),
-		AppFlowConn:                       appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppFlow])})),
-		AppIntegrationsConn:               appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppIntegrations])})),
-		ApplicationCostProfilerConn:       applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationCostProfiler])})),
-		ApplicationDiscoveryConn:          applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationDiscovery])})),
-		ApplicationInsightsConn:           applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ApplicationInsights])})),
-		AppMeshConn:                       appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppMesh])})),
-		AppRegistryConn:                   appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRegistry])})),
-		AppRunnerConn:                     apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppRunner])})),
-		AppStreamConn:                     appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppStream])})),
-		AppSyncConn:                       appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AppSync])})),
-		AthenaConn:                        athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Athena])})),
-		AuditManagerConn:                  auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AuditManager])})),
-		AugmentedAIRuntimeConn:            augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AugmentedAIRuntime])})),
-		AutoScalingConn:                   autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScaling])})),
-		AutoScalingPlansConn:              autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[AutoScalingPlans])})),
-		BackupConn:                        backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Backup])})),
-		BatchConn:                         batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Batch])})),
-		BraketConn:                        braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Braket])})),
-		BudgetsConn:                       budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Budgets])})),
-		ChimeConn:                         chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Chime])})),
-		Cloud9Conn:                        cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Cloud9])})),
-		CloudControlConn:                  cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudControl])})),
-		CloudDirectoryConn:                clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudDirectory])})),
-		CloudFormationConn:                cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFormation])})),
-		CloudFrontConn:                    cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudFront])})),
-		CloudHSMV2Conn:                    cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudHSMV2])})),
-		CloudSearchConn:                   cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearch])})),
-		CloudSearchDomainConn:             cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudSearchDomain])})),
-		CloudTrailConn:                    cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudTrail])})),
-		CloudWatchConn:                    cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatch])})),
-		CloudWatchLogsConn:                cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CloudWatchLogs])})),
-		CodeArtifactConn:                  codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeArtifact])})),
-		CodeBuildConn:                     codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeBuild])})),
-		CodeCommitConn:                    codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeCommit])})),
-		CodeDeployConn:                    codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeDeploy])})),
-		CodeGuruProfilerConn:              codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruProfiler])})),
-		CodeGuruReviewerConn:              codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeGuruReviewer])})),
-		CodePipelineConn:                  codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodePipeline])})),
-		CodeStarConn:                      codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStar])})),
-		CodeStarConnectionsConn:           codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarConnections])})),
-		CodeStarNotificationsConn:         codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CodeStarNotifications])})),
-		CognitoIdentityConn:               cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIdentity])})),
-		CognitoIDPConn:                    cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoIDP])})),
-		CognitoSyncConn:                   cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CognitoSync])})),
-		ComprehendConn:                    comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Comprehend])})),
-		ComprehendMedicalConn:             comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ComprehendMedical])})),
-		ConfigServiceConn:                 configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConfigService])})),
-		ConnectConn:                       connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Connect])})),
-		ConnectContactLensConn:            connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectContactLens])})),
-		ConnectParticipantConn:            connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ConnectParticipant])})),
-		CostExplorerConn:                  costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CostExplorer])})),
-		CURConn:                           costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[CUR])})),
-		DataExchangeConn:                  dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataExchange])})),
-		DataPipelineConn:                  datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataPipeline])})),
-		DataSyncConn:                      datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DataSync])})),
-		DAXConn:                           dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DAX])})),
-		DefaultTagsConfig:                 c.DefaultTagsConfig,
-		DetectiveConn:                     detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Detective])})),
-		DeviceFarmConn:                    devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DeviceFarm])})),
-		DevOpsGuruConn:                    devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DevOpsGuru])})),
-		DirectConnectConn:                 directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DirectConnect])})),
-		DLMConn:                           dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DLM])})),
-		DMSConn:                           databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DMS])})),
-		DNSSuffix:                         DNSSuffix,
-		DocDBConn:                         docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DocDB])})),
-		DSConn:                            directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DS])})),
-		DynamoDBConn:                      dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDB])})),
-		DynamoDBStreamsConn:               dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[DynamoDBStreams])})),
-		EC2Conn:                           ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2])})),
-		EC2InstanceConnectConn:            ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EC2InstanceConnect])})),
-		ECRConn:                           ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECR])})),
-		ECRPublicConn:                     ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECRPublic])})),
-		ECSConn:                           ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ECS])})),
-		EFSConn:                           efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EFS])})),
-		EKSConn:                           eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EKS])})),
-		ElastiCacheConn:                   elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElastiCache])})),
-		ElasticBeanstalkConn:              elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticBeanstalk])})),
-		ElasticInferenceConn:              elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticInference])})),
-		ElasticsearchConn:                 elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Elasticsearch])})),
-		ElasticTranscoderConn:             elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ElasticTranscoder])})),
-		ELBConn:                           elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELB])})),
-		ELBV2Conn:                         elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ELBV2])})),
-		EMRConn:                           emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMR])})),
-		EMRContainersConn:                 emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[EMRContainers])})),
-		EventsConn:                        eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Events])})),
-		FinSpaceConn:                      finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpace])})),
-		FinSpaceDataConn:                  finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FinSpaceData])})),
-		FirehoseConn:                      firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Firehose])})),
-		FISConn:                           fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FIS])})),
-		FMSConn:                           fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FMS])})),
-		ForecastConn:                      forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Forecast])})),
-		ForecastQueryConn:                 forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ForecastQuery])})),
-		FraudDetectorConn:                 frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FraudDetector])})),
-		FSxConn:                           fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[FSx])})),
-		GameLiftConn:                      gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GameLift])})),
-		GlacierConn:                       glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glacier])})),
-		GlueConn:                          glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Glue])})),
-		GlueDataBrewConn:                  gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GlueDataBrew])})),
-		GreengrassConn:                    greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Greengrass])})),
-		GreengrassV2Conn:                  greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GreengrassV2])})),
-		GroundStationConn:                 groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GroundStation])})),
-		GuardDutyConn:                     guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[GuardDuty])})),
-		HealthConn:                        health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Health])})),
-		HealthLakeConn:                    healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[HealthLake])})),
-		HoneycodeConn:                     honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Honeycode])})),
-		IAMConn:                           iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IAM])})),
-		IdentityStoreConn:                 identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IdentityStore])})),
-		IgnoreTagsConfig:                  c.IgnoreTagsConfig,
-		ImageBuilderConn:                  imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ImageBuilder])})),
-		InspectorConn:                     inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Inspector])})),
-		IoT1ClickDevicesConn:              iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickDevices])})),
-		IoT1ClickProjectsConn:             iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT1ClickProjects])})),
-		IoTAnalyticsConn:                  iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTAnalytics])})),
-		IoTConn:                           iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoT])})),
-		IoTDataPlaneConn:                  iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDataPlane])})),
-		IoTDeviceAdvisorConn:              iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTDeviceAdvisor])})),
-		IoTEventsConn:                     iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEvents])})),
-		IoTEventsDataConn:                 ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTEventsData])})),
-		IoTFleetHubConn:                   iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTFleetHub])})),
-		IoTJobsDataPlaneConn:              iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTJobsDataPlane])})),
-		IoTSecureTunnelingConn:            iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSecureTunneling])})),
-		IoTSiteWiseConn:                   iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTSiteWise])})),
-		IoTThingsGraphConn:                iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTThingsGraph])})),
-		IoTWirelessConn:                   iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[IoTWireless])})),
-		KafkaConn:                         kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kafka])})),
-		KafkaConnectConn:                  kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KafkaConnect])})),
-		KendraConn:                        kendra.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kendra])})),
-		KinesisAnalyticsConn:              kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalytics])})),
-		KinesisAnalyticsV2Conn:            kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisAnalyticsV2])})),
-		KinesisConn:                       kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Kinesis])})),
-		KinesisVideoArchivedMediaConn:     kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoArchivedMedia])})),
-		KinesisVideoConn:                  kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideo])})),
-		KinesisVideoMediaConn:             kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoMedia])})),
-		KinesisVideoSignalingChannelsConn: kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KinesisVideoSignalingChannels])})),
-		KMSConn:                           kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[KMS])})),
-		LakeFormationConn:                 lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LakeFormation])})),
-		LambdaConn:                        lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lambda])})),
-		LexModelsConn:                     lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModels])})),
-		LexModelsV2Conn:                   lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexModelsV2])})),
-		LexRuntimeConn:                    lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntime])})),
-		LexRuntimeV2Conn:                  lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LexRuntimeV2])})),
-		LicenseManagerConn:                licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LicenseManager])})),
-		LightsailConn:                     lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Lightsail])})),
-		LocationConn:                      locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Location])})),
-		LookoutEquipmentConn:              lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutEquipment])})),
-		LookoutForVisionConn:              lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutForVision])})),
-		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutMetrics])})),
-		MachineLearningConn:               machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MachineLearning])})),
-		Macie2Conn:                        macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie2])})),
-		MacieConn:                         macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie])})),
-		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ManagedBlockchain])})),
-		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCatalog])})),
-		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCommerceAnalytics])})),
-		MarketplaceEntitlementConn:        marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceEntitlement])})),
-		MarketplaceMeteringConn:           marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceMetering])})),
-		MediaConnectConn:                  mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConnect])})),
-		MediaConvertConn:                  mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaConvert])})),
-		MediaLiveConn:                     medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaLive])})),
-		MediaPackageConn:                  mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackage])})),
-		MediaPackageVODConn:               mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaPackageVOD])})),
-		MediaStoreConn:                    mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStore])})),
-		MediaStoreDataConn:                mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaStoreData])})),
-		MediaTailorConn:                   mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MediaTailor])})),
-		MemoryDBConn:                      memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MemoryDB])})),
-		MgnConn:                           mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mgn])})),
-		MigrationHubConfigConn:            migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHubConfig])})),
-		MigrationHubConn:                  migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MigrationHub])})),
-		MobileAnalyticsConn:               mobileanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MobileAnalytics])})),
-		MobileConn:                        mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Mobile])})),
-		MQConn:                            mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MQ])})),
-		MTurkConn:                         mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MTurk])})),
-		MWAAConn:                          mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MWAA])})),
-		NeptuneConn:                       neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Neptune])})),
-		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
-		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
-		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
-		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
-		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
-		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
-		OutpostsConn:                      outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Outposts])})),
-		Partition:                         Partition,
-		PersonalizeConn:                   personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Personalize])})),
-		PersonalizeEventsConn:             personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeEvents])})),
-		PersonalizeRuntimeConn:            personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PersonalizeRuntime])})),
-		PIConn:                            pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PI])})),
-		PinpointConn:                      pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pinpoint])})),
-		PinpointEmailConn:                 pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointEmail])})),
-		PinpointSMSVoiceConn:              pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[PinpointSMSVoice])})),
-		PollyConn:                         polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Polly])})),
-		PricingConn:                       pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Pricing])})),
-		ProtonConn:                        proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Proton])})),
-		QLDBConn:                          qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDB])})),
-		QLDBSessionConn:                   qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QLDBSession])})),
-		QuickSightConn:                    quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[QuickSight])})),
-		RAMConn:                           ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RAM])})),
-		RDSConn:                           rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDS])})),
-		RDSDataConn:                       rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RDSData])})),
-		RedshiftConn:                      redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Redshift])})),
-		RedshiftDataConn:                  redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RedshiftData])})),
-		Region:                            c.Region,
-		RekognitionConn:                   rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Rekognition])})),
-		ResourceGroupsConn:                resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroups])})),
-		ResourceGroupsTaggingAPIConn:      resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ResourceGroupsTaggingAPI])})),
-		ReverseDNSPrefix:                  ReverseDNS(DNSSuffix),
-		RoboMakerConn:                     robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[RoboMaker])})),
-		Route53DomainsConn:                route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Domains])})),
-		Route53RecoveryControlConfigConn:  route53recoverycontrolconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig])})),
-		Route53RecoveryReadinessConn:      route53recoveryreadiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness])})),
-		Route53ResolverConn:               route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Route53Resolver])})),
-		S3ControlConn:                     s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Control])})),
-		S3OutpostsConn:                    s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[S3Outposts])})),
-		SageMakerConn:                     sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMaker])})),
-		SageMakerEdgeManagerConn:          sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerEdgeManager])})),
-		SageMakerFeatureStoreRuntimeConn:  sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerFeatureStoreRuntime])})),
-		SageMakerRuntimeConn:              sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SageMakerRuntime])})),
-		SavingsPlansConn:                  savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SavingsPlans])})),
-		SchemasConn:                       schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Schemas])})),
-		SecretsManagerConn:                secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecretsManager])})),
-		SecurityHubConn:                   securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SecurityHub])})),
-		ServerlessRepoConn:                serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServerlessRepo])})),
-		ServiceCatalogConn:                servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceCatalog])})),
-		ServiceDiscoveryConn:              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceDiscovery])})),
-		ServiceQuotasConn:                 servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ServiceQuotas])})),
-		SESConn:                           ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SES])})),
-		SESV2Conn:                         sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SESV2])})),
-		SFNConn:                           sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SFN])})),
-		SignerConn:                        signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Signer])})),
-		SimpleDBConn:                      simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SimpleDB])})),
-		SMSConn:                           sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SMS])})),
-		SnowballConn:                      snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Snowball])})),
-		SNSConn:                           sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SNS])})),
-		SQSConn:                           sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SQS])})),
-		SSMConn:                           ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSM])})),
-		SSMContactsConn:                   ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMContacts])})),
-		SSMIncidentsConn:                  ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSMIncidents])})),
-		SSOAdminConn:                      ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOAdmin])})),
-		SSOConn:                           sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSO])})),
-		SSOOIDCConn:                       ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SSOOIDC])})),
-		StorageGatewayConn:                storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[StorageGateway])})),
-		STSConn:                           sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[STS])})),
-		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
-		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
-		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
-		TerraformVersion:                  c.TerraformVersion,
-		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
-		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
-		TimestreamWriteConn:               timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamWrite])})),
-		TranscribeConn:                    transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transcribe])})),
-		TranscribeStreamingConn:           transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TranscribeStreaming])})),
-		TransferConn:                      transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Transfer])})),
-		TranslateConn:                     translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Translate])})),
-		WAFConn:                           waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAF])})),
-		WAFRegionalConn:                   wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFRegional])})),
-		WAFV2Conn:                         wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WAFV2])})),
-		WellArchitectedConn:               wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WellArchitected])})),
-		WorkDocsConn:                      workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkDocs])})),
-		WorkLinkConn:                      worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkLink])})),
-		WorkMailConn:                      workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMail])})),
-		WorkMailMessageFlowConn:           workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkMailMessageFlow])})),
-		WorkSpacesConn:                    workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[WorkSpaces])})),
-		XRayConn:                          xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[XRay])})),
+	client.connsLock.Lock()
+	defer client.connsLock.Unlock()
+
+	if conn, ok := client.conns[key]; ok {
+		return conn
 	}
 
-	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[GlobalAccelerator]),
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
 	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53]),
+
+	conn := newConn(client.session.Copy(client.serviceConfig(key)))
+	client.conns[key] = conn
+
+	return conn
+}
+
+// MediaConvertAccountConn returns the cached MediaConvert client for the account-specific endpoint,
+// calling newConn with the untraced client to create it on first use.
+// Creation is serialized separately from the other service clients as newConn calls the MediaConvert API.
+func (client *AWSClient) MediaConvertAccountConn(newConn func(*AWSClient) (*mediaconvert.MediaConvert, error)) (*mediaconvert.MediaConvert, error) {
+	if client.untraced != nil {
+		conn, err := client.untraced.MediaConvertAccountConn(newConn)
+
+		if err != nil || client.tracedResource == nil {
+			return conn, err
+		}
+
+		return client.tracedResource.tracedConn(conn).(*mediaconvert.MediaConvert), nil
 	}
-	route53RecoveryControlConfigConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryControlConfig]),
+
+	client.mediaConvertAccountConnLock.Lock()
+	defer client.mediaConvertAccountConnLock.Unlock()
+
+	client.connsLock.Lock()
+	conn, ok := client.conns[mediaConvertAccount]
+	client.connsLock.Unlock()
+
+	if ok {
+		return conn.(*mediaconvert.MediaConvert), nil
 	}
-	route53RecoveryReadinessConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Route53RecoveryReadiness]),
+
+	newConnection, err := newConn(client)
+
+	if err != nil {
+		return nil, err
 	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints[Shield]),
+
+	client.connsLock.Lock()
+	defer client.connsLock.Unlock()
+
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
 	}
 
-	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints[S3]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
+	client.conns[mediaConvertAccount] = newConnection
+
+	return newConnection, nil
+}
+
+// serviceConfig returns the session configuration for the service client
+// identified by key, including any custom endpoint and region overrides.
+func (client *AWSClient) serviceConfig(key string) *aws.Config {
+	if key == s3URICleaningDisabled {
+		config := client.serviceConfig(S3)
+		config.DisableRestProtocolURICleaning = aws.Bool(true)
+
+		return config
 	}
 
-	client.S3Conn = s3.New(sess.Copy(s3Config))
+	config := &aws.Config{
+		Endpoint: aws.String(client.endpoints[key]),
+	}
 
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	if key == S3 {
+		config.S3ForcePathStyle = aws.Bool(client.s3ForcePathStyle)
+	}
 
 	// Force "global" services to correct regions
-	switch Partition {
+	switch client.Partition {
 	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		route53RecoveryControlConfigConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53RecoveryReadinessConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
+		switch key {
+		case GlobalAccelerator, Route53RecoveryControlConfig, Route53RecoveryReadiness:
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		case Route53, Shield:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
 	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
+		if key == Route53 {
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
 		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
 	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		if key == Route53 {
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
 	}
 
-	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.Route53Conn = route53.New(sess.Copy(route53Config))
-	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
-
-	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
+	// Custom service endpoints take precedence over FIPS and dual-stack endpoint resolution
+	if aws.StringValue(config.Endpoint) == "" {
+		if client.useDualStackEndpoint {
+			config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
 		}
-	})
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.AppAutoScalingConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// StartDeployment operations can return a ConflictException
-	// if ongoing deployments are in-progress, thus we handle them
-	// here for the service client.
-	client.AppConfigConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "StartDeployment" {
-			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
+		if client.useFIPSEndpoint {
+			config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
 		}
-	})
+	}
 
-	client.AppSyncConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	return config
+}
+
+// The service client accessors below create their clients lazily on first use
+// and cache them for the lifetime of the AWSClient.
+
+func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
+	return client.conn(AccessAnalyzer, func(sess *session.Session) interface{} { return accessanalyzer.New(sess) }).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) AccountConn() *account.Account {
+	return client.conn(Account, func(sess *session.Session) interface{} { return account.New(sess) }).(*account.Account)
+}
+
+func (client *AWSClient) ACMConn() *acm.ACM {
+	return client.conn(ACM, func(sess *session.Session) interface{} { return acm.New(sess) }).(*acm.ACM)
+}
+
+func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
+	return client.conn(ACMPCA, func(sess *session.Session) interface{} { return acmpca.New(sess) }).(*acmpca.ACMPCA)
+}
 
-	client.ChimeConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling CreateVoiceConnector across multiple resources,
-		// the API can randomly return a BadRequestException without explanation
-		if r.Operation.Name == "CreateVoiceConnector" {
-			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
+	return client.conn(AlexaForBusiness, func(sess *session.Session) interface{} { return alexaforbusiness.New(sess) }).(*alexaforbusiness.AlexaForBusiness)
+}
+
+func (client *AWSClient) AMPConn() *prometheusservice.PrometheusService {
+	return client.conn(AMP, func(sess *session.Session) interface{} { return prometheusservice.New(sess) }).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) AmplifyBackendConn() *amplifybackend.AmplifyBackend {
+	return client.conn(AmplifyBackend, func(sess *session.Session) interface{} { return amplifybackend.New(sess) }).(*amplifybackend.AmplifyBackend)
+}
+
+func (client *AWSClient) AmplifyConn() *amplify.Amplify {
+	return client.conn(Amplify, func(sess *session.Session) interface{} { return amplify.New(sess) }).(*amplify.Amplify)
+}
+
+func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
+	return client.conn(APIGateway, func(sess *session.Session) interface{} {
+		conn := apigateway.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
-	})
+		})
 
-	client.CloudHSMV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.ConfigServiceConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+		return conn
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn(APIGatewayV2, func(sess *session.Session) interface{} { return apigatewayv2.New(sess) }).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) AppAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn(AppAutoScaling, func(sess *session.Session) interface{} {
+		conn := applicationautoscaling.New(sess)
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
 				return
 			}
-
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
 				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
 			}
-		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
-			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
+	return client.conn(AppConfig, func(sess *session.Session) interface{} {
+		conn := appconfig.New(sess)
+
+		// StartDeployment operations can return a ConflictException
+		// if ongoing deployments are in-progress, thus we handle them
+		// here for the service client.
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "StartDeployment" {
+				if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
 					r.Retryable = aws.Bool(true)
 				}
-				return
 			}
+		})
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		}
-	})
+		return conn
+	}).(*appconfig.AppConfig)
+}
 
-	client.CloudFormationConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+func (client *AWSClient) AppFlowConn() *appflow.Appflow {
+	return client.conn(AppFlow, func(sess *session.Session) interface{} { return appflow.New(sess) }).(*appflow.Appflow)
+}
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.DynamoDBConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+func (client *AWSClient) AppIntegrationsConn() *appintegrationsservice.AppIntegrationsService {
+	return client.conn(AppIntegrations, func(sess *session.Session) interface{} { return appintegrationsservice.New(sess) }).(*appintegrationsservice.AppIntegrationsService)
+}
 
-	client.EC2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+func (client *AWSClient) ApplicationCostProfilerConn() *applicationcostprofiler.ApplicationCostProfiler {
+	return client.conn(ApplicationCostProfiler, func(sess *session.Session) interface{} { return applicationcostprofiler.New(sess) }).(*applicationcostprofiler.ApplicationCostProfiler)
+}
 
-		if r.Operation.Name == "CreateVpnConnection" {
-			if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+func (client *AWSClient) ApplicationDiscoveryConn() *applicationdiscoveryservice.ApplicationDiscoveryService {
+	return client.conn(ApplicationDiscovery, func(sess *session.Session) interface{} { return applicationdiscoveryservice.New(sess) }).(*applicationdiscoveryservice.ApplicationDiscoveryService)
+}
 
-		if r.Operation.Name == "CreateVpnGateway" {
-			if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
+	return client.conn(ApplicationInsights, func(sess *session.Session) interface{} { return applicationinsights.New(sess) }).(*applicationinsights.ApplicationInsights)
+}
 
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.FMSConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Acceptance testing creates and deletes resources in quick succession.
-		// The FMS onboarding process into Organizations is opaque to consumers.
-		// Since we cannot reasonably check this status before receiving the error,
-		// set the operation as retryable.
-		switch r.Operation.Name {
-		case "AssociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DisassociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
+	return client.conn(AppMesh, func(sess *session.Session) interface{} { return appmesh.New(sess) }).(*appmesh.AppMesh)
+}
 
-	client.KafkaConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+func (client *AWSClient) AppRegistryConn() *appregistry.AppRegistry {
+	return client.conn(AppRegistry, func(sess *session.Session) interface{} { return appregistry.New(sess) }).(*appregistry.AppRegistry)
+}
 
-	client.KinesisConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
+	return client.conn(AppRunner, func(sess *session.Session) interface{} { return apprunner.New(sess) }).(*apprunner.AppRunner)
+}
 
-	client.OrganizationsConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+func (client *AWSClient) AppStreamConn() *appstream.AppStream {
+	return client.conn(AppStream, func(sess *session.Session) interface{} { return appstream.New(sess) }).(*appstream.AppStream)
+}
 
-	client.S3Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+func (client *AWSClient) AppSyncConn() *appsync.AppSync {
+	return client.conn(AppSync, func(sess *session.Session) interface{} {
+		conn := appsync.New(sess)
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
-	client.SecurityHubConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "EnableOrganizationAdminAccount":
-			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
-				r.Retryable = aws.Bool(true)
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateGraphqlApi" {
+				if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+					r.Retryable = aws.Bool(true)
+				}
 			}
-		}
-	})
+		})
 
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
-	client.SSOAdminConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
-			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
+		return conn
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) AthenaConn() *athena.Athena {
+	return client.conn(Athena, func(sess *session.Session) interface{} { return athena.New(sess) }).(*athena.Athena)
+}
+
+func (client *AWSClient) AuditManagerConn() *auditmanager.AuditManager {
+	return client.conn(AuditManager, func(sess *session.Session) interface{} { return auditmanager.New(sess) }).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) AugmentedAIRuntimeConn() *augmentedairuntime.AugmentedAIRuntime {
+	return client.conn(AugmentedAIRuntime, func(sess *session.Session) interface{} { return augmentedairuntime.New(sess) }).(*augmentedairuntime.AugmentedAIRuntime)
+}
+
+func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
+	return client.conn(AutoScaling, func(sess *session.Session) interface{} { return autoscaling.New(sess) }).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
+	return client.conn(AutoScalingPlans, func(sess *session.Session) interface{} { return autoscalingplans.New(sess) }).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) BackupConn() *backup.Backup {
+	return client.conn(Backup, func(sess *session.Session) interface{} { return backup.New(sess) }).(*backup.Backup)
+}
+
+func (client *AWSClient) BatchConn() *batch.Batch {
+	return client.conn(Batch, func(sess *session.Session) interface{} { return batch.New(sess) }).(*batch.Batch)
+}
+
+func (client *AWSClient) BraketConn() *braket.Braket {
+	return client.conn(Braket, func(sess *session.Session) interface{} { return braket.New(sess) }).(*braket.Braket)
+}
+
+func (client *AWSClient) BudgetsConn() *budgets.Budgets {
+	return client.conn(Budgets, func(sess *session.Session) interface{} { return budgets.New(sess) }).(*budgets.Budgets)
+}
+
+func (client *AWSClient) ChimeConn() *chime.Chime {
+	return client.conn(Chime, func(sess *session.Session) interface{} {
+		conn := chime.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling CreateVoiceConnector across multiple resources,
+			// the API can randomly return a BadRequestException without explanation
+			if r.Operation.Name == "CreateVoiceConnector" {
+				if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+					r.Retryable = aws.Bool(true)
+				}
 			}
-		}
-	})
+		})
 
-	client.StorageGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+		return conn
+	}).(*chime.Chime)
+}
 
-	client.WAFV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
-		}
+func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
+	return client.conn(Cloud9, func(sess *session.Session) interface{} { return cloud9.New(sess) }).(*cloud9.Cloud9)
+}
 
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
-		}
+func (client *AWSClient) CloudControlConn() *cloudcontrolapi.CloudControlApi {
+	return client.conn(CloudControl, func(sess *session.Session) interface{} { return cloudcontrolapi.New(sess) }).(*cloudcontrolapi.CloudControlApi)
+}
+
+func (client *AWSClient) CloudDirectoryConn() *clouddirectory.CloudDirectory {
+	return client.conn(CloudDirectory, func(sess *session.Session) interface{} { return clouddirectory.New(sess) }).(*clouddirectory.CloudDirectory)
+}
+
+func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
+	return client.conn(CloudFormation, func(sess *session.Session) interface{} {
+		conn := cloudformation.New(sess)
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
 				r.Retryable = aws.Bool(true)
 			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+		})
+
+		return conn
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
+	return client.conn(CloudFront, func(sess *session.Session) interface{} { return cloudfront.New(sess) }).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn(CloudHSMV2, func(sess *session.Session) interface{} {
+		conn := cloudhsmv2.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
 				r.Retryable = aws.Bool(true)
 			}
-		}
-	})
+		})
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.SupportedPlatforms = supportedPlatforms
-		}
-	}
+		return conn
+	}).(*cloudhsmv2.CloudHSMV2)
+}
 
-	return client, nil
+func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
+	return client.conn(CloudSearch, func(sess *session.Session) interface{} { return cloudsearch.New(sess) }).(*cloudsearch.CloudSearch)
 }
 
-func StdUserAgentProducts(terraformVersion string) []*awsbase.UserAgentProduct {
-	return []*awsbase.UserAgentProduct{
-		{Name: "APN", Version: "1.0"},
-		{Name: "HashiCorp", Version: "1.0"},
-		{Name: "Terraform", Version: terraformVersion, Extra: []string{"+https://www.terraform.io"}},
-		{Name: "terraform-provider-aws", Version: version.ProviderVersion, Extra: []string{"+https://registry.terraform.io/providers/hashicorp/aws"}},
-	}
+func (client *AWSClient) CloudSearchDomainConn() *cloudsearchdomain.CloudSearchDomain {
+	return client.conn(CloudSearchDomain, func(sess *session.Session) interface{} { return cloudsearchdomain.New(sess) }).(*cloudsearchdomain.CloudSearchDomain)
 }
 
-func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*session.Session, error) {
-	session, err := session.NewSession(cfg)
+func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
+	return client.conn(CloudTrail, func(sess *session.Session) interface{} { return cloudtrail.New(sess) }).(*cloudtrail.CloudTrail)
+}
 
-	if err != nil {
-		return nil, err
-	}
+func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
+	return client.conn(CloudWatch, func(sess *session.Session) interface{} { return cloudwatch.New(sess) }).(*cloudwatch.CloudWatch)
+}
 
-	userAgentProducts := StdUserAgentProducts(terraformVersion)
-	// Copied from github.com/hashicorp/aws-sdk-go-base@v1.0.0/session.go:
-	for i := len(userAgentProducts) - 1; i >= 0; i-- {
-		product := userAgentProducts[i]
-		session.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
-	}
+func (client *AWSClient) CloudWatchLogsConn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn(CloudWatchLogs, func(sess *session.Session) interface{} { return cloudwatchlogs.New(sess) }).(*cloudwatchlogs.CloudWatchLogs)
+}
 
-	return session.Copy(&aws.Config{Region: aws.String(region)}), nil
+func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
+	return client.conn(CodeArtifact, func(sess *session.Session) interface{} { return codeartifact.New(sess) }).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
+	return client.conn(CodeBuild, func(sess *session.Session) interface{} { return codebuild.New(sess) }).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
+	return client.conn(CodeCommit, func(sess *session.Session) interface{} { return codecommit.New(sess) }).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) CodeDeployConn() *codedeploy.CodeDeploy {
+	return client.conn(CodeDeploy, func(sess *session.Session) interface{} { return codedeploy.New(sess) }).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) CodeGuruProfilerConn() *codeguruprofiler.CodeGuruProfiler {
+	return client.conn(CodeGuruProfiler, func(sess *session.Session) interface{} { return codeguruprofiler.New(sess) }).(*codeguruprofiler.CodeGuruProfiler)
+}
+
+func (client *AWSClient) CodeGuruReviewerConn() *codegurureviewer.CodeGuruReviewer {
+	return client.conn(CodeGuruReviewer, func(sess *session.Session) interface{} { return codegurureviewer.New(sess) }).(*codegurureviewer.CodeGuruReviewer)
+}
+
+func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
+	return client.conn(CodePipeline, func(sess *session.Session) interface{} { return codepipeline.New(sess) }).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) CodeStarConn() *codestar.CodeStar {
+	return client.conn(CodeStar, func(sess *session.Session) interface{} { return codestar.New(sess) }).(*codestar.CodeStar)
+}
+
+func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
+	return client.conn(CodeStarConnections, func(sess *session.Session) interface{} { return codestarconnections.New(sess) }).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
+	return client.conn(CodeStarNotifications, func(sess *session.Session) interface{} { return codestarnotifications.New(sess) }).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
+	return client.conn(CognitoIdentity, func(sess *session.Session) interface{} { return cognitoidentity.New(sess) }).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn(CognitoIDP, func(sess *session.Session) interface{} { return cognitoidentityprovider.New(sess) }).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) CognitoSyncConn() *cognitosync.CognitoSync {
+	return client.conn(CognitoSync, func(sess *session.Session) interface{} { return cognitosync.New(sess) }).(*cognitosync.CognitoSync)
+}
+
+func (client *AWSClient) ComprehendConn() *comprehend.Comprehend {
+	return client.conn(Comprehend, func(sess *session.Session) interface{} { return comprehend.New(sess) }).(*comprehend.Comprehend)
+}
+
+func (client *AWSClient) ComprehendMedicalConn() *comprehendmedical.ComprehendMedical {
+	return client.conn(ComprehendMedical, func(sess *session.Session) interface{} { return comprehendmedical.New(sess) }).(*comprehendmedical.ComprehendMedical)
+}
+
+func (client *AWSClient) ConfigServiceConn() *configservice.ConfigService {
+	return client.conn(ConfigService, func(sess *session.Session) interface{} {
+		conn := configservice.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+				if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+					if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+						r.Retryable = aws.Bool(true)
+					}
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) ConnectConn() *connect.Connect {
+	return client.conn(Connect, func(sess *session.Session) interface{} { return connect.New(sess) }).(*connect.Connect)
+}
+
+func (client *AWSClient) ConnectContactLensConn() *connectcontactlens.ConnectContactLens {
+	return client.conn(ConnectContactLens, func(sess *session.Session) interface{} { return connectcontactlens.New(sess) }).(*connectcontactlens.ConnectContactLens)
+}
+
+func (client *AWSClient) ConnectParticipantConn() *connectparticipant.ConnectParticipant {
+	return client.conn(ConnectParticipant, func(sess *session.Session) interface{} { return connectparticipant.New(sess) }).(*connectparticipant.ConnectParticipant)
+}
+
+func (client *AWSClient) CostExplorerConn() *costexplorer.CostExplorer {
+	return client.conn(CostExplorer, func(sess *session.Session) interface{} { return costexplorer.New(sess) }).(*costexplorer.CostExplorer)
+}
+
+func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn(CUR, func(sess *session.Session) interface{} { return costandusagereportservice.New(sess) }).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
+	return client.conn(DataExchange, func(sess *session.Session) interface{} { return dataexchange.New(sess) }).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
+	return client.conn(DataPipeline, func(sess *session.Session) interface{} { return datapipeline.New(sess) }).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) DataSyncConn() *datasync.DataSync {
+	return client.conn(DataSync, func(sess *session.Session) interface{} { return datasync.New(sess) }).(*datasync.DataSync)
+}
+
+func (client *AWSClient) DAXConn() *dax.DAX {
+	return client.conn(DAX, func(sess *session.Session) interface{} { return dax.New(sess) }).(*dax.DAX)
+}
+
+func (client *AWSClient) DetectiveConn() *detective.Detective {
+	return client.conn(Detective, func(sess *session.Session) interface{} { return detective.New(sess) }).(*detective.Detective)
+}
+
+func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
+	return client.conn(DeviceFarm, func(sess *session.Session) interface{} { return devicefarm.New(sess) }).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) DevOpsGuruConn() *devopsguru.DevOpsGuru {
+	return client.conn(DevOpsGuru, func(sess *session.Session) interface{} { return devopsguru.New(sess) }).(*devopsguru.DevOpsGuru)
+}
+
+func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
+	return client.conn(DirectConnect, func(sess *session.Session) interface{} { return directconnect.New(sess) }).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) DLMConn() *dlm.DLM {
+	return client.conn(DLM, func(sess *session.Session) interface{} { return dlm.New(sess) }).(*dlm.DLM)
+}
+
+func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn(DMS, func(sess *session.Session) interface{} { return databasemigrationservice.New(sess) }).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) DocDBConn() *docdb.DocDB {
+	return client.conn(DocDB, func(sess *session.Session) interface{} { return docdb.New(sess) }).(*docdb.DocDB)
+}
+
+func (client *AWSClient) DSConn() *directoryservice.DirectoryService {
+	return client.conn(DS, func(sess *session.Session) interface{} { return directoryservice.New(sess) }).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
+	return client.conn(DynamoDB, func(sess *session.Session) interface{} {
+		conn := dynamodb.New(sess)
+
+		// See https://github.com/aws/aws-sdk-go/pull/1276
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+				return
+			}
+			if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) DynamoDBStreamsConn() *dynamodbstreams.DynamoDBStreams {
+	return client.conn(DynamoDBStreams, func(sess *session.Session) interface{} { return dynamodbstreams.New(sess) }).(*dynamodbstreams.DynamoDBStreams)
+}
+
+func (client *AWSClient) EC2Conn() *ec2.EC2 {
+	return client.conn(EC2, func(sess *session.Session) interface{} {
+		conn := ec2.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateClientVpnEndpoint" {
+				if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnConnection" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) EC2InstanceConnectConn() *ec2instanceconnect.EC2InstanceConnect {
+	return client.conn(EC2InstanceConnect, func(sess *session.Session) interface{} { return ec2instanceconnect.New(sess) }).(*ec2instanceconnect.EC2InstanceConnect)
+}
+
+func (client *AWSClient) ECRConn() *ecr.ECR {
+	return client.conn(ECR, func(sess *session.Session) interface{} { return ecr.New(sess) }).(*ecr.ECR)
+}
+
+func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
+	return client.conn(ECRPublic, func(sess *session.Session) interface{} { return ecrpublic.New(sess) }).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ECSConn() *ecs.ECS {
+	return client.conn(ECS, func(sess *session.Session) interface{} { return ecs.New(sess) }).(*ecs.ECS)
+}
+
+func (client *AWSClient) EFSConn() *efs.EFS {
+	return client.conn(EFS, func(sess *session.Session) interface{} { return efs.New(sess) }).(*efs.EFS)
+}
+
+func (client *AWSClient) EKSConn() *eks.EKS {
+	return client.conn(EKS, func(sess *session.Session) interface{} { return eks.New(sess) }).(*eks.EKS)
+}
+
+func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
+	return client.conn(ElastiCache, func(sess *session.Session) interface{} { return elasticache.New(sess) }).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn(ElasticBeanstalk, func(sess *session.Session) interface{} { return elasticbeanstalk.New(sess) }).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) ElasticInferenceConn() *elasticinference.ElasticInference {
+	return client.conn(ElasticInference, func(sess *session.Session) interface{} { return elasticinference.New(sess) }).(*elasticinference.ElasticInference)
+}
+
+func (client *AWSClient) ElasticsearchConn() *elasticsearch.ElasticsearchService {
+	return client.conn(Elasticsearch, func(sess *session.Session) interface{} { return elasticsearch.New(sess) }).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
+	return client.conn(ElasticTranscoder, func(sess *session.Session) interface{} { return elastictranscoder.New(sess) }).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) ELBConn() *elb.ELB {
+	return client.conn(ELB, func(sess *session.Session) interface{} { return elb.New(sess) }).(*elb.ELB)
+}
+
+func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
+	return client.conn(ELBV2, func(sess *session.Session) interface{} { return elbv2.New(sess) }).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) EMRConn() *emr.EMR {
+	return client.conn(EMR, func(sess *session.Session) interface{} { return emr.New(sess) }).(*emr.EMR)
+}
+
+func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
+	return client.conn(EMRContainers, func(sess *session.Session) interface{} { return emrcontainers.New(sess) }).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
+	return client.conn(Events, func(sess *session.Session) interface{} { return eventbridge.New(sess) }).(*eventbridge.EventBridge)
+}
+
+func (client *AWSClient) FinSpaceConn() *finspace.Finspace {
+	return client.conn(FinSpace, func(sess *session.Session) interface{} { return finspace.New(sess) }).(*finspace.Finspace)
+}
+
+func (client *AWSClient) FinSpaceDataConn() *finspacedata.FinSpaceData {
+	return client.conn(FinSpaceData, func(sess *session.Session) interface{} { return finspacedata.New(sess) }).(*finspacedata.FinSpaceData)
+}
+
+func (client *AWSClient) FirehoseConn() *firehose.Firehose {
+	return client.conn(Firehose, func(sess *session.Session) interface{} { return firehose.New(sess) }).(*firehose.Firehose)
+}
+
+func (client *AWSClient) FISConn() *fis.FIS {
+	return client.conn(FIS, func(sess *session.Session) interface{} { return fis.New(sess) }).(*fis.FIS)
+}
+
+func (client *AWSClient) FMSConn() *fms.FMS {
+	return client.conn(FMS, func(sess *session.Session) interface{} {
+		conn := fms.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Acceptance testing creates and deletes resources in quick succession.
+			// The FMS onboarding process into Organizations is opaque to consumers.
+			// Since we cannot reasonably check this status before receiving the error,
+			// set the operation as retryable.
+			switch r.Operation.Name {
+			case "AssociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			case "DisassociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
+	return client.conn(Forecast, func(sess *session.Session) interface{} { return forecastservice.New(sess) }).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) ForecastQueryConn() *forecastqueryservice.ForecastQueryService {
+	return client.conn(ForecastQuery, func(sess *session.Session) interface{} { return forecastqueryservice.New(sess) }).(*forecastqueryservice.ForecastQueryService)
+}
+
+func (client *AWSClient) FraudDetectorConn() *frauddetector.FraudDetector {
+	return client.conn(FraudDetector, func(sess *session.Session) interface{} { return frauddetector.New(sess) }).(*frauddetector.FraudDetector)
+}
+
+func (client *AWSClient) FSxConn() *fsx.FSx {
+	return client.conn(FSx, func(sess *session.Session) interface{} { return fsx.New(sess) }).(*fsx.FSx)
+}
+
+func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
+	return client.conn(GameLift, func(sess *session.Session) interface{} { return gamelift.New(sess) }).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) GlacierConn() *glacier.Glacier {
+	return client.conn(Glacier, func(sess *session.Session) interface{} { return glacier.New(sess) }).(*glacier.Glacier)
+}
+
+func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
+	return client.conn(GlobalAccelerator, func(sess *session.Session) interface{} { return globalaccelerator.New(sess) }).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) GlueConn() *glue.Glue {
+	return client.conn(Glue, func(sess *session.Session) interface{} { return glue.New(sess) }).(*glue.Glue)
+}
+
+func (client *AWSClient) GlueDataBrewConn() *gluedatabrew.GlueDataBrew {
+	return client.conn(GlueDataBrew, func(sess *session.Session) interface{} { return gluedatabrew.New(sess) }).(*gluedatabrew.GlueDataBrew)
+}
+
+func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
+	return client.conn(Greengrass, func(sess *session.Session) interface{} { return greengrass.New(sess) }).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) GreengrassV2Conn() *greengrassv2.GreengrassV2 {
+	return client.conn(GreengrassV2, func(sess *session.Session) interface{} { return greengrassv2.New(sess) }).(*greengrassv2.GreengrassV2)
+}
+
+func (client *AWSClient) GroundStationConn() *groundstation.GroundStation {
+	return client.conn(GroundStation, func(sess *session.Session) interface{} { return groundstation.New(sess) }).(*groundstation.GroundStation)
+}
+
+func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
+	return client.conn(GuardDuty, func(sess *session.Session) interface{} { return guardduty.New(sess) }).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) HealthConn() *health.Health {
+	return client.conn(Health, func(sess *session.Session) interface{} { return health.New(sess) }).(*health.Health)
+}
+
+func (client *AWSClient) HealthLakeConn() *healthlake.HealthLake {
+	return client.conn(HealthLake, func(sess *session.Session) interface{} { return healthlake.New(sess) }).(*healthlake.HealthLake)
+}
+
+func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
+	return client.conn(Honeycode, func(sess *session.Session) interface{} { return honeycode.New(sess) }).(*honeycode.Honeycode)
+}
+
+func (client *AWSClient) IAMConn() *iam.IAM {
+	return client.conn(IAM, func(sess *session.Session) interface{} { return iam.New(sess) }).(*iam.IAM)
+}
+
+func (client *AWSClient) IdentityStoreConn() *identitystore.IdentityStore {
+	return client.conn(IdentityStore, func(sess *session.Session) interface{} { return identitystore.New(sess) }).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
+	return client.conn(ImageBuilder, func(sess *session.Session) interface{} { return imagebuilder.New(sess) }).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) InspectorConn() *inspector.Inspector {
+	return client.conn(Inspector, func(sess *session.Session) interface{} { return inspector.New(sess) }).(*inspector.Inspector)
+}
+
+func (client *AWSClient) IoT1ClickDevicesConn() *iot1clickdevicesservice.IoT1ClickDevicesService {
+	return client.conn(IoT1ClickDevices, func(sess *session.Session) interface{} { return iot1clickdevicesservice.New(sess) }).(*iot1clickdevicesservice.IoT1ClickDevicesService)
+}
+
+func (client *AWSClient) IoT1ClickProjectsConn() *iot1clickprojects.IoT1ClickProjects {
+	return client.conn(IoT1ClickProjects, func(sess *session.Session) interface{} { return iot1clickprojects.New(sess) }).(*iot1clickprojects.IoT1ClickProjects)
+}
+
+func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
+	return client.conn(IoTAnalytics, func(sess *session.Session) interface{} { return iotanalytics.New(sess) }).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) IoTConn() *iot.IoT {
+	return client.conn(IoT, func(sess *session.Session) interface{} { return iot.New(sess) }).(*iot.IoT)
+}
+
+func (client *AWSClient) IoTDataPlaneConn() *iotdataplane.IoTDataPlane {
+	return client.conn(IoTDataPlane, func(sess *session.Session) interface{} { return iotdataplane.New(sess) }).(*iotdataplane.IoTDataPlane)
+}
+
+func (client *AWSClient) IoTDeviceAdvisorConn() *iotdeviceadvisor.IoTDeviceAdvisor {
+	return client.conn(IoTDeviceAdvisor, func(sess *session.Session) interface{} { return iotdeviceadvisor.New(sess) }).(*iotdeviceadvisor.IoTDeviceAdvisor)
+}
+
+func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
+	return client.conn(IoTEvents, func(sess *session.Session) interface{} { return iotevents.New(sess) }).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) IoTEventsDataConn() *ioteventsdata.IoTEventsData {
+	return client.conn(IoTEventsData, func(sess *session.Session) interface{} { return ioteventsdata.New(sess) }).(*ioteventsdata.IoTEventsData)
+}
+
+func (client *AWSClient) IoTFleetHubConn() *iotfleethub.IoTFleetHub {
+	return client.conn(IoTFleetHub, func(sess *session.Session) interface{} { return iotfleethub.New(sess) }).(*iotfleethub.IoTFleetHub)
+}
+
+func (client *AWSClient) IoTJobsDataPlaneConn() *iotjobsdataplane.IoTJobsDataPlane {
+	return client.conn(IoTJobsDataPlane, func(sess *session.Session) interface{} { return iotjobsdataplane.New(sess) }).(*iotjobsdataplane.IoTJobsDataPlane)
+}
+
+func (client *AWSClient) IoTSecureTunnelingConn() *iotsecuretunneling.IoTSecureTunneling {
+	return client.conn(IoTSecureTunneling, func(sess *session.Session) interface{} { return iotsecuretunneling.New(sess) }).(*iotsecuretunneling.IoTSecureTunneling)
+}
+
+func (client *AWSClient) IoTSiteWiseConn() *iotsitewise.IoTSiteWise {
+	return client.conn(IoTSiteWise, func(sess *session.Session) interface{} { return iotsitewise.New(sess) }).(*iotsitewise.IoTSiteWise)
+}
+
+func (client *AWSClient) IoTThingsGraphConn() *iotthingsgraph.IoTThingsGraph {
+	return client.conn(IoTThingsGraph, func(sess *session.Session) interface{} { return iotthingsgraph.New(sess) }).(*iotthingsgraph.IoTThingsGraph)
+}
+
+func (client *AWSClient) IoTWirelessConn() *iotwireless.IoTWireless {
+	return client.conn(IoTWireless, func(sess *session.Session) interface{} { return iotwireless.New(sess) }).(*iotwireless.IoTWireless)
+}
+
+func (client *AWSClient) KafkaConn() *kafka.Kafka {
+	return client.conn(Kafka, func(sess *session.Session) interface{} {
+		conn := kafka.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) KafkaConnectConn() *kafkaconnect.KafkaConnect {
+	return client.conn(KafkaConnect, func(sess *session.Session) interface{} { return kafkaconnect.New(sess) }).(*kafkaconnect.KafkaConnect)
+}
+
+func (client *AWSClient) KendraConn() *kendra.Kendra {
+	return client.conn(Kendra, func(sess *session.Session) interface{} { return kendra.New(sess) }).(*kendra.Kendra)
+}
+
+func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn(KinesisAnalytics, func(sess *session.Session) interface{} { return kinesisanalytics.New(sess) }).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn(KinesisAnalyticsV2, func(sess *session.Session) interface{} { return kinesisanalyticsv2.New(sess) }).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
+	return client.conn(Kinesis, func(sess *session.Session) interface{} {
+		conn := kinesis.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) KinesisVideoArchivedMediaConn() *kinesisvideoarchivedmedia.KinesisVideoArchivedMedia {
+	return client.conn(KinesisVideoArchivedMedia, func(sess *session.Session) interface{} { return kinesisvideoarchivedmedia.New(sess) }).(*kinesisvideoarchivedmedia.KinesisVideoArchivedMedia)
+}
+
+func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
+	return client.conn(KinesisVideo, func(sess *session.Session) interface{} { return kinesisvideo.New(sess) }).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) KinesisVideoMediaConn() *kinesisvideomedia.KinesisVideoMedia {
+	return client.conn(KinesisVideoMedia, func(sess *session.Session) interface{} { return kinesisvideomedia.New(sess) }).(*kinesisvideomedia.KinesisVideoMedia)
+}
+
+func (client *AWSClient) KinesisVideoSignalingChannelsConn() *kinesisvideosignalingchannels.KinesisVideoSignalingChannels {
+	return client.conn(KinesisVideoSignalingChannels, func(sess *session.Session) interface{} { return kinesisvideosignalingchannels.New(sess) }).(*kinesisvideosignalingchannels.KinesisVideoSignalingChannels)
+}
+
+func (client *AWSClient) KMSConn() *kms.KMS {
+	return client.conn(KMS, func(sess *session.Session) interface{} { return kms.New(sess) }).(*kms.KMS)
+}
+
+func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
+	return client.conn(LakeFormation, func(sess *session.Session) interface{} { return lakeformation.New(sess) }).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) LambdaConn() *lambda.Lambda {
+	return client.conn(Lambda, func(sess *session.Session) interface{} { return lambda.New(sess) }).(*lambda.Lambda)
+}
+
+func (client *AWSClient) LexModelsConn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn(LexModels, func(sess *session.Session) interface{} { return lexmodelbuildingservice.New(sess) }).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) LexModelsV2Conn() *lexmodelsv2.LexModelsV2 {
+	return client.conn(LexModelsV2, func(sess *session.Session) interface{} { return lexmodelsv2.New(sess) }).(*lexmodelsv2.LexModelsV2)
+}
+
+func (client *AWSClient) LexRuntimeConn() *lexruntimeservice.LexRuntimeService {
+	return client.conn(LexRuntime, func(sess *session.Session) interface{} { return lexruntimeservice.New(sess) }).(*lexruntimeservice.LexRuntimeService)
+}
+
+func (client *AWSClient) LexRuntimeV2Conn() *lexruntimev2.LexRuntimeV2 {
+	return client.conn(LexRuntimeV2, func(sess *session.Session) interface{} { return lexruntimev2.New(sess) }).(*lexruntimev2.LexRuntimeV2)
+}
+
+func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
+	return client.conn(LicenseManager, func(sess *session.Session) interface{} { return licensemanager.New(sess) }).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
+	return client.conn(Lightsail, func(sess *session.Session) interface{} { return lightsail.New(sess) }).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) LocationConn() *locationservice.LocationService {
+	return client.conn(Location, func(sess *session.Session) interface{} { return locationservice.New(sess) }).(*locationservice.LocationService)
+}
+
+func (client *AWSClient) LookoutEquipmentConn() *lookoutequipment.LookoutEquipment {
+	return client.conn(LookoutEquipment, func(sess *session.Session) interface{} { return lookoutequipment.New(sess) }).(*lookoutequipment.LookoutEquipment)
+}
+
+func (client *AWSClient) LookoutForVisionConn() *lookoutforvision.LookoutForVision {
+	return client.conn(LookoutForVision, func(sess *session.Session) interface{} { return lookoutforvision.New(sess) }).(*lookoutforvision.LookoutForVision)
+}
+
+func (client *AWSClient) LookoutMetricsConn() *lookoutmetrics.LookoutMetrics {
+	return client.conn(LookoutMetrics, func(sess *session.Session) interface{} { return lookoutmetrics.New(sess) }).(*lookoutmetrics.LookoutMetrics)
+}
+
+func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning {
+	return client.conn(MachineLearning, func(sess *session.Session) interface{} { return machinelearning.New(sess) }).(*machinelearning.MachineLearning)
+}
+
+func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
+	return client.conn(Macie2, func(sess *session.Session) interface{} { return macie2.New(sess) }).(*macie2.Macie2)
+}
+
+func (client *AWSClient) MacieConn() *macie.Macie {
+	return client.conn(Macie, func(sess *session.Session) interface{} { return macie.New(sess) }).(*macie.Macie)
+}
+
+func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
+	return client.conn(ManagedBlockchain, func(sess *session.Session) interface{} { return managedblockchain.New(sess) }).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
+	return client.conn(MarketplaceCatalog, func(sess *session.Session) interface{} { return marketplacecatalog.New(sess) }).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) MarketplaceCommerceAnalyticsConn() *marketplacecommerceanalytics.MarketplaceCommerceAnalytics {
+	return client.conn(MarketplaceCommerceAnalytics, func(sess *session.Session) interface{} { return marketplacecommerceanalytics.New(sess) }).(*marketplacecommerceanalytics.MarketplaceCommerceAnalytics)
+}
+
+func (client *AWSClient) MarketplaceEntitlementConn() *marketplaceentitlementservice.MarketplaceEntitlementService {
+	return client.conn(MarketplaceEntitlement, func(sess *session.Session) interface{} { return marketplaceentitlementservice.New(sess) }).(*marketplaceentitlementservice.MarketplaceEntitlementService)
+}
+
+func (client *AWSClient) MarketplaceMeteringConn() *marketplacemetering.MarketplaceMetering {
+	return client.conn(MarketplaceMetering, func(sess *session.Session) interface{} { return marketplacemetering.New(sess) }).(*marketplacemetering.MarketplaceMetering)
+}
+
+func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
+	return client.conn(MediaConnect, func(sess *session.Session) interface{} { return mediaconnect.New(sess) }).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
+	return client.conn(MediaConvert, func(sess *session.Session) interface{} { return mediaconvert.New(sess) }).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) MediaLiveConn() *medialive.MediaLive {
+	return client.conn(MediaLive, func(sess *session.Session) interface{} { return medialive.New(sess) }).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
+	return client.conn(MediaPackage, func(sess *session.Session) interface{} { return mediapackage.New(sess) }).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) MediaPackageVODConn() *mediapackagevod.MediaPackageVod {
+	return client.conn(MediaPackageVOD, func(sess *session.Session) interface{} { return mediapackagevod.New(sess) }).(*mediapackagevod.MediaPackageVod)
+}
+
+func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
+	return client.conn(MediaStore, func(sess *session.Session) interface{} { return mediastore.New(sess) }).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
+	return client.conn(MediaStoreData, func(sess *session.Session) interface{} { return mediastoredata.New(sess) }).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) MediaTailorConn() *mediatailor.MediaTailor {
+	return client.conn(MediaTailor, func(sess *session.Session) interface{} { return mediatailor.New(sess) }).(*mediatailor.MediaTailor)
+}
+
+func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
+	return client.conn(MemoryDB, func(sess *session.Session) interface{} { return memorydb.New(sess) }).(*memorydb.MemoryDB)
+}
+
+func (client *AWSClient) MgnConn() *mgn.Mgn {
+	return client.conn(Mgn, func(sess *session.Session) interface{} { return mgn.New(sess) }).(*mgn.Mgn)
+}
+
+func (client *AWSClient) MigrationHubConfigConn() *migrationhubconfig.MigrationHubConfig {
+	return client.conn(MigrationHubConfig, func(sess *session.Session) interface{} { return migrationhubconfig.New(sess) }).(*migrationhubconfig.MigrationHubConfig)
+}
+
+func (client *AWSClient) MigrationHubConn() *migrationhub.MigrationHub {
+	return client.conn(MigrationHub, func(sess *session.Session) interface{} { return migrationhub.New(sess) }).(*migrationhub.MigrationHub)
+}
+
+func (client *AWSClient) MobileAnalyticsConn() *mobileanalytics.MobileAnalytics {
+	return client.conn(MobileAnalytics, func(sess *session.Session) interface{} { return mobileanalytics.New(sess) }).(*mobileanalytics.MobileAnalytics)
+}
+
+func (client *AWSClient) MobileConn() *mobile.Mobile {
+	return client.conn(Mobile, func(sess *session.Session) interface{} { return mobile.New(sess) }).(*mobile.Mobile)
+}
+
+func (client *AWSClient) MQConn() *mq.MQ {
+	return client.conn(MQ, func(sess *session.Session) interface{} { return mq.New(sess) }).(*mq.MQ)
+}
+
+func (client *AWSClient) MTurkConn() *mturk.MTurk {
+	return client.conn(MTurk, func(sess *session.Session) interface{} { return mturk.New(sess) }).(*mturk.MTurk)
+}
+
+func (client *AWSClient) MWAAConn() *mwaa.MWAA {
+	return client.conn(MWAA, func(sess *session.Session) interface{} { return mwaa.New(sess) }).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) NeptuneConn() *neptune.Neptune {
+	return client.conn(Neptune, func(sess *session.Session) interface{} { return neptune.New(sess) }).(*neptune.Neptune)
+}
+
+func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
+	return client.conn(NetworkFirewall, func(sess *session.Session) interface{} { return networkfirewall.New(sess) }).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
+	return client.conn(NetworkManager, func(sess *session.Session) interface{} { return networkmanager.New(sess) }).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) NimbleStudioConn() *nimblestudio.NimbleStudio {
+	return client.conn(NimbleStudio, func(sess *session.Session) interface{} { return nimblestudio.New(sess) }).(*nimblestudio.NimbleStudio)
+}
+
+func (client *AWSClient) OpsWorksCMConn() *opsworkscm.OpsWorksCM {
+	return client.conn(OpsWorksCM, func(sess *session.Session) interface{} { return opsworkscm.New(sess) }).(*opsworkscm.OpsWorksCM)
+}
+
+func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
+	return client.conn(OpsWorks, func(sess *session.Session) interface{} { return opsworks.New(sess) }).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
+	return client.conn(Organizations, func(sess *session.Session) interface{} {
+		conn := organizations.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) OutpostsConn() *outposts.Outposts {
+	return client.conn(Outposts, func(sess *session.Session) interface{} { return outposts.New(sess) }).(*outposts.Outposts)
+}
+
+func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
+	return client.conn(Personalize, func(sess *session.Session) interface{} { return personalize.New(sess) }).(*personalize.Personalize)
+}
+
+func (client *AWSClient) PersonalizeEventsConn() *personalizeevents.PersonalizeEvents {
+	return client.conn(PersonalizeEvents, func(sess *session.Session) interface{} { return personalizeevents.New(sess) }).(*personalizeevents.PersonalizeEvents)
+}
+
+func (client *AWSClient) PersonalizeRuntimeConn() *personalizeruntime.PersonalizeRuntime {
+	return client.conn(PersonalizeRuntime, func(sess *session.Session) interface{} { return personalizeruntime.New(sess) }).(*personalizeruntime.PersonalizeRuntime)
+}
+
+func (client *AWSClient) PIConn() *pi.PI {
+	return client.conn(PI, func(sess *session.Session) interface{} { return pi.New(sess) }).(*pi.PI)
+}
+
+func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
+	return client.conn(Pinpoint, func(sess *session.Session) interface{} { return pinpoint.New(sess) }).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) PinpointEmailConn() *pinpointemail.PinpointEmail {
+	return client.conn(PinpointEmail, func(sess *session.Session) interface{} { return pinpointemail.New(sess) }).(*pinpointemail.PinpointEmail)
+}
+
+func (client *AWSClient) PinpointSMSVoiceConn() *pinpointsmsvoice.PinpointSMSVoice {
+	return client.conn(PinpointSMSVoice, func(sess *session.Session) interface{} { return pinpointsmsvoice.New(sess) }).(*pinpointsmsvoice.PinpointSMSVoice)
+}
+
+func (client *AWSClient) PollyConn() *polly.Polly {
+	return client.conn(Polly, func(sess *session.Session) interface{} { return polly.New(sess) }).(*polly.Polly)
+}
+
+func (client *AWSClient) PricingConn() *pricing.Pricing {
+	return client.conn(Pricing, func(sess *session.Session) interface{} { return pricing.New(sess) }).(*pricing.Pricing)
+}
+
+func (client *AWSClient) ProtonConn() *proton.Proton {
+	return client.conn(Proton, func(sess *session.Session) interface{} { return proton.New(sess) }).(*proton.Proton)
+}
+
+func (client *AWSClient) QLDBConn() *qldb.QLDB {
+	return client.conn(QLDB, func(sess *session.Session) interface{} { return qldb.New(sess) }).(*qldb.QLDB)
+}
+
+func (client *AWSClient) QLDBSessionConn() *qldbsession.QLDBSession {
+	return client.conn(QLDBSession, func(sess *session.Session) interface{} { return qldbsession.New(sess) }).(*qldbsession.QLDBSession)
+}
+
+func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
+	return client.conn(QuickSight, func(sess *session.Session) interface{} { return quicksight.New(sess) }).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) RAMConn() *ram.RAM {
+	return client.conn(RAM, func(sess *session.Session) interface{} { return ram.New(sess) }).(*ram.RAM)
+}
+
+func (client *AWSClient) RDSConn() *rds.RDS {
+	return client.conn(RDS, func(sess *session.Session) interface{} { return rds.New(sess) }).(*rds.RDS)
+}
+
+func (client *AWSClient) RDSDataConn() *rdsdataservice.RDSDataService {
+	return client.conn(RDSData, func(sess *session.Session) interface{} { return rdsdataservice.New(sess) }).(*rdsdataservice.RDSDataService)
+}
+
+func (client *AWSClient) RedshiftConn() *redshift.Redshift {
+	return client.conn(Redshift, func(sess *session.Session) interface{} { return redshift.New(sess) }).(*redshift.Redshift)
+}
+
+func (client *AWSClient) RedshiftDataConn() *redshiftdataapiservice.RedshiftDataAPIService {
+	return client.conn(RedshiftData, func(sess *session.Session) interface{} { return redshiftdataapiservice.New(sess) }).(*redshiftdataapiservice.RedshiftDataAPIService)
+}
+
+func (client *AWSClient) RekognitionConn() *rekognition.Rekognition {
+	return client.conn(Rekognition, func(sess *session.Session) interface{} { return rekognition.New(sess) }).(*rekognition.Rekognition)
+}
+
+func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
+	return client.conn(ResourceGroups, func(sess *session.Session) interface{} { return resourcegroups.New(sess) }).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) ResourceGroupsTaggingAPIConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.conn(ResourceGroupsTaggingAPI, func(sess *session.Session) interface{} { return resourcegroupstaggingapi.New(sess) }).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) RoboMakerConn() *robomaker.RoboMaker {
+	return client.conn(RoboMaker, func(sess *session.Session) interface{} { return robomaker.New(sess) }).(*robomaker.RoboMaker)
+}
+
+func (client *AWSClient) Route53Conn() *route53.Route53 {
+	return client.conn(Route53, func(sess *session.Session) interface{} { return route53.New(sess) }).(*route53.Route53)
+}
+
+func (client *AWSClient) Route53DomainsConn() *route53domains.Route53Domains {
+	return client.conn(Route53Domains, func(sess *session.Session) interface{} { return route53domains.New(sess) }).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return client.conn(Route53RecoveryControlConfig, func(sess *session.Session) interface{} { return route53recoverycontrolconfig.New(sess) }).(*route53recoverycontrolconfig.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return client.conn(Route53RecoveryReadiness, func(sess *session.Session) interface{} { return route53recoveryreadiness.New(sess) }).(*route53recoveryreadiness.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
+	return client.conn(Route53Resolver, func(sess *session.Session) interface{} { return route53resolver.New(sess) }).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) S3Conn() *s3.S3 {
+	return client.conn(S3, func(sess *session.Session) interface{} {
+		conn := s3.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return client.conn(s3URICleaningDisabled, func(sess *session.Session) interface{} { return s3.New(sess) }).(*s3.S3)
+}
+
+func (client *AWSClient) S3ControlConn() *s3control.S3Control {
+	return client.conn(S3Control, func(sess *session.Session) interface{} { return s3control.New(sess) }).(*s3control.S3Control)
+}
+
+func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
+	return client.conn(S3Outposts, func(sess *session.Session) interface{} { return s3outposts.New(sess) }).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
+	return client.conn(SageMaker, func(sess *session.Session) interface{} { return sagemaker.New(sess) }).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) SageMakerEdgeManagerConn() *sagemakeredgemanager.SagemakerEdgeManager {
+	return client.conn(SageMakerEdgeManager, func(sess *session.Session) interface{} { return sagemakeredgemanager.New(sess) }).(*sagemakeredgemanager.SagemakerEdgeManager)
+}
+
+func (client *AWSClient) SageMakerFeatureStoreRuntimeConn() *sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime {
+	return client.conn(SageMakerFeatureStoreRuntime, func(sess *session.Session) interface{} { return sagemakerfeaturestoreruntime.New(sess) }).(*sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime)
+}
+
+func (client *AWSClient) SageMakerRuntimeConn() *sagemakerruntime.SageMakerRuntime {
+	return client.conn(SageMakerRuntime, func(sess *session.Session) interface{} { return sagemakerruntime.New(sess) }).(*sagemakerruntime.SageMakerRuntime)
+}
+
+func (client *AWSClient) SavingsPlansConn() *savingsplans.SavingsPlans {
+	return client.conn(SavingsPlans, func(sess *session.Session) interface{} { return savingsplans.New(sess) }).(*savingsplans.SavingsPlans)
+}
+
+func (client *AWSClient) SchemasConn() *schemas.Schemas {
+	return client.conn(Schemas, func(sess *session.Session) interface{} { return schemas.New(sess) }).(*schemas.Schemas)
+}
+
+func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
+	return client.conn(SecretsManager, func(sess *session.Session) interface{} { return secretsmanager.New(sess) }).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
+	return client.conn(SecurityHub, func(sess *session.Session) interface{} {
+		conn := securityhub.New(sess)
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			switch r.Operation.Name {
+			case "EnableOrganizationAdminAccount":
+				if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) ServerlessRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn(ServerlessRepo, func(sess *session.Session) interface{} { return serverlessapplicationrepository.New(sess) }).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
+	return client.conn(ServiceCatalog, func(sess *session.Session) interface{} { return servicecatalog.New(sess) }).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
+	return client.conn(ServiceDiscovery, func(sess *session.Session) interface{} { return servicediscovery.New(sess) }).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
+	return client.conn(ServiceQuotas, func(sess *session.Session) interface{} { return servicequotas.New(sess) }).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) SESConn() *ses.SES {
+	return client.conn(SES, func(sess *session.Session) interface{} { return ses.New(sess) }).(*ses.SES)
+}
+
+func (client *AWSClient) SESV2Conn() *sesv2.SESV2 {
+	return client.conn(SESV2, func(sess *session.Session) interface{} { return sesv2.New(sess) }).(*sesv2.SESV2)
+}
+
+func (client *AWSClient) SFNConn() *sfn.SFN {
+	return client.conn(SFN, func(sess *session.Session) interface{} { return sfn.New(sess) }).(*sfn.SFN)
+}
+
+func (client *AWSClient) ShieldConn() *shield.Shield {
+	return client.conn(Shield, func(sess *session.Session) interface{} { return shield.New(sess) }).(*shield.Shield)
+}
+
+func (client *AWSClient) SignerConn() *signer.Signer {
+	return client.conn(Signer, func(sess *session.Session) interface{} { return signer.New(sess) }).(*signer.Signer)
+}
+
+func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
+	return client.conn(SimpleDB, func(sess *session.Session) interface{} { return simpledb.New(sess) }).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) SMSConn() *sms.SMS {
+	return client.conn(SMS, func(sess *session.Session) interface{} { return sms.New(sess) }).(*sms.SMS)
+}
+
+func (client *AWSClient) SnowballConn() *snowball.Snowball {
+	return client.conn(Snowball, func(sess *session.Session) interface{} { return snowball.New(sess) }).(*snowball.Snowball)
+}
+
+func (client *AWSClient) SNSConn() *sns.SNS {
+	return client.conn(SNS, func(sess *session.Session) interface{} { return sns.New(sess) }).(*sns.SNS)
+}
+
+func (client *AWSClient) SQSConn() *sqs.SQS {
+	return client.conn(SQS, func(sess *session.Session) interface{} { return sqs.New(sess) }).(*sqs.SQS)
+}
+
+func (client *AWSClient) SSMConn() *ssm.SSM {
+	return client.conn(SSM, func(sess *session.Session) interface{} { return ssm.New(sess) }).(*ssm.SSM)
+}
+
+func (client *AWSClient) SSMContactsConn() *ssmcontacts.SSMContacts {
+	return client.conn(SSMContacts, func(sess *session.Session) interface{} { return ssmcontacts.New(sess) }).(*ssmcontacts.SSMContacts)
+}
+
+func (client *AWSClient) SSMIncidentsConn() *ssmincidents.SSMIncidents {
+	return client.conn(SSMIncidents, func(sess *session.Session) interface{} { return ssmincidents.New(sess) }).(*ssmincidents.SSMIncidents)
+}
+
+func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
+	return client.conn(SSOAdmin, func(sess *session.Session) interface{} {
+		conn := ssoadmin.New(sess)
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+				if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) SSOConn() *sso.SSO {
+	return client.conn(SSO, func(sess *session.Session) interface{} { return sso.New(sess) }).(*sso.SSO)
+}
+
+func (client *AWSClient) SSOOIDCConn() *ssooidc.SSOOIDC {
+	return client.conn(SSOOIDC, func(sess *session.Session) interface{} { return ssooidc.New(sess) }).(*ssooidc.SSOOIDC)
+}
+
+func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
+	return client.conn(StorageGateway, func(sess *session.Session) interface{} {
+		conn := storagegateway.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) STSConn() *sts.STS {
+	return client.conn(STS, func(sess *session.Session) interface{} { return sts.New(sess) }).(*sts.STS)
+}
+
+func (client *AWSClient) SupportConn() *support.Support {
+	return client.conn(Support, func(sess *session.Session) interface{} { return support.New(sess) }).(*support.Support)
+}
+
+func (client *AWSClient) SWFConn() *swf.SWF {
+	return client.conn(SWF, func(sess *session.Session) interface{} { return swf.New(sess) }).(*swf.SWF)
+}
+
+func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
+	return client.conn(Synthetics, func(sess *session.Session) interface{} { return synthetics.New(sess) }).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) TextractConn() *textract.Textract {
+	return client.conn(Textract, func(sess *session.Session) interface{} { return textract.New(sess) }).(*textract.Textract)
+}
+
+func (client *AWSClient) TimestreamQueryConn() *timestreamquery.TimestreamQuery {
+	return client.conn(TimestreamQuery, func(sess *session.Session) interface{} { return timestreamquery.New(sess) }).(*timestreamquery.TimestreamQuery)
+}
+
+func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
+	return client.conn(TimestreamWrite, func(sess *session.Session) interface{} { return timestreamwrite.New(sess) }).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) TranscribeConn() *transcribeservice.TranscribeService {
+	return client.conn(Transcribe, func(sess *session.Session) interface{} { return transcribeservice.New(sess) }).(*transcribeservice.TranscribeService)
+}
+
+func (client *AWSClient) TranscribeStreamingConn() *transcribestreamingservice.TranscribeStreamingService {
+	return client.conn(TranscribeStreaming, func(sess *session.Session) interface{} { return transcribestreamingservice.New(sess) }).(*transcribestreamingservice.TranscribeStreamingService)
+}
+
+func (client *AWSClient) TransferConn() *transfer.Transfer {
+	return client.conn(Transfer, func(sess *session.Session) interface{} { return transfer.New(sess) }).(*transfer.Transfer)
+}
+
+func (client *AWSClient) TranslateConn() *translate.Translate {
+	return client.conn(Translate, func(sess *session.Session) interface{} { return translate.New(sess) }).(*translate.Translate)
+}
+
+func (client *AWSClient) WAFConn() *waf.WAF {
+	return client.conn(WAF, func(sess *session.Session) interface{} { return waf.New(sess) }).(*waf.WAF)
+}
+
+func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
+	return client.conn(WAFRegional, func(sess *session.Session) interface{} { return wafregional.New(sess) }).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
+	return client.conn(WAFV2, func(sess *session.Session) interface{} {
+		conn := wafv2.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) WellArchitectedConn() *wellarchitected.WellArchitected {
+	return client.conn(WellArchitected, func(sess *session.Session) interface{} { return wellarchitected.New(sess) }).(*wellarchitected.WellArchitected)
+}
+
+func (client *AWSClient) WorkDocsConn() *workdocs.WorkDocs {
+	return client.conn(WorkDocs, func(sess *session.Session) interface{} { return workdocs.New(sess) }).(*workdocs.WorkDocs)
+}
+
+func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
+	return client.conn(WorkLink, func(sess *session.Session) interface{} { return worklink.New(sess) }).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
+	return client.conn(WorkMail, func(sess *session.Session) interface{} { return workmail.New(sess) }).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) WorkMailMessageFlowConn() *workmailmessageflow.WorkMailMessageFlow {
+	return client.conn(WorkMailMessageFlow, func(sess *session.Session) interface{} { return workmailmessageflow.New(sess) }).(*workmailmessageflow.WorkMailMessageFlow)
+}
+
+func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
+	return client.conn(WorkSpaces, func(sess *session.Session) interface{} { return workspaces.New(sess) }).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) XRayConn() *xray.XRay {
+	return client.conn(XRay, func(sess *session.Session) interface{} { return xray.New(sess) }).(*xray.XRay)
+}
+
+// Client configures and returns a fully initialized AWSClient
+func (c *Config) Client() (interface{}, error) {
+	// Get the auth and region. This can fail if keys/regions were not
+	// specified and we're attempting to use the environment.
+	if !c.SkipRegionValidation {
+		if err := awsbase.ValidateRegion(c.Region); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateRegionAllowed(c.Region, c.AllowedRegions, c.ForbiddenRegions); err != nil {
+		return nil, err
+	}
+
+	awsbaseConfig := &awsbase.Config{
+		AccessKey:               c.AccessKey,
+		CallerDocumentationURL:  "https://registry.terraform.io/providers/hashicorp/aws",
+		CallerName:              "Terraform AWS Provider",
+		CredsFilename:           c.CredsFilename,
+		DebugLogging:            logging.IsDebugOrHigher(),
+		IamEndpoint:             c.Endpoints[IAM],
+		Insecure:                c.Insecure,
+		HTTPProxy:               c.HTTPProxy,
+		MaxRetries:              c.MaxRetries,
+		Profile:                 c.Profile,
+		Region:                  c.Region,
+		SecretKey:               c.SecretKey,
+		SkipCredsValidation:     c.SkipCredsValidation,
+		SkipMetadataApiCheck:    c.SkipMetadataApiCheck,
+		SkipRequestingAccountId: c.SkipRequestingAccountId,
+		StsEndpoint:             c.Endpoints[STS],
+		Token:                   c.Token,
+		UserAgentProducts:       StdUserAgentProducts(c.TerraformVersion),
+	}
+
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	// Roles are assumed before any service client is created,
+	// so every client uses the credentials of the final role.
+	sessionConfig := &sessionConfig{
+		Config:                     awsbaseConfig,
+		AssumeRole:                 c.AssumeRole,
+		AssumeRoleWithWebIdentity:  c.AssumeRoleWithWebIdentity,
+		EC2MetadataServiceEndpoint: c.EC2MetadataServiceEndpoint,
+		UseDualStackEndpoint:       c.UseDualStackEndpoint,
+		UseFIPSEndpoint:            c.UseFIPSEndpoint,
+	}
+
+	if err := sessionConfig.EC2MetadataServiceEndpointMode.SetFromString(c.EC2MetadataServiceEndpointMode); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	sess, accountID, Partition, err := getSessionWithAccountIDAndPartition(sessionConfig, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	rateLimiters := installRateLimitHandlers(&sess.Handlers, c.RetryMode, c.RateLimits)
+
+	if c.ReadOnly {
+		installReadOnlyHandlers(&sess.Handlers)
+	}
+
+	if tracer := defaultAPICallTracer(); tracer != nil {
+		tracer.installHandlers(&sess.Handlers)
+	}
+
+	if accountID == "" {
+		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
+	}
+
+	if err := awsbase.ValidateAccountID(accountID, c.AllowedAccountIds, c.ForbiddenAccountIds); err != nil {
+		return nil, err
+	}
+
+	DNSSuffix := "amazonaws.com"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		DNSSuffix = p.DNSSuffix()
+	}
+
+	client := &AWSClient{
+		AccountID:         accountID,
+		DefaultTagsConfig: c.DefaultTagsConfig,
+		DNSSuffix:         DNSSuffix,
+		IgnoreTagsConfig:  c.IgnoreTagsConfig,
+		NamingConfig:      c.NamingConfig,
+		Partition:         Partition,
+		Region:            c.Region,
+		ReverseDNSPrefix:  ReverseDNS(DNSSuffix),
+		TagPolicyConfig:   c.TagPolicyConfig,
+		TerraformVersion:  c.TerraformVersion,
+
+		endpoints:            c.Endpoints,
+		rateLimiters:         rateLimiters,
+		readOnly:             c.ReadOnly,
+		s3ForcePathStyle:     c.S3ForcePathStyle,
+		session:              sess,
+		useDualStackEndpoint: c.UseDualStackEndpoint,
+		useFIPSEndpoint:      c.UseFIPSEndpoint,
+	}
+
+	if err := validateOrganizationID(client.OrganizationsConn(), accountID, c.AllowedOrganizationIds); err != nil {
+		return nil, err
+	}
+
+	if c.UseOrganizationsTagPolicy {
+		tagPolicyConfig, err := organizationsTagPolicyConfig(client.OrganizationsConn(), accountID, c.TagPolicyConfig)
+
+		if err != nil {
+			return nil, err
+		}
+
+		client.TagPolicyConfig = tagPolicyConfig
+	}
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.SupportedPlatforms = supportedPlatforms
+		}
+	}
+
+	return client, nil
+}
+
+func StdUserAgentProducts(terraformVersion string) []*awsbase.UserAgentProduct {
+	return []*awsbase.UserAgentProduct{
+		{Name: "APN", Version: "1.0"},
+		{Name: "HashiCorp", Version: "1.0"},
+		{Name: "Terraform", Version: terraformVersion, Extra: []string{"+https://www.terraform.io"}},
+		{Name: "terraform-provider-aws", Version: version.ProviderVersion, Extra: []string{"+https://registry.terraform.io/providers/hashicorp/aws"}},
+	}
+}
+
+func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*session.Session, error) {
+	session, err := newSession(session.Options{Config: *cfg})
+
+	if err != nil {
+		return nil, err
+	}
+
+	userAgentProducts := StdUserAgentProducts(terraformVersion)
+	// Copied from github.com/hashicorp/aws-sdk-go-base@v1.0.0/session.go:
+	for i := len(userAgentProducts) - 1; i >= 0; i-- {
+		product := userAgentProducts[i]
+		session.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	return session.Copy(&aws.Config{Region: aws.String(region)}), nil
+}
+
+// NewSessionForRegion returns a session for the specified region as NewSessionForRegion does,
+// also adding the rate limiting, read-only and API call tracing request handlers of the client's own session.
+// Requests made with the session share the client's per-service rate limits.
+func (client *AWSClient) NewSessionForRegion(cfg *aws.Config, region string) (*session.Session, error) {
+	sess, err := NewSessionForRegion(cfg, region, client.TerraformVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client.rateLimiters.installHandlers(&sess.Handlers)
+
+	if client.readOnly {
+		installReadOnlyHandlers(&sess.Handlers)
+	}
+
+	if tracer := defaultAPICallTracer(); tracer != nil {
+		tracer.installHandlers(&sess.Handlers)
+	}
+
+	if client.tracedResource != nil {
+		installTracedResourceHandler(&sess.Handlers, *client.tracedResource)
+	}
+
+	return sess, nil
 }
 
 func HasEC2Classic(platforms []string) bool {
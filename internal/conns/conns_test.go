@@ -1,11 +1,20 @@
 package conns
 
 import (
+	"errors"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -82,6 +91,202 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientConn(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		endpoints: map[string]string{
+			EC2: "https://ec2.example.com",
+		},
+		session: sess,
+	}
+
+	var wg sync.WaitGroup
+	ec2Conns := make([]*ec2.EC2, 10)
+	for i := range ec2Conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ec2Conns[i] = client.EC2Conn()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, conn := range ec2Conns {
+		if conn != ec2Conns[0] {
+			t.Fatalf("expected a single cached EC2 client, got %p and %p", conn, ec2Conns[0])
+		}
+	}
+
+	if got, expected := ec2Conns[0].Endpoint, "https://ec2.example.com"; got != expected {
+		t.Errorf("EC2 endpoint: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.IAMConn().Config.Region), endpoints.UsWest2RegionID; got != expected {
+		t.Errorf("IAM region: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.Route53Conn().Config.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("Route 53 region: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(client.conns), 3; got != expected {
+		t.Errorf("cached clients: got %d, expected %d", got, expected)
+	}
+}
+
+func TestAWSClientMediaConvertAccountConn(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		session:   sess,
+	}
+
+	if _, err := client.MediaConvertAccountConn(func(*AWSClient) (*mediaconvert.MediaConvert, error) {
+		return nil, errors.New("test error")
+	}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	var calls int32
+	var wg sync.WaitGroup
+	mediaConvertConns := make([]*mediaconvert.MediaConvert, 10)
+	for i := range mediaConvertConns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conn, err := client.MediaConvertAccountConn(func(client *AWSClient) (*mediaconvert.MediaConvert, error) {
+				atomic.AddInt32(&calls, 1)
+				// Creating the client uses the other service clients.
+				return mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(client.MediaConvertConn().Endpoint)})), nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			mediaConvertConns[i] = conn
+		}(i)
+	}
+	wg.Wait()
+
+	if got, expected := atomic.LoadInt32(&calls), int32(1); got != expected {
+		t.Errorf("newConn calls: got %d, expected %d", got, expected)
+	}
+
+	for _, conn := range mediaConvertConns {
+		if conn != mediaConvertConns[0] {
+			t.Fatalf("expected a single cached MediaConvert account client, got %p and %p", conn, mediaConvertConns[0])
+		}
+	}
+}
+
+func TestAWSClientWithDefaultTagsConfig(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defaultTagsConfig := &tftags.DefaultConfig{Tags: tftags.New(map[string]string{"Owner": "finops"})}
+	client := &AWSClient{
+		DefaultTagsConfig: defaultTagsConfig,
+		Region:            endpoints.UsWest2RegionID,
+		session:           sess,
+	}
+
+	resourceDefaultTagsConfig := &tftags.DefaultConfig{Tags: tftags.New(map[string]string{"Owner": "devops"})}
+	derived := client.WithDefaultTagsConfig(resourceDefaultTagsConfig)
+
+	if derived.DefaultTagsConfig != resourceDefaultTagsConfig {
+		t.Error("expected derived client to have the resource's default tags configuration")
+	}
+
+	if client.DefaultTagsConfig != defaultTagsConfig {
+		t.Error("expected client's default tags configuration not to be modified")
+	}
+
+	if got, expected := derived.Region, client.Region; got != expected {
+		t.Errorf("region: got %s, expected %s", got, expected)
+	}
+
+	// Service clients are shared with the client derived from.
+	if derived.EC2Conn() != client.EC2Conn() {
+		t.Error("expected a single cached EC2 client")
+	}
+}
+
+func TestAWSClientConnFIPSAndDualStackEndpoints(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+		Region:      aws.String(endpoints.UsEast1RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name                 string
+		UseDualStackEndpoint bool
+		UseFIPSEndpoint      bool
+		Expected             string
+	}{
+		{
+			Name:     "default",
+			Expected: "https://sqs.us-east-1.amazonaws.com",
+		},
+		{
+			Name:            "FIPS",
+			UseFIPSEndpoint: true,
+			Expected:        "https://sqs-fips.us-east-1.amazonaws.com",
+		},
+		{
+			Name:                 "DualStack",
+			UseDualStackEndpoint: true,
+			Expected:             "https://sqs.us-east-1.api.aws",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &AWSClient{
+				Partition: endpoints.AwsPartitionID,
+				Region:    endpoints.UsEast1RegionID,
+				endpoints: map[string]string{
+					SNS: "https://sns.example.com",
+				},
+				session:              sess,
+				useDualStackEndpoint: testCase.UseDualStackEndpoint,
+				useFIPSEndpoint:      testCase.UseFIPSEndpoint,
+			}
+
+			if got := client.SQSConn().Endpoint; got != testCase.Expected {
+				t.Errorf("SQS endpoint: got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got, expected := client.SNSConn().Endpoint, "https://sns.example.com"; got != expected {
+				t.Errorf("SNS endpoint: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
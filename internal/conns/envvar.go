@@ -10,6 +10,9 @@ import (
 // Standard AWS environment variables used in the Terraform AWS Provider testing.
 // These are not provided as constants in the AWS Go SDK currently.
 const (
+	// Path to a custom certificate authority bundle (AWS Go SDK does not provide this as constant)
+	EnvVarCABundle = "AWS_CA_BUNDLE"
+
 	// Default static credential identifier for tests (AWS Go SDK does not provide this as constant)
 	// See also AWS_SECRET_ACCESS_KEY and AWS_PROFILE
 	EnvVarAccessKeyId = "AWS_ACCESS_KEY_ID"
@@ -18,15 +21,30 @@ const (
 	// See also AWS_ACCESS_KEY_ID and AWS_PROFILE
 	EnvVarContainerCredentialsFullUri = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
 
+	// EC2 Instance Metadata Service endpoint (AWS Go SDK does not provide this as constant)
+	EnvVarEC2MetadataServiceEndpoint = "AWS_EC2_METADATA_SERVICE_ENDPOINT"
+
+	// EC2 Instance Metadata Service endpoint mode, IPv4 or IPv6 (AWS Go SDK does not provide this as constant)
+	EnvVarEC2MetadataServiceEndpointMode = "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE"
+
 	// Default AWS region for tests (AWS Go SDK does not provide this as constant)
 	EnvVarDefaultRegion = "AWS_DEFAULT_REGION"
 
 	// Default AWS shared configuration profile for tests (AWS Go SDK does not provide this as constant)
 	EnvVarProfile = "AWS_PROFILE"
 
+	// Retry mode for AWS API requests, standard or adaptive (AWS Go SDK does not provide this as constant)
+	EnvVarRetryMode = "AWS_RETRY_MODE"
+
 	// Default static credential value for tests (AWS Go SDK does not provide this as constant)
 	// See also AWS_ACCESS_KEY_ID and AWS_PROFILE
 	EnvVarSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+
+	// Enables dual-stack (IPv4 and IPv6) endpoint resolution (AWS Go SDK does not provide this as constant)
+	EnvVarUseDualStackEndpoint = "AWS_USE_DUALSTACK_ENDPOINT"
+
+	// Enables FIPS endpoint resolution (AWS Go SDK does not provide this as constant)
+	EnvVarUseFIPSEndpoint = "AWS_USE_FIPS_ENDPOINT"
 )
 
 // Custom environment variables used in the Terraform AWS Provider testing.
@@ -72,6 +90,24 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for tracing AWS API calls
+const (
+	// Enables AWS API call tracing using the named exporter, jsonl or otlp
+	EnvVarAPITrace = "TF_AWS_API_TRACE"
+
+	// Path of the JSON Lines file API call records are appended to by the jsonl exporter
+	EnvVarAPITraceFile = "TF_AWS_API_TRACE_FILE"
+
+	// Path of the file the API call summary is appended to when the provider stops
+	EnvVarAPITraceSummaryFile = "TF_AWS_API_TRACE_SUMMARY_FILE"
+
+	// Base URL of the OpenTelemetry collector used by the otlp exporter
+	EnvVarOTLPEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	// URL of the OpenTelemetry collector traces endpoint used by the otlp exporter, overriding OTEL_EXPORTER_OTLP_ENDPOINT
+	EnvVarOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,307 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	RetryModeAdaptive = "adaptive"
+	RetryModeStandard = "standard"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeAdaptive,
+		RetryModeStandard,
+	}
+}
+
+const (
+	// adaptiveRateBackoff is the factor by which the request rate is reduced after a throttled request.
+	adaptiveRateBackoff = 0.7
+	// adaptiveRateIncrement is the number of requests per second added back after a successful request.
+	adaptiveRateIncrement = 0.5
+	// adaptiveRateMinimum is the lowest request rate, in requests per second, adaptive mode will reduce to.
+	adaptiveRateMinimum = 0.5
+)
+
+// ServiceRateLimit is a client-side limit on the rate of a service's API requests.
+type ServiceRateLimit struct {
+	Burst             int
+	RequestsPerSecond float64
+}
+
+// rateLimiter is a token bucket limiting the rate of API requests.
+// A zero rate means requests are not limited.
+//
+// In adaptive mode the rate is reduced each time a request is throttled and
+// is restored gradually as requests succeed, lifting the limit altogether
+// when no rate is configured.
+type rateLimiter struct {
+	adaptive bool
+	burst    float64
+	ceiling  float64
+	maxRate  float64
+	rate     float64
+	tokens   float64
+	last     time.Time
+
+	// Requests sent in the current one second window, used to measure
+	// the request rate before any limit is in effect.
+	count        int
+	measuredRate float64
+	windowStart  time.Time
+
+	lock sync.Mutex
+	now  func() time.Time
+}
+
+func newRateLimiter(limit ServiceRateLimit, adaptive bool) *rateLimiter {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimiter{
+		adaptive: adaptive,
+		burst:    burst,
+		maxRate:  limit.RequestsPerSecond,
+		rate:     limit.RequestsPerSecond,
+		tokens:   burst,
+		now:      time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	l.measure(now)
+
+	if l.rate == 0 {
+		return 0
+	}
+
+	l.refill(now)
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// wait blocks until a request may be sent or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttled reduces the request rate after a throttled request in adaptive mode.
+func (l *rateLimiter) throttled() {
+	if !l.adaptive {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	rate := l.rate
+
+	if rate == 0 {
+		// Requests in the current window are a lower bound on the rate while it is being measured.
+		rate = math.Max(l.measuredRate, float64(l.count)/math.Max(now.Sub(l.windowStart).Seconds(), 1))
+		l.tokens = 0
+	} else {
+		l.refill(now)
+	}
+
+	l.ceiling = math.Max(rate, adaptiveRateMinimum)
+	l.rate = math.Max(l.ceiling*adaptiveRateBackoff, adaptiveRateMinimum)
+	l.last = now
+
+	log.Printf("[DEBUG] Request throttled, reducing request rate to %.2f/s", l.rate)
+}
+
+// succeeded gradually restores the request rate after a successful request in adaptive mode.
+func (l *rateLimiter) succeeded() {
+	if !l.adaptive {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.rate == 0 || (l.maxRate != 0 && l.rate >= l.maxRate) {
+		return
+	}
+
+	l.refill(l.now())
+	l.rate += adaptiveRateIncrement
+
+	if l.maxRate != 0 {
+		l.rate = math.Min(l.rate, l.maxRate)
+	} else if l.rate >= l.ceiling {
+		l.rate = 0
+	}
+}
+
+func (l *rateLimiter) refill(now time.Time) {
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+
+	l.last = now
+}
+
+func (l *rateLimiter) measure(now time.Time) {
+	if elapsed := now.Sub(l.windowStart); elapsed >= time.Second {
+		if !l.windowStart.IsZero() {
+			l.measuredRate = float64(l.count) / elapsed.Seconds()
+		}
+
+		l.count = 0
+		l.windowStart = now
+	}
+
+	l.count++
+}
+
+// rateLimiters holds the rate limiter for each service and region whose requests are limited.
+// Each region's endpoint of a service throttles requests independently, so each has its own token bucket.
+type rateLimiters struct {
+	adaptive bool
+	limits   map[string]ServiceRateLimit
+
+	limiters     map[string]*rateLimiter
+	limitersLock sync.Mutex
+}
+
+// limiter returns the rate limiter for the service with the specified AWS service ID in the region,
+// or nil if the service's requests are not limited.
+func (l *rateLimiters) limiter(serviceID, region string) *rateLimiter {
+	l.limitersLock.Lock()
+	defer l.limitersLock.Unlock()
+
+	key := serviceID + "/" + region
+
+	if limiter, ok := l.limiters[key]; ok {
+		return limiter
+	}
+
+	var limiter *rateLimiter
+	limit, ok := l.limits[serviceForServiceID(serviceID)]
+
+	if ok || l.adaptive {
+		limiter = newRateLimiter(limit, l.adaptive)
+	}
+
+	l.limiters[key] = limiter
+
+	return limiter
+}
+
+// installRateLimitHandlers adds request handlers that apply client-side rate
+// limits and, in adaptive retry mode, slow down requests to services that
+// throttle them.
+// It returns the rate limiters so that the same limits can be applied to other
+// sessions, or nil if requests are not rate limited.
+func installRateLimitHandlers(handlers *request.Handlers, retryMode string, limits map[string]ServiceRateLimit) *rateLimiters {
+	adaptive := retryMode == RetryModeAdaptive
+
+	if !adaptive && len(limits) == 0 {
+		return nil
+	}
+
+	l := &rateLimiters{
+		adaptive: adaptive,
+		limits:   limits,
+		limiters: make(map[string]*rateLimiter),
+	}
+
+	l.installHandlers(handlers)
+
+	return l
+}
+
+// installHandlers adds the rate limiting request handlers, sharing the
+// limiters' per-service and per-region token buckets with any other sessions they are installed on.
+func (l *rateLimiters) installHandlers(handlers *request.Handlers) {
+	if l == nil {
+		return
+	}
+
+	// Requests are rate limited before each attempt is signed
+	// so that a long wait cannot invalidate the signature.
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.RateLimitHandler",
+		Fn: func(r *request.Request) {
+			limiter := l.limiter(r.ClientInfo.ServiceID, aws.StringValue(r.Config.Region))
+
+			if limiter == nil {
+				return
+			}
+
+			if err := limiter.wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+			}
+		},
+	})
+
+	if !l.adaptive {
+		return
+	}
+
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.AdaptiveRetryHandler",
+		Fn: func(r *request.Request) {
+			limiter := l.limiter(r.ClientInfo.ServiceID, aws.StringValue(r.Config.Region))
+
+			if limiter == nil {
+				return
+			}
+
+			if request.IsErrorThrottle(r.Error) {
+				limiter.throttled()
+			} else if r.Error == nil {
+				limiter.succeeded()
+			}
+		},
+	})
+}
+
+// serviceForServiceID returns the service key for the specified AWS service ID.
+func serviceForServiceID(serviceID string) string {
+	for k, v := range serviceData {
+		if v.AWSServiceID == serviceID {
+			return k
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,265 @@
+package conns
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestRateLimiter(limit ServiceRateLimit, adaptive bool) (*rateLimiter, *testClock) {
+	clock := &testClock{now: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := newRateLimiter(limit, adaptive)
+	limiter.now = clock.Now
+
+	return limiter, clock
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter, clock := newTestRateLimiter(ServiceRateLimit{Burst: 2, RequestsPerSecond: 4}, false)
+
+	for i, expected := range []time.Duration{0, 0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if got := limiter.reserve(); got != expected {
+			t.Errorf("request %d: got delay %s, expected %s", i, got, expected)
+		}
+	}
+
+	clock.Advance(time.Second)
+
+	// Four tokens were added back, two of which were already reserved.
+	for i, expected := range []time.Duration{0, 0, 250 * time.Millisecond} {
+		if got := limiter.reserve(); got != expected {
+			t.Errorf("request %d after refill: got delay %s, expected %s", i, got, expected)
+		}
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter, _ := newTestRateLimiter(ServiceRateLimit{}, false)
+
+	for i := 0; i < 100; i++ {
+		if got := limiter.reserve(); got != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	limiter.throttled()
+
+	if limiter.rate != 0 {
+		t.Errorf("standard mode: got rate %v after throttling, expected unlimited", limiter.rate)
+	}
+}
+
+func TestRateLimiterWaitContextCanceled(t *testing.T) {
+	limiter, _ := newTestRateLimiter(ServiceRateLimit{RequestsPerSecond: 0.1}, false)
+
+	if err := limiter.wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := limiter.wait(ctx); err != context.Canceled {
+		t.Errorf("got error %v, expected %v", err, context.Canceled)
+	}
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	limiter, clock := newTestRateLimiter(ServiceRateLimit{RequestsPerSecond: 10}, true)
+
+	limiter.throttled()
+
+	if got, expected := limiter.rate, 7.0; got != expected {
+		t.Errorf("got rate %v after throttling, expected %v", got, expected)
+	}
+
+	limiter.throttled()
+
+	if got, expected := limiter.rate, 4.9; math.Abs(got-expected) > 1e-9 {
+		t.Errorf("got rate %v after throttling twice, expected %v", got, expected)
+	}
+
+	for i := 0; i < 100; i++ {
+		clock.Advance(time.Second)
+		limiter.succeeded()
+	}
+
+	if got, expected := limiter.rate, 10.0; got != expected {
+		t.Errorf("got rate %v after recovering, expected configured rate %v", got, expected)
+	}
+}
+
+func TestRateLimiterAdaptiveUnlimited(t *testing.T) {
+	limiter, clock := newTestRateLimiter(ServiceRateLimit{}, true)
+
+	// Measure a rate of 20 requests per second.
+	for i := 0; i < 40; i++ {
+		limiter.reserve()
+		clock.Advance(50 * time.Millisecond)
+	}
+
+	limiter.throttled()
+
+	if got, expected := limiter.rate, 14.0; math.Abs(got-expected) > 1e-9 {
+		t.Errorf("got rate %v after throttling, expected %v", got, expected)
+	}
+
+	if got := limiter.reserve(); got == 0 {
+		t.Error("expected request to be delayed after throttling")
+	}
+
+	for i := 0; i < 100; i++ {
+		clock.Advance(time.Second)
+		limiter.succeeded()
+	}
+
+	if limiter.rate != 0 {
+		t.Errorf("got rate %v after recovering, expected unlimited", limiter.rate)
+	}
+}
+
+func TestInstallRateLimitHandlers(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		RetryMode string
+		Limits    map[string]ServiceRateLimit
+		Expected  map[string]bool
+		Installed bool
+	}{
+		{
+			Name:      "standard",
+			RetryMode: RetryModeStandard,
+		},
+		{
+			Name:      "standard with limits",
+			RetryMode: RetryModeStandard,
+			Limits:    map[string]ServiceRateLimit{EC2: {RequestsPerSecond: 20}},
+			Expected:  map[string]bool{ec2.ServiceID: true, route53.ServiceID: false, sqs.ServiceID: false},
+			Installed: true,
+		},
+		{
+			Name:      "adaptive",
+			RetryMode: RetryModeAdaptive,
+			Expected:  map[string]bool{ec2.ServiceID: true, route53.ServiceID: true, sqs.ServiceID: true},
+			Installed: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			handlers := request.Handlers{}
+
+			installed := installRateLimitHandlers(&handlers, testCase.RetryMode, testCase.Limits)
+
+			if got := handlers.Sign.Len() > 0; got != testCase.Installed {
+				t.Fatalf("got handler installed %t, expected %t", got, testCase.Installed)
+			}
+
+			if got := installed != nil; got != testCase.Installed {
+				t.Errorf("got rate limiters returned %t, expected %t", got, testCase.Installed)
+			}
+
+			if got, expected := handlers.CompleteAttempt.Len() > 0, testCase.RetryMode == RetryModeAdaptive; got != expected {
+				t.Errorf("got adaptive handler installed %t, expected %t", got, expected)
+			}
+
+			l := &rateLimiters{
+				adaptive: testCase.RetryMode == RetryModeAdaptive,
+				limits:   testCase.Limits,
+				limiters: make(map[string]*rateLimiter),
+			}
+
+			for serviceID, expected := range testCase.Expected {
+				if got := l.limiter(serviceID, endpoints.UsWest2RegionID) != nil; got != expected {
+					t.Errorf("%s: got rate limited %t, expected %t", serviceID, got, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestAWSClientNewSessionForRegionRateLimits(t *testing.T) {
+	limits := map[string]ServiceRateLimit{EC2: {RequestsPerSecond: 20}}
+	handlers := request.Handlers{}
+	client := &AWSClient{
+		rateLimiters: installRateLimitHandlers(&handlers, RetryModeAdaptive, limits),
+	}
+
+	sess, err := client.NewSessionForRegion(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+	}, endpoints.UsEast1RegionID)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unlimitedSess, err := (&AWSClient{}).NewSessionForRegion(&aws.Config{
+		Credentials: credentials.AnonymousCredentials,
+	}, endpoints.UsEast1RegionID)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := sess.Handlers.Sign.Len(), unlimitedSess.Handlers.Sign.Len()+1; got != expected {
+		t.Errorf("got %d Sign handlers, expected %d", got, expected)
+	}
+
+	if got, expected := sess.Handlers.CompleteAttempt.Len(), unlimitedSess.Handlers.CompleteAttempt.Len()+1; got != expected {
+		t.Errorf("got %d CompleteAttempt handlers, expected %d", got, expected)
+	}
+
+	// Requests made with the region's session use the client's token buckets.
+	req, _ := ec2.New(sess).DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+
+	if err := req.Sign(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := client.rateLimiters.limiters[ec2.ServiceID+"/"+endpoints.UsEast1RegionID]; !ok {
+		t.Errorf("expected the client's %s rate limiter for %s to be used", ec2.ServiceID, endpoints.UsEast1RegionID)
+	}
+}
+
+func TestRateLimitersLimiterRegions(t *testing.T) {
+	l := &rateLimiters{
+		limits:   map[string]ServiceRateLimit{EC2: {RequestsPerSecond: 20}},
+		limiters: make(map[string]*rateLimiter),
+	}
+
+	usEast1 := l.limiter(ec2.ServiceID, endpoints.UsEast1RegionID)
+	usWest2 := l.limiter(ec2.ServiceID, endpoints.UsWest2RegionID)
+
+	if usEast1 == nil || usWest2 == nil {
+		t.Fatal("expected requests to be rate limited")
+	}
+
+	if usEast1 == usWest2 {
+		t.Error("expected each region to have its own rate limiter")
+	}
+
+	if l.limiter(ec2.ServiceID, endpoints.UsEast1RegionID) != usEast1 {
+		t.Error("expected the region's rate limiter to be reused")
+	}
+}
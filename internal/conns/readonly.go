@@ -0,0 +1,89 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// ErrCodeReadOnlyOperation is the error code of requests rejected because the
+// provider is configured to be read-only.
+const ErrCodeReadOnlyOperation = "ReadOnlyOperation"
+
+// readOnlyOperationPrefixes are the prefixes of the names of operations that
+// do not modify anything, in any service.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Search",
+}
+
+// readOnlyOperations are, by AWS service ID, the operations that do not modify
+// anything but whose names do not start with a read-only prefix.
+var readOnlyOperations = map[string][]string{
+	cloudformation.ServiceID:   {"EstimateTemplateCost", "ValidateTemplate"},
+	dynamodb.ServiceID:         {"Query", "Scan"},
+	elasticbeanstalk.ServiceID: {"CheckDNSAvailability"},
+	iam.ServiceID:              {"SimulateCustomPolicy", "SimulatePrincipalPolicy"},
+	kms.ServiceID:              {"Decrypt", "Encrypt", "Verify"},
+	s3.ServiceID:               {"SelectObjectContent"},
+	sts.ServiceID:              {"AssumeRole", "AssumeRoleWithSAML", "AssumeRoleWithWebIdentity", "DecodeAuthorizationMessage"},
+}
+
+// mutatingOperations are, by AWS service ID, the operations that modify
+// something in spite of their names starting with a read-only prefix.
+var mutatingOperations = map[string][]string{
+	cognitoidentity.ServiceID: {"GetId", "GetOpenIdTokenForDeveloperIdentity"},
+}
+
+// IsReadOnlyOperation returns whether the operation of the service with the specified AWS service ID does not modify anything.
+func IsReadOnlyOperation(serviceID, operation string) bool {
+	for _, v := range mutatingOperations[serviceID] {
+		if operation == v {
+			return false
+		}
+	}
+
+	for _, v := range readOnlyOperations[serviceID] {
+		if operation == v {
+			return true
+		}
+	}
+
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(operation, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// installReadOnlyHandlers adds a request handler that rejects, before they are
+// sent, any requests for operations that may modify anything.
+func installReadOnlyHandlers(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.ReadOnlyHandler",
+		Fn: func(r *request.Request) {
+			if IsReadOnlyOperation(r.ClientInfo.ServiceID, r.Operation.Name) {
+				return
+			}
+
+			r.Error = awserr.New(ErrCodeReadOnlyOperation, fmt.Sprintf("%s %s is not a read-only operation and the provider is configured with read_only", r.ClientInfo.ServiceID, r.Operation.Name), nil)
+		},
+	})
+}
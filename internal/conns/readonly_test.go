@@ -0,0 +1,105 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	testCases := []struct {
+		ServiceID string
+		Operation string
+		Expected  bool
+	}{
+		{ServiceID: ec2.ServiceID, Operation: "DescribeVpcs", Expected: true},
+		{ServiceID: ec2.ServiceID, Operation: "GetPasswordData", Expected: true},
+		{ServiceID: ec2.ServiceID, Operation: "CreateVpc", Expected: false},
+		{ServiceID: ec2.ServiceID, Operation: "ModifyVpcAttribute", Expected: false},
+		{ServiceID: ec2.ServiceID, Operation: "CreateTags", Expected: false},
+		{ServiceID: dynamodb.ServiceID, Operation: "Query", Expected: true},
+		{ServiceID: dynamodb.ServiceID, Operation: "PutItem", Expected: false},
+		{ServiceID: kms.ServiceID, Operation: "Decrypt", Expected: true},
+		{ServiceID: kms.ServiceID, Operation: "ScheduleKeyDeletion", Expected: false},
+		{ServiceID: cognitoidentity.ServiceID, Operation: "GetId", Expected: false},
+		{ServiceID: cognitoidentity.ServiceID, Operation: "GetIdentityPoolRoles", Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		if got := IsReadOnlyOperation(testCase.ServiceID, testCase.Operation); got != testCase.Expected {
+			t.Errorf("%s %s: got %t, expected %t", testCase.ServiceID, testCase.Operation, got, testCase.Expected)
+		}
+	}
+}
+
+func TestConfigClientReadOnly(t *testing.T) {
+	var actions []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		actions = append(actions, r.Form.Get("Action"))
+
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintln(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		AccessKey:           awsbase.MockStaticAccessKey,
+		Endpoints:           map[string]string{EC2: server.URL, STS: server.URL},
+		MaxRetries:          1,
+		ReadOnly:            true,
+		Region:              endpoints.UsEast1RegionID,
+		SecretKey:           awsbase.MockStaticSecretKey,
+		SkipGetEC2Platforms: true,
+	}
+
+	raw, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if _, err := client.STSConn().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = client.EC2Conn().CreateVpc(&ec2.CreateVpcInput{})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperation) {
+		t.Fatalf("got error %v, expected %s", err, ErrCodeReadOnlyOperation)
+	}
+
+	// Sessions for other regions also reject operations that are not read-only.
+	sess, err := client.NewSessionForRegion(&client.EC2Conn().Config, endpoints.UsWest2RegionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = ec2.New(sess).CreateVpc(&ec2.CreateVpcInput{})
+
+	if !tfawserr.ErrCodeEquals(err, ErrCodeReadOnlyOperation) {
+		t.Fatalf("other region: got error %v, expected %s", err, ErrCodeReadOnlyOperation)
+	}
+
+	for _, action := range actions {
+		if action == "CreateVpc" {
+			t.Errorf("expected CreateVpc not to be sent, got requests %q", actions)
+		}
+	}
+}
@@ -0,0 +1,589 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	EC2MetadataServiceEndpointModeIPv4 = "IPv4"
+	EC2MetadataServiceEndpointModeIPv6 = "IPv6"
+)
+
+func EC2MetadataServiceEndpointMode_Values() []string {
+	return []string{
+		EC2MetadataServiceEndpointModeIPv4,
+		EC2MetadataServiceEndpointModeIPv6,
+	}
+}
+
+// httpClient returns the HTTP client used by every provider session,
+// configured with the provider's TLS and proxy settings.
+// If set, Config.HTTPTransport wraps the client's transport, e.g. to record AWS API interactions.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+
+	if c.Insecure || c.CustomCABundle != "" {
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: c.Insecure,
+		}
+	}
+
+	if c.CustomCABundle != "" {
+		filename, err := homedir.Expand(c.CustomCABundle)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding custom CA bundle filename: %w", err)
+		}
+
+		pem, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("error loading custom CA bundle (%s): no PEM certificates found", filename)
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	proxyConfig := httpproxy.FromEnvironment()
+
+	if c.HTTPProxy != "" {
+		if _, err := url.Parse(c.HTTPProxy); err != nil {
+			return nil, fmt.Errorf("error parsing HTTP proxy URL: %w", err)
+		}
+
+		proxyConfig.HTTPProxy = c.HTTPProxy
+		proxyConfig.HTTPSProxy = c.HTTPProxy
+	}
+
+	if len(c.NoProxy) > 0 {
+		proxyConfig.NoProxy = strings.Join(c.NoProxy, ",")
+	}
+
+	proxyFunc := proxyConfig.ProxyFunc()
+	transport.Proxy = func(r *http.Request) (*url.URL, error) {
+		return proxyFunc(r.URL)
+	}
+
+	if c.HTTPTransport != nil {
+		client.Transport = c.HTTPTransport(transport)
+	}
+
+	return client, nil
+}
+
+// newSession returns a new AWS Go SDK session.
+//
+// The AWS Go SDK replaces the root certificate authorities of the session's HTTP transport
+// when a CA bundle is set in the environment (AWS_CA_BUNDLE) or shared configuration.
+// If the transport already trusts a custom CA bundle, the session is given its own copy
+// of the transport so that the custom CA bundle takes precedence and the original is never modified.
+func newSession(options session.Options) (*session.Session, error) {
+	client := options.Config.HTTPClient
+
+	if client == nil {
+		return session.NewSessionWithOptions(options)
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+
+	if !ok || transport.TLSClientConfig == nil || transport.TLSClientConfig.RootCAs == nil {
+		return session.NewSessionWithOptions(options)
+	}
+
+	rootCAs := transport.TLSClientConfig.RootCAs
+	transport = transport.Clone()
+	client = &http.Client{
+		CheckRedirect: client.CheckRedirect,
+		Jar:           client.Jar,
+		Timeout:       client.Timeout,
+		Transport:     transport,
+	}
+	options.Config.HTTPClient = client
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transport.TLSClientConfig.RootCAs = rootCAs
+
+	return sess, nil
+}
+
+// sessionConfig is the configuration of the provider's base session:
+// the awsbase configuration along with settings awsbase does not support.
+type sessionConfig struct {
+	*awsbase.Config
+
+	AssumeRole                     []AssumeRole
+	AssumeRoleWithWebIdentity      *AssumeRoleWithWebIdentity
+	EC2MetadataServiceEndpoint     string
+	EC2MetadataServiceEndpointMode endpoints.EC2IMDSEndpointModeState
+	UseDualStackEndpoint           bool
+	UseFIPSEndpoint                bool
+}
+
+// setEndpointVariants enables FIPS and dual-stack endpoint resolution in the session configuration,
+// unless a custom endpoint is configured for one of the services the session sends requests to.
+func (c *sessionConfig) setEndpointVariants(config *aws.Config, customEndpoints ...string) {
+	for _, endpoint := range customEndpoints {
+		if endpoint != "" {
+			return
+		}
+	}
+
+	if c.UseDualStackEndpoint {
+		config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+
+	if c.UseFIPSEndpoint {
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+}
+
+// getSessionWithAccountIDAndPartition returns the provider's base AWS Go SDK session
+// along with account ID and partition information if available.
+//
+// It follows awsbase.GetSessionWithAccountIDAndPartition, except that every request,
+// including those made while assuming a role or validating credentials,
+// is sent using the provider's HTTP client.
+func getSessionWithAccountIDAndPartition(c *sessionConfig, httpClient *http.Client) (*session.Session, string, string, error) {
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	creds, err := getCredentials(c, httpClient)
+
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	options := session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			Credentials:                   creds,
+			EndpointResolver:              c.EndpointResolver(),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		EC2IMDSEndpoint:     c.EC2MetadataServiceEndpoint,
+		EC2IMDSEndpointMode: c.EC2MetadataServiceEndpointMode,
+		Profile:             c.Profile,
+		SharedConfigState:   session.SharedConfigEnable,
+	}
+
+	// The base session validates credentials and looks up the account ID using STS and IAM.
+	c.setEndpointVariants(&options.Config, c.StsEndpoint, c.IamEndpoint)
+
+	if c.DebugLogging {
+		options.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		options.Config.Logger = awsbase.DebugLogger{}
+	}
+
+	sess, err := newSession(options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, "", "", c.NewNoValidCredentialSourcesError(err)
+		}
+		return nil, "", "", fmt.Errorf("Error creating AWS session: %w", err)
+	}
+
+	if c.MaxRetries > 0 {
+		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	}
+
+	// The configuration of additional User-Agent header products should take
+	// precedence over the AWS SDK Go product, so they are pushed to the front
+	// of the build handlers in reverse order.
+	for i := len(c.UserAgentProducts) - 1; i >= 0; i-- {
+		product := c.UserAgentProducts[i]
+		sess.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	// Add custom input from ENV to the User-Agent request header
+	// Reference: https://github.com/terraform-providers/terraform-provider-aws/issues/9149
+	if v := os.Getenv(awsbase.AppendUserAgentEnvVar); v != "" {
+		log.Printf("[DEBUG] Using additional User-Agent Info: %s", v)
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(v))
+	}
+
+	// Generally, we want to configure a lower retry theshold for networking issues
+	// as the session retry threshold is very high by default and can mask permanent
+	// networking failures, such as a non-existent service endpoint.
+	// MaxRetries will override this logic if it has a lower retry threshold.
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.RetryCount < awsbase.MaxNetworkRetryCount {
+			return
+		}
+		// RequestError: send request failed
+		// caused by: Post https://FQDN/: dial tcp: lookup FQDN: no such host
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "no such host") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+		// RequestError: send request failed
+		// caused by: Post https://FQDN/: dial tcp IPADDRESS:443: connect: connection refused
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "connection refused") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	stsClient := sts.New(sess)
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return nil, "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		if c.assumedRoleARN() == "" {
+			return sess, accountID, partition, nil
+		}
+	}
+
+	if roleARN := c.assumedRoleARN(); roleARN != "" {
+		accountID, partition, _ := parseAccountIDAndPartitionFromARN(roleARN)
+		return sess, accountID, partition, nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		// awsbase always uses the default EC2 Instance Metadata Service endpoint.
+		if credentialsProviderName == ec2rolecreds.ProviderName {
+			if info, err := ec2metadata.New(sess).IAMInfo(); err == nil {
+				if accountID, partition, err := parseAccountIDAndPartitionFromARN(info.InstanceProfileArn); err == nil {
+					return sess, accountID, partition, nil
+				}
+			}
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), stsClient, credentialsProviderName)
+
+		if err == nil {
+			return sess, accountID, partition, nil
+		}
+
+		return nil, "", "", fmt.Errorf(
+			"AWS account ID not previously found and failed retrieving via all available methods. "+
+				"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+				"Errors: %w", err)
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return sess, "", partition, nil
+}
+
+// getCredentials returns the provider's credentials, assuming the configured IAM Roles if any.
+func getCredentials(c *sessionConfig, httpClient *http.Client) (*credentials.Credentials, error) {
+	var creds *credentials.Credentials
+	var err error
+
+	if c.AssumeRoleWithWebIdentity != nil {
+		creds, err = getWebIdentityCredentials(c, httpClient)
+	} else {
+		creds, err = getSourceCredentials(c)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Each role in the chain is assumed using the credentials of the previous one.
+	for _, assumeRole := range c.AssumeRole {
+		creds, err = getAssumeRoleCredentials(c, httpClient, creds, assumeRole)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creds, nil
+}
+
+// getAssumeRoleCredentials returns credentials for the IAM Role, assumed using the specified credentials.
+func getAssumeRoleCredentials(c *sessionConfig, httpClient *http.Client, creds *credentials.Credentials, assumeRole AssumeRole) (*credentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)",
+		assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+
+	sess, err := c.stsSession(creds, httpClient)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role session: %w", err)
+	}
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(sess),
+		RoleARN: assumeRole.RoleARN,
+	}
+
+	if assumeRole.DurationSeconds > 0 {
+		provider.Duration = time.Duration(assumeRole.DurationSeconds) * time.Second
+	}
+
+	if assumeRole.ExternalID != "" {
+		provider.ExternalID = aws.String(assumeRole.ExternalID)
+	}
+
+	if assumeRole.Policy != "" {
+		provider.Policy = aws.String(assumeRole.Policy)
+	}
+
+	for _, policyARN := range assumeRole.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if assumeRole.SessionName != "" {
+		provider.RoleSessionName = assumeRole.SessionName
+	}
+
+	for k, v := range assumeRole.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(assumeRole.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(assumeRole.TransitiveTagKeys)
+	}
+
+	assumeRoleCreds := credentials.NewCredentials(provider)
+
+	if _, err := assumeRoleCreds.Get(); err != nil {
+		return nil, c.newCannotAssumeRoleError(assumeRole.RoleARN, err)
+	}
+
+	return assumeRoleCreds, nil
+}
+
+// webIdentityToken is a web identity token configured directly rather than read from a file.
+type webIdentityToken string
+
+func (t webIdentityToken) FetchToken(credentials.Context) ([]byte, error) {
+	return []byte(t), nil
+}
+
+// getWebIdentityCredentials returns credentials for the IAM Role assumed using a web identity token.
+func getWebIdentityCredentials(c *sessionConfig, httpClient *http.Client) (*credentials.Credentials, error) {
+	webIdentity := c.AssumeRoleWithWebIdentity
+
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q)", webIdentity.RoleARN, webIdentity.SessionName)
+
+	var tokenFetcher stscreds.TokenFetcher
+
+	switch {
+	case webIdentity.WebIdentityToken != "":
+		tokenFetcher = webIdentityToken(webIdentity.WebIdentityToken)
+	case webIdentity.WebIdentityTokenFile != "":
+		filename, err := homedir.Expand(webIdentity.WebIdentityTokenFile)
+
+		if err != nil {
+			return nil, fmt.Errorf("error expanding web identity token filename: %w", err)
+		}
+
+		tokenFetcher = stscreds.FetchTokenPath(filename)
+	default:
+		return nil, fmt.Errorf("one of web identity token or web identity token file must be set to assume IAM Role (%s) with web identity", webIdentity.RoleARN)
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed.
+	sess, err := c.stsSession(credentials.AnonymousCredentials, httpClient)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating assume role with web identity session: %w", err)
+	}
+
+	provider := stscreds.NewWebIdentityRoleProviderWithOptions(sts.New(sess), webIdentity.RoleARN, webIdentity.SessionName, tokenFetcher, func(p *stscreds.WebIdentityRoleProvider) {
+		if webIdentity.DurationSeconds > 0 {
+			p.Duration = time.Duration(webIdentity.DurationSeconds) * time.Second
+		}
+
+		for _, policyARN := range webIdentity.PolicyARNs {
+			p.PolicyArns = append(p.PolicyArns, &sts.PolicyDescriptorType{
+				Arn: aws.String(policyARN),
+			})
+		}
+	})
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, c.newCannotAssumeRoleError(webIdentity.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// stsSession returns a session for STS requests made while resolving credentials.
+func (c *sessionConfig) stsSession(creds *credentials.Credentials, httpClient *http.Client) (*session.Session, error) {
+	config := aws.Config{
+		CredentialsChainVerboseErrors: aws.Bool(true),
+		Credentials:                   creds,
+		EndpointResolver:              c.EndpointResolver(),
+		HTTPClient:                    httpClient,
+		MaxRetries:                    aws.Int(c.MaxRetries),
+		Region:                        aws.String(c.Region),
+	}
+
+	c.setEndpointVariants(&config, c.StsEndpoint)
+
+	if c.DebugLogging {
+		config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		config.Logger = awsbase.DebugLogger{}
+	}
+
+	return newSession(session.Options{Config: config})
+}
+
+// assumedRoleARN returns the ARN of the IAM Role whose credentials the provider uses, if any.
+func (c *sessionConfig) assumedRoleARN() string {
+	if n := len(c.AssumeRole); n > 0 {
+		return c.AssumeRole[n-1].RoleARN
+	}
+
+	if c.AssumeRoleWithWebIdentity != nil {
+		return c.AssumeRoleWithWebIdentity.RoleARN
+	}
+
+	return ""
+}
+
+func (c *sessionConfig) newCannotAssumeRoleError(roleARN string, err error) error {
+	config := *c.Config
+	config.AssumeRoleARN = roleARN
+
+	return config.NewCannotAssumeRoleError(err)
+}
+
+// getSourceCredentials returns credentials from the provider configuration, environment,
+// shared credentials file or, failing those, the session (which may include a credential process)
+// or ECS/EC2 metadata endpoints.
+//
+// It follows awsbase.GetCredentials, except that the EC2 Instance Metadata Service
+// endpoint configuration is applied to session-derived credentials.
+func getSourceCredentials(c *sessionConfig) (*credentials.Credentials, error) {
+	sharedCredentialsFilename, err := homedir.Expand(c.CredsFilename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding shared credentials filename: %w", err)
+	}
+
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.StaticProvider{Value: credentials.Value{
+			AccessKeyID:     c.AccessKey,
+			SecretAccessKey: c.SecretKey,
+			SessionToken:    c.Token,
+		}},
+		&credentials.EnvProvider{},
+		&credentials.SharedCredentialsProvider{
+			Filename: sharedCredentialsFilename,
+			Profile:  c.Profile,
+		},
+	})
+
+	cp, err := creds.Get()
+
+	if err == nil {
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+		return creds, nil
+	}
+
+	if !tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %w", err)
+	}
+
+	log.Printf("[INFO] Attempting to use session-derived credentials")
+
+	// Avoid setting HTTPClient here as it will prevent the ec2metadata
+	// client from automatically lowering the timeout to 1 second.
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              c.EndpointResolver(),
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		EC2IMDSEndpoint:     c.EC2MetadataServiceEndpoint,
+		EC2IMDSEndpointMode: c.EC2MetadataServiceEndpointMode,
+		Profile:             c.Profile,
+		SharedConfigState:   session.SharedConfigEnable,
+	})
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, c.NewNoValidCredentialSourcesError(err)
+		}
+		return nil, fmt.Errorf("Error creating AWS session: %w", err)
+	}
+
+	creds = sess.Config.Credentials
+	cp, err = creds.Get()
+
+	if err != nil {
+		return nil, c.NewNoValidCredentialSourcesError(err)
+	}
+
+	log.Printf("[INFO] Successfully derived credentials from session")
+	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+
+	return creds, nil
+}
+
+func parseAccountIDAndPartitionFromARN(v string) (string, string, error) {
+	arn, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	return arn.AccountID, arn.Partition, nil
+}
@@ -0,0 +1,541 @@
+package conns
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigClientCustomCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintln(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+	}))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	if err := ioutil.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name           string
+		CustomCABundle string
+		ExpectError    bool
+	}{
+		{
+			Name:        "no custom CA bundle",
+			ExpectError: true,
+		},
+		{
+			Name:           "custom CA bundle",
+			CustomCABundle: caBundle,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := &Config{
+				AccessKey:           awsbase.MockStaticAccessKey,
+				CustomCABundle:      testCase.CustomCABundle,
+				Endpoints:           map[string]string{STS: server.URL},
+				MaxRetries:          1,
+				Region:              endpoints.UsEast1RegionID,
+				SecretKey:           awsbase.MockStaticSecretKey,
+				SkipGetEC2Platforms: true,
+			}
+
+			raw, err := config.Client()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			client := raw.(*AWSClient)
+
+			if got, expected := client.AccountID, awsbase.MockStsGetCallerIdentityAccountID; got != expected {
+				t.Errorf("account ID: got %s, expected %s", got, expected)
+			}
+
+			// Service clients, and sessions derived from them, share the provider's HTTP transport.
+			sess, err := NewSessionForRegion(&client.STSConn().Config, endpoints.UsWest2RegionID, client.TerraformVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := sess.Config.HTTPClient.Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestConfigHTTPClientInvalidCustomCABundle(t *testing.T) {
+	caBundle := filepath.Join(t.TempDir(), "ca-bundle.pem")
+	if err := ioutil.WriteFile(caBundle, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &Config{
+		CustomCABundle: caBundle,
+	}
+
+	if _, err := config.httpClient(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestConfigHTTPClientNoProxy(t *testing.T) {
+	config := &Config{
+		HTTPProxy: "http://proxy.example.com:3128",
+		NoProxy:   []string{".example.org", "10.0.0.0/8"},
+	}
+
+	client, err := config.httpClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		URL      string
+		Expected string
+	}{
+		{
+			URL:      "https://sts.amazonaws.com/",
+			Expected: "http://proxy.example.com:3128",
+		},
+		{
+			URL: "https://sts.example.org/",
+		},
+		{
+			URL: "https://10.1.2.3/",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.URL, func(t *testing.T) {
+			request, err := http.NewRequest(http.MethodGet, testCase.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			proxyURL, err := client.Transport.(*http.Transport).Proxy(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got string
+			if proxyURL != nil {
+				got = proxyURL.String()
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got proxy %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+// unsetAWSEnv clears environment variables that would provide credentials before the
+// EC2 Instance Metadata Service, returning a function restoring the original environment.
+func unsetAWSEnv(t *testing.T) func() {
+	t.Helper()
+
+	values := map[string]string{
+		EnvVarAccessKeyId:                        "",
+		EnvVarProfile:                            "",
+		EnvVarSecretAccessKey:                    "",
+		EnvVarContainerCredentialsFullUri:        "",
+		EnvVarEC2MetadataServiceEndpoint:         "",
+		EnvVarEC2MetadataServiceEndpointMode:     "",
+		"AWS_CONFIG_FILE":                        filepath.Join(t.TempDir(), "config"),
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI": "",
+		"AWS_EC2_METADATA_DISABLED":              "",
+		"AWS_SESSION_TOKEN":                      "",
+		"AWS_SHARED_CREDENTIALS_FILE":            filepath.Join(t.TempDir(), "credentials"),
+	}
+	original := make(map[string]*string)
+
+	for k, v := range values {
+		if o, ok := os.LookupEnv(k); ok {
+			original[k] = &o
+		} else {
+			original[k] = nil
+		}
+
+		if v == "" {
+			os.Unsetenv(k)
+		} else {
+			os.Setenv(k, v)
+		}
+	}
+
+	return func() {
+		for k, v := range original {
+			if v == nil {
+				os.Unsetenv(k)
+			} else {
+				os.Setenv(k, *v)
+			}
+		}
+	}
+}
+
+func TestConfigClientEC2MetadataServiceEndpoint(t *testing.T) {
+	defer unsetAWSEnv(t)()
+
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.URL.Path {
+		case "/latest/api/token":
+			w.Header().Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", "21600")
+			fmt.Fprint(w, "token")
+		case "/latest/meta-data/iam/security-credentials/":
+			fmt.Fprint(w, "test-role")
+		case "/latest/meta-data/iam/security-credentials/test-role":
+			fmt.Fprintf(w, `{"Code":"Success","LastUpdated":"2022-01-01T00:00:00Z","Type":"AWS-HMAC","AccessKeyId":%q,"SecretAccessKey":%q,"Token":"token","Expiration":%q}`,
+				awsbase.MockStaticAccessKey, awsbase.MockStaticSecretKey, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		case "/latest/meta-data/iam/info":
+			fmt.Fprint(w, `{"Code":"Success","LastUpdated":"2022-01-01T00:00:00Z","InstanceProfileArn":"arn:aws:iam::111111111111:instance-profile/test","InstanceProfileId":"AIPAEXAMPLE"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		EC2MetadataServiceEndpoint: server.URL,
+		MaxRetries:                 1,
+		Region:                     endpoints.UsEast1RegionID,
+		SkipCredsValidation:        true,
+		SkipGetEC2Platforms:        true,
+	}
+
+	raw, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "111111111111"; got != expected {
+		t.Errorf("account ID: got %s, expected %s", got, expected)
+	}
+
+	creds, err := client.session.Config.Credentials.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := creds.AccessKeyID, awsbase.MockStaticAccessKey; got != expected {
+		t.Errorf("access key: got %s, expected %s", got, expected)
+	}
+
+	if len(requests) == 0 {
+		t.Error("expected requests to the EC2 metadata service endpoint")
+	}
+}
+
+func TestConfigClientInvalidEC2MetadataServiceEndpointMode(t *testing.T) {
+	config := &Config{
+		AccessKey:                      awsbase.MockStaticAccessKey,
+		EC2MetadataServiceEndpointMode: "IPv5",
+		Region:                         endpoints.UsEast1RegionID,
+		SecretKey:                      awsbase.MockStaticSecretKey,
+		SkipCredsValidation:            true,
+		SkipGetEC2Platforms:            true,
+		SkipRequestingAccountId:        true,
+	}
+
+	if _, err := config.Client(); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+// stsAssumeRoleServer returns a fake STS endpoint issuing credentials whose access key ID
+// is the name of the assumed role, recording the action and access key ID of each request.
+func stsAssumeRoleServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+
+	credentialsResponse := `<Credentials><AccessKeyId>%[1]s</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken><Expiration>%[2]s</Expiration></Credentials>`
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		action := r.Form.Get("Action")
+		accessKeyID := "anonymous"
+
+		if v := r.Header.Get("Authorization"); v != "" {
+			accessKeyID = strings.SplitN(strings.SplitN(v, "Credential=", 2)[1], "/", 2)[0]
+		}
+
+		*requests = append(*requests, action+" "+accessKeyID)
+
+		roleARN, err := arn.Parse(r.Form.Get("RoleArn"))
+		expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		w.Header().Set("Content-Type", "text/xml")
+
+		switch {
+		case action == "GetCallerIdentity":
+			fmt.Fprintln(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+		case action == "AssumeRole" && err == nil:
+			fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult>`+credentialsResponse+`</AssumeRoleResult></AssumeRoleResponse>`, strings.TrimPrefix(roleARN.Resource, "role/"), expiration)
+		case action == "AssumeRoleWithWebIdentity" && err == nil && r.Form.Get("WebIdentityToken") == "oidc-token":
+			fmt.Fprintf(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleWithWebIdentityResult>`+credentialsResponse+`</AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`, strings.TrimPrefix(roleARN.Resource, "role/"), expiration)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error></ErrorResponse>`)
+		}
+	}))
+}
+
+func TestConfigClientAssumeRoleChain(t *testing.T) {
+	var requests []string
+
+	server := stsAssumeRoleServer(t, &requests)
+	defer server.Close()
+
+	config := &Config{
+		AccessKey: awsbase.MockStaticAccessKey,
+		AssumeRole: []AssumeRole{
+			{RoleARN: "arn:aws:iam::222222222222:role/hub", SessionName: "hub-session"},
+			{RoleARN: "arn:aws:iam::333333333333:role/spoke", SessionName: "spoke-session"},
+		},
+		Endpoints:           map[string]string{STS: server.URL},
+		MaxRetries:          1,
+		Region:              endpoints.UsEast1RegionID,
+		SecretKey:           awsbase.MockStaticSecretKey,
+		SkipGetEC2Platforms: true,
+	}
+
+	raw, err := config.Client()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := raw.(*AWSClient)
+
+	if got, expected := client.AccountID, "333333333333"; got != expected {
+		t.Errorf("account ID: got %s, expected %s", got, expected)
+	}
+
+	expectedRequests := []string{
+		"AssumeRole " + awsbase.MockStaticAccessKey,
+		"AssumeRole hub",
+		"GetCallerIdentity spoke",
+	}
+
+	if got, expected := strings.Join(requests, ", "), strings.Join(expectedRequests, ", "); got != expected {
+		t.Errorf("requests: got %s, expected %s", got, expected)
+	}
+}
+
+func TestConfigClientAssumeRoleWithWebIdentity(t *testing.T) {
+	defer unsetAWSEnv(t)()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := ioutil.WriteFile(tokenFile, []byte("oidc-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Name             string
+		AssumeRole       []AssumeRole
+		WebIdentity      *AssumeRoleWithWebIdentity
+		ExpectedRequests []string
+		ExpectError      bool
+	}{
+		{
+			Name: "token",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::222222222222:role/ci",
+				WebIdentityToken: "oidc-token",
+			},
+			ExpectedRequests: []string{"AssumeRoleWithWebIdentity anonymous", "GetCallerIdentity ci"},
+		},
+		{
+			Name: "token file",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:              "arn:aws:iam::222222222222:role/ci",
+				WebIdentityTokenFile: tokenFile,
+			},
+			ExpectedRequests: []string{"AssumeRoleWithWebIdentity anonymous", "GetCallerIdentity ci"},
+		},
+		{
+			Name: "chained",
+			AssumeRole: []AssumeRole{
+				{RoleARN: "arn:aws:iam::333333333333:role/deploy"},
+			},
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::222222222222:role/ci",
+				WebIdentityToken: "oidc-token",
+			},
+			ExpectedRequests: []string{"AssumeRoleWithWebIdentity anonymous", "AssumeRole ci", "GetCallerIdentity deploy"},
+		},
+		{
+			Name: "invalid token",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN:          "arn:aws:iam::222222222222:role/ci",
+				WebIdentityToken: "invalid",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "no token",
+			WebIdentity: &AssumeRoleWithWebIdentity{
+				RoleARN: "arn:aws:iam::222222222222:role/ci",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var requests []string
+
+			server := stsAssumeRoleServer(t, &requests)
+			defer server.Close()
+
+			config := &Config{
+				AssumeRole:                testCase.AssumeRole,
+				AssumeRoleWithWebIdentity: testCase.WebIdentity,
+				Endpoints:                 map[string]string{STS: server.URL},
+				MaxRetries:                1,
+				Region:                    endpoints.UsEast1RegionID,
+				SkipGetEC2Platforms:       true,
+				SkipMetadataApiCheck:      true,
+			}
+
+			_, err := config.Client()
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := strings.Join(requests, ", "), strings.Join(testCase.ExpectedRequests, ", "); got != expected {
+				t.Errorf("requests: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestSessionConfigEndpointVariants(t *testing.T) {
+	testCases := []struct {
+		Name                   string
+		IAMEndpoint            string
+		STSEndpoint            string
+		UseDualStackEndpoint   bool
+		UseFIPSEndpoint        bool
+		ExpectedBaseSTSHost    string
+		ExpectedAssumeRoleHost string
+	}{
+		{
+			Name:                   "default",
+			ExpectedBaseSTSHost:    "sts.amazonaws.com",
+			ExpectedAssumeRoleHost: "sts.amazonaws.com",
+		},
+		{
+			Name:                   "FIPS",
+			UseFIPSEndpoint:        true,
+			ExpectedBaseSTSHost:    "sts-fips.us-east-1.amazonaws.com",
+			ExpectedAssumeRoleHost: "sts-fips.us-east-1.amazonaws.com",
+		},
+		{
+			Name:                   "dual-stack",
+			UseDualStackEndpoint:   true,
+			ExpectedBaseSTSHost:    "sts.us-east-1.api.aws",
+			ExpectedAssumeRoleHost: "sts.us-east-1.api.aws",
+		},
+		{
+			Name:                   "FIPS custom STS endpoint",
+			STSEndpoint:            "https://sts.example.com",
+			UseFIPSEndpoint:        true,
+			ExpectedBaseSTSHost:    "sts.example.com",
+			ExpectedAssumeRoleHost: "sts.example.com",
+		},
+		{
+			Name:                   "FIPS custom IAM endpoint",
+			IAMEndpoint:            "https://iam.example.com",
+			UseFIPSEndpoint:        true,
+			ExpectedBaseSTSHost:    "sts.amazonaws.com",
+			ExpectedAssumeRoleHost: "sts-fips.us-east-1.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			c := &sessionConfig{
+				Config: &awsbase.Config{
+					AccessKey:               awsbase.MockStaticAccessKey,
+					IamEndpoint:             testCase.IAMEndpoint,
+					Region:                  endpoints.UsEast1RegionID,
+					SecretKey:               awsbase.MockStaticSecretKey,
+					SkipCredsValidation:     true,
+					SkipMetadataApiCheck:    true,
+					SkipRequestingAccountId: true,
+					StsEndpoint:             testCase.STSEndpoint,
+				},
+				UseDualStackEndpoint: testCase.UseDualStackEndpoint,
+				UseFIPSEndpoint:      testCase.UseFIPSEndpoint,
+			}
+
+			sess, _, _, err := getSessionWithAccountIDAndPartition(c, http.DefaultClient)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req, _ := sts.New(sess).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+
+			if got, expected := req.HTTPRequest.URL.Host, testCase.ExpectedBaseSTSHost; got != expected {
+				t.Errorf("GetCallerIdentity host: got %s, expected %s", got, expected)
+			}
+
+			stsSess, err := c.stsSession(sess.Config.Credentials, http.DefaultClient)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req, _ = sts.New(stsSess).AssumeRoleRequest(&sts.AssumeRoleInput{})
+
+			if got, expected := req.HTTPRequest.URL.Host, testCase.ExpectedAssumeRoleHost; got != expected {
+				t.Errorf("AssumeRole host: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package conns
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// organizationsTagPolicyConfig returns a copy of the tag policy configuration with the rules
+// of the AWS Organizations tag policy in effect for the caller's account.
+func organizationsTagPolicyConfig(conn *organizations.Organizations, accountID string, policyConfig *tftags.PolicyConfig) (*tftags.PolicyConfig, error) {
+	output, err := conn.DescribeEffectivePolicy(&organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(organizations.EffectivePolicyTypeTagPolicy),
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeEffectivePolicyNotFoundException) || tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		log.Printf("[WARN] No AWS Organizations tag policy in effect for AWS Account (%s)", accountID)
+		return policyConfig, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading AWS Organizations effective tag policy for AWS Account (%s): %w", accountID, err)
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, fmt.Errorf("error reading AWS Organizations effective tag policy for AWS Account (%s): empty response", accountID)
+	}
+
+	keyRules, err := tftags.OrganizationsPolicyKeyRules(aws.StringValue(output.EffectivePolicy.PolicyContent))
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tftags.PolicyConfig{}
+
+	if policyConfig != nil {
+		*config = *policyConfig
+	}
+
+	config.KeyRules = keyRules
+
+	return config, nil
+}
@@ -0,0 +1,117 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestConfigClientUseOrganizationsTagPolicy(t *testing.T) {
+	testCases := []struct {
+		Name                      string
+		UseOrganizationsTagPolicy bool
+		OrganizationsResponse     string
+		OrganizationsStatus       int
+		ExpectedError             string
+		ExpectedTagPolicyConfig   *tftags.PolicyConfig
+	}{
+		{
+			Name:                    "not used",
+			ExpectedTagPolicyConfig: &tftags.PolicyConfig{WarnOnly: true},
+		},
+		{
+			Name:                      "effective policy",
+			UseOrganizationsTagPolicy: true,
+			OrganizationsResponse:     `{"EffectivePolicy":{"PolicyContent":"{\"tags\":{\"costcenter\":{\"tag_key\":\"CostCenter\",\"tag_value\":[\"100\",\"200\"],\"enforced_for\":[\"ec2:instance\"]}}}","PolicyType":"TAG_POLICY","TargetId":"111111111111"}}`,
+			OrganizationsStatus:       http.StatusOK,
+			ExpectedTagPolicyConfig: &tftags.PolicyConfig{
+				KeyRules: map[string]*tftags.PolicyKeyRule{
+					"costcenter": {
+						EnforcedFor: []string{"ec2:instance"},
+						Key:         "CostCenter",
+						Values:      []string{"100", "200"},
+					},
+				},
+				WarnOnly: true,
+			},
+		},
+		{
+			Name:                      "no effective policy",
+			UseOrganizationsTagPolicy: true,
+			OrganizationsResponse:     `{"__type":"EffectivePolicyNotFoundException","Message":"No effective policy of type TAG_POLICY was found."}`,
+			OrganizationsStatus:       http.StatusBadRequest,
+			ExpectedTagPolicyConfig:   &tftags.PolicyConfig{WarnOnly: true},
+		},
+		{
+			Name:                      "access denied",
+			UseOrganizationsTagPolicy: true,
+			OrganizationsResponse:     `{"__type":"AccessDeniedException","Message":"You don't have permissions to access this resource."}`,
+			OrganizationsStatus:       http.StatusBadRequest,
+			ExpectedError:             "error reading AWS Organizations effective tag policy",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var organizationsRequests int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Amz-Target") == "AWSOrganizationsV20161128.DescribeEffectivePolicy" {
+					organizationsRequests++
+					w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+					w.WriteHeader(testCase.OrganizationsStatus)
+					fmt.Fprintln(w, testCase.OrganizationsResponse)
+					return
+				}
+
+				w.Header().Set("Content-Type", "text/xml")
+				fmt.Fprintln(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+			}))
+			defer server.Close()
+
+			config := &Config{
+				AccessKey:                 awsbase.MockStaticAccessKey,
+				Endpoints:                 map[string]string{Organizations: server.URL, STS: server.URL},
+				MaxRetries:                1,
+				Region:                    endpoints.UsEast1RegionID,
+				SecretKey:                 awsbase.MockStaticSecretKey,
+				SkipGetEC2Platforms:       true,
+				TagPolicyConfig:           &tftags.PolicyConfig{WarnOnly: true},
+				UseOrganizationsTagPolicy: testCase.UseOrganizationsTagPolicy,
+			}
+
+			raw, err := config.Client()
+
+			if testCase.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.ExpectedError) {
+					t.Fatalf("got error %v, expected error containing %q", err, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := raw.(*AWSClient).TagPolicyConfig; !reflect.DeepEqual(got, testCase.ExpectedTagPolicyConfig) {
+				t.Errorf("got tag policy %+v, expected %+v", got, testCase.ExpectedTagPolicyConfig)
+			}
+
+			if config.TagPolicyConfig.KeyRules != nil {
+				t.Errorf("expected Config.TagPolicyConfig not to be modified")
+			}
+
+			if got, expected := organizationsRequests > 0, testCase.UseOrganizationsTagPolicy; got != expected {
+				t.Errorf("got Organizations requested %t, expected %t", got, expected)
+			}
+		})
+	}
+}
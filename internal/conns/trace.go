@@ -0,0 +1,814 @@
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	APITraceExporterJSONL = "jsonl"
+	APITraceExporterOTLP  = "otlp"
+)
+
+func APITraceExporter_Values() []string {
+	return []string{
+		APITraceExporterJSONL,
+		APITraceExporterOTLP,
+	}
+}
+
+const (
+	apiCallRecordBufferSize   = 4096
+	latencyHistogramBuckets   = 96
+	defaultAPITraceFile       = "terraform-provider-aws-api-trace.jsonl"
+	defaultAPITraceSummary    = "terraform-provider-aws-api-summary.txt"
+	defaultOTLPEndpoint       = "http://localhost:4318"
+	otlpExportBatchSize       = 512
+	otlpExportTimeout         = 10 * time.Second
+	otlpInstrumentationName   = "github.com/hashicorp/terraform-provider-aws/internal/conns"
+	summaryResourcesTableSize = 20
+)
+
+// APICallRecord is the record of a single AWS API call, including any retries.
+type APICallRecord struct {
+	ErrorCode      string    `json:"error_code,omitempty"`
+	HTTPStatusCode int       `json:"http_status_code,omitempty"`
+	LatencyMillis  float64   `json:"latency_ms"`
+	Operation      string    `json:"operation"`
+	Region         string    `json:"region,omitempty"`
+	RequestID      string    `json:"request_id,omitempty"`
+	ResourceID     string    `json:"resource_id,omitempty"`
+	ResourceType   string    `json:"resource_type,omitempty"`
+	RetryCount     int       `json:"retry_count"`
+	Service        string    `json:"service"`
+	StartTime      time.Time `json:"start_time"`
+	Throttles      int       `json:"throttles,omitempty"`
+}
+
+func (r *APICallRecord) latency() time.Duration {
+	return time.Duration(r.LatencyMillis * float64(time.Millisecond))
+}
+
+type tracedResourceContextKey struct{}
+
+type tracedResource struct {
+	id           func() string
+	operation    *tracedOperation
+	resourceType string
+}
+
+// tracedOperation collects the AWS API calls made by a single CRUD operation of a resource.
+type tracedOperation struct {
+	apiCalls map[string]int
+	name     string
+	stats    resourceStats
+}
+
+// contextWithTracedResource returns a copy of the context recording the Terraform
+// resource on whose behalf AWS API calls made with the context are traced.
+func contextWithTracedResource(ctx context.Context, resource tracedResource) context.Context {
+	return context.WithValue(ctx, tracedResourceContextKey{}, resource)
+}
+
+// installTracedResourceHandler adds a request handler that attributes API calls made with
+// the handlers to the resource, unless the request's context already records a resource.
+func installTracedResourceHandler(handlers *request.Handlers, resource tracedResource) {
+	handlers.Build.PushFrontNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APICallTraceResourceHandler",
+		Fn: func(r *request.Request) {
+			if _, ok := r.Context().Value(tracedResourceContextKey{}).(tracedResource); !ok {
+				r.SetContext(contextWithTracedResource(r.Context(), resource))
+			}
+		},
+	})
+}
+
+// WithTracedResource returns a copy of the client whose service clients attribute AWS API calls
+// to the Terraform resource of the specified type in API call traces. The resource's ID is
+// read when each call completes, so that calls made once a new resource's ID is set are attributed to it.
+// The returned function logs a summary of the calls made with the copy by the named CRUD operation,
+// and should be called once the operation finishes.
+// The client itself and a no-op function are returned if API call tracing is not enabled.
+//
+// Terraform does not send resource addresses to providers, so calls are attributed to a
+// resource by type and ID.
+func (client *AWSClient) WithTracedResource(resourceType, operation string, id func() string) (*AWSClient, func()) {
+	tracer := defaultAPICallTracer()
+
+	if tracer == nil {
+		return client, func() {}
+	}
+
+	resource := tracedResource{
+		id:           id,
+		operation:    &tracedOperation{apiCalls: make(map[string]int), name: operation},
+		resourceType: resourceType,
+	}
+
+	return client.withTracedResource(resource), func() {
+		if summary := tracer.operationSummary(resource); summary != "" {
+			log.Printf("[INFO] %s", summary)
+		}
+	}
+}
+
+// withTracedResource returns a copy of the client attributing AWS API calls to the resource.
+// The copy shares the client's cached service clients, returning shallow copies of them
+// with the resource's request handler added.
+func (client *AWSClient) withTracedResource(resource tracedResource) *AWSClient {
+	traced := client.derive()
+	traced.tracedResource = &resource
+
+	return traced
+}
+
+// tracedConn returns a shallow copy of the AWS Go SDK service client, such as *ec2.EC2,
+// whose requests are attributed to the resource.
+// The service client itself is returned if it does not embed a *client.Client.
+func (resource tracedResource) tracedConn(conn interface{}) interface{} {
+	v := reflect.ValueOf(conn)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return conn
+	}
+
+	field := v.Elem().FieldByName("Client")
+
+	if !field.IsValid() || field.Type() != reflect.TypeOf((*client.Client)(nil)) || field.IsNil() {
+		return conn
+	}
+
+	c := *field.Interface().(*client.Client)
+	c.Handlers = c.Handlers.Copy()
+	installTracedResourceHandler(&c.Handlers, resource)
+
+	copied := reflect.New(v.Elem().Type())
+	copied.Elem().Set(v.Elem())
+	copied.Elem().FieldByName("Client").Set(reflect.ValueOf(&c))
+
+	return copied.Interface()
+}
+
+// apiCallExporter writes API call records to a trace destination.
+type apiCallExporter interface {
+	Export(record *APICallRecord) error
+	Close() error
+}
+
+type operationStats struct {
+	calls     int
+	errors    int
+	latencies latencyHistogram
+	throttles int
+}
+
+// latencyHistogram counts latencies in a fixed number of buckets, so that the memory used
+// does not grow with the number of API calls. The upper bound of bucket i is 2^(i/4) milliseconds,
+// so percentiles are within 19% of the exact value for latencies of up to several hours.
+type latencyHistogram struct {
+	count  int
+	counts [latencyHistogramBuckets]int
+	max    time.Duration
+}
+
+func latencyHistogramBucket(d time.Duration) int {
+	ms := float64(d) / float64(time.Millisecond)
+
+	if ms <= 1 {
+		return 0
+	}
+
+	if i := int(math.Ceil(4 * math.Log2(ms))); i < latencyHistogramBuckets {
+		return i
+	}
+
+	return latencyHistogramBuckets - 1
+}
+
+func (h *latencyHistogram) add(d time.Duration) {
+	h.count++
+	h.counts[latencyHistogramBucket(d)]++
+
+	if d > h.max {
+		h.max = d
+	}
+}
+
+// percentile returns the upper bound of the bucket holding the nearest-rank percentile
+// of the latencies, or the maximum latency if lower.
+func (h *latencyHistogram) percentile(p float64) time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(h.count)))
+
+	if rank < 1 {
+		rank = 1
+	}
+
+	var i, n int
+	for i = range h.counts {
+		if n += h.counts[i]; n >= rank {
+			break
+		}
+	}
+
+	// The last bucket has no upper bound.
+	if i == latencyHistogramBuckets-1 {
+		return h.max
+	}
+
+	if d := time.Duration(math.Pow(2, float64(i)/4) * float64(time.Millisecond)); d < h.max {
+		return d
+	}
+
+	return h.max
+}
+
+type resourceStats struct {
+	calls     int
+	errors    int
+	latency   time.Duration
+	throttles int
+}
+
+func (s *resourceStats) add(record *APICallRecord) {
+	s.calls++
+	s.latency += record.latency()
+	s.throttles += record.Throttles
+
+	if record.ErrorCode != "" {
+		s.errors++
+	}
+}
+
+// apiCallTracer records AWS API calls, exporting each record and
+// collecting per-operation and per-resource statistics for the summary.
+//
+// Records are exported in the background so that a slow trace destination does not
+// delay API calls. Records are dropped if the destination cannot keep up.
+type apiCallTracer struct {
+	dropped     int
+	exportDone  chan struct{}
+	exporter    apiCallExporter
+	records     chan *APICallRecord
+	resources   map[string]*resourceStats
+	startTime   time.Time
+	stats       map[string]*operationStats
+	summaryPath string
+	throttles   map[*request.Request]int
+	lock        sync.Mutex
+	stopped     bool
+}
+
+func newAPICallTracer(exporter apiCallExporter) *apiCallTracer {
+	t := &apiCallTracer{
+		exportDone: make(chan struct{}),
+		exporter:   exporter,
+		records:    make(chan *APICallRecord, apiCallRecordBufferSize),
+		resources:  make(map[string]*resourceStats),
+		startTime:  time.Now(),
+		stats:      make(map[string]*operationStats),
+		throttles:  make(map[*request.Request]int),
+	}
+
+	go t.export()
+
+	return t
+}
+
+var (
+	globalAPICallTracer     *apiCallTracer
+	globalAPICallTracerOnce sync.Once
+)
+
+// defaultAPICallTracer returns the process-wide tracer configured from the
+// environment, or nil if API call tracing is not enabled.
+func defaultAPICallTracer() *apiCallTracer {
+	globalAPICallTracerOnce.Do(func() {
+		exporter, err := newAPICallExporterFromEnv()
+
+		if err != nil {
+			log.Printf("[WARN] Disabling AWS API call tracing: %s", err)
+			return
+		}
+
+		if exporter != nil {
+			globalAPICallTracer = newAPICallTracer(exporter)
+			globalAPICallTracer.summaryPath = GetEnvVarWithDefault(EnvVarAPITraceSummaryFile, defaultAPITraceSummary)
+		}
+	})
+
+	return globalAPICallTracer
+}
+
+func newAPICallExporterFromEnv() (apiCallExporter, error) {
+	switch v := os.Getenv(EnvVarAPITrace); v {
+	case "":
+		return nil, nil
+	case APITraceExporterJSONL:
+		return newJSONLAPICallExporter(GetEnvVarWithDefault(EnvVarAPITraceFile, defaultAPITraceFile))
+	case APITraceExporterOTLP:
+		endpoint := os.Getenv(EnvVarOTLPTracesEndpoint)
+
+		if endpoint == "" {
+			endpoint = strings.TrimSuffix(GetEnvVarWithDefault(EnvVarOTLPEndpoint, defaultOTLPEndpoint), "/") + "/v1/traces"
+		}
+
+		return newOTLPAPICallExporter(endpoint, &http.Client{Timeout: otlpExportTimeout}), nil
+	default:
+		return nil, fmt.Errorf("%s must be one of %v, got %q", EnvVarAPITrace, APITraceExporter_Values(), v)
+	}
+}
+
+// APICallTracingEnabled returns whether AWS API call tracing is enabled.
+func APICallTracingEnabled() bool {
+	return defaultAPICallTracer() != nil
+}
+
+// StopAPICallTracing flushes any buffered API call records and writes a summary
+// of the calls made to the summary file and the log. It should be called once the
+// provider stops serving, i.e. at the end of each Terraform operation using the provider.
+func StopAPICallTracing() {
+	if tracer := defaultAPICallTracer(); tracer != nil {
+		tracer.stop()
+	}
+}
+
+// installHandlers adds request handlers that record every API call made with the handlers.
+func (t *apiCallTracer) installHandlers(handlers *request.Handlers) {
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APICallTraceAttemptHandler",
+		Fn: func(r *request.Request) {
+			if !request.IsErrorThrottle(r.Error) {
+				return
+			}
+
+			t.lock.Lock()
+			defer t.lock.Unlock()
+
+			t.throttles[r]++
+		},
+	})
+
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform-provider-aws.APICallTraceHandler",
+		Fn:   t.record,
+	})
+}
+
+func (t *apiCallTracer) record(r *request.Request) {
+	record := &APICallRecord{
+		LatencyMillis: float64(time.Since(r.Time)) / float64(time.Millisecond),
+		RequestID:     r.RequestID,
+		RetryCount:    r.RetryCount,
+		Service:       r.ClientInfo.ServiceID,
+		StartTime:     r.Time,
+	}
+
+	if r.Config.Region != nil {
+		record.Region = *r.Config.Region
+	}
+
+	if r.Operation != nil {
+		record.Operation = r.Operation.Name
+	}
+
+	if r.HTTPResponse != nil {
+		record.HTTPStatusCode = r.HTTPResponse.StatusCode
+	}
+
+	if r.Error != nil {
+		if err, ok := r.Error.(awserr.Error); ok {
+			record.ErrorCode = err.Code()
+		} else {
+			record.ErrorCode = "UnknownError"
+		}
+	}
+
+	var operation *tracedOperation
+
+	if v, ok := r.Context().Value(tracedResourceContextKey{}).(tracedResource); ok {
+		record.ResourceID = v.id()
+		record.ResourceType = v.resourceType
+		operation = v.operation
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	record.Throttles = t.throttles[r]
+	delete(t.throttles, r)
+
+	if t.stopped {
+		return
+	}
+
+	apiCall := record.Service + "." + record.Operation
+
+	if operation != nil {
+		operation.apiCalls[apiCall]++
+		operation.stats.add(record)
+	}
+
+	if record.ResourceType != "" {
+		key := record.ResourceType
+		if record.ResourceID != "" {
+			key += " " + record.ResourceID
+		}
+
+		stats, ok := t.resources[key]
+
+		if !ok {
+			stats = &resourceStats{}
+			t.resources[key] = stats
+		}
+
+		stats.add(record)
+	}
+
+	stats, ok := t.stats[apiCall]
+
+	if !ok {
+		stats = &operationStats{}
+		t.stats[apiCall] = stats
+	}
+
+	stats.calls++
+	stats.latencies.add(record.latency())
+	stats.throttles += record.Throttles
+
+	if record.ErrorCode != "" {
+		stats.errors++
+	}
+
+	// Never block the API call on the trace destination.
+	select {
+	case t.records <- record:
+	default:
+		if t.dropped == 0 {
+			log.Printf("[WARN] AWS API call trace destination is not keeping up, dropping records")
+		}
+
+		t.dropped++
+	}
+}
+
+// export exports records until the tracer is stopped.
+func (t *apiCallTracer) export() {
+	defer close(t.exportDone)
+
+	var exportErr error
+
+	for record := range t.records {
+		if err := t.exporter.Export(record); err != nil && exportErr == nil {
+			log.Printf("[WARN] Error exporting AWS API call trace: %s", err)
+			exportErr = err
+		}
+	}
+}
+
+func (t *apiCallTracer) stop() {
+	t.lock.Lock()
+
+	if t.stopped {
+		t.lock.Unlock()
+		return
+	}
+
+	t.stopped = true
+	close(t.records)
+
+	t.lock.Unlock()
+
+	<-t.exportDone
+
+	if err := t.exporter.Close(); err != nil {
+		log.Printf("[WARN] Error closing AWS API call trace: %s", err)
+	}
+
+	var summary bytes.Buffer
+	fmt.Fprintf(&summary, "AWS API call summary for provider process %d, %s to %s\n\n", os.Getpid(), t.startTime.Format(time.RFC3339), time.Now().Format(time.RFC3339))
+	t.writeSummary(&summary)
+
+	if t.dropped > 0 {
+		fmt.Fprintf(&summary, "\n%d records were not exported as the trace destination did not keep up.\n", t.dropped)
+	}
+
+	log.Printf("[INFO] %s", summary.String())
+
+	if t.summaryPath != "" {
+		if err := appendFile(t.summaryPath, append(summary.Bytes(), '\n')); err != nil {
+			log.Printf("[WARN] Error writing AWS API call summary: %s", err)
+		}
+	}
+}
+
+// writeSummary writes a table of calls, throttles, errors and 95th percentile latency per operation,
+// busiest operations first, followed by a table of the resources whose API calls took longest.
+func (t *apiCallTracer) writeSummary(w io.Writer) {
+	keys := make([]string, 0, len(t.stats))
+	for k := range t.stats {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if ci, cj := t.stats[keys[i]].calls, t.stats[keys[j]].calls; ci != cj {
+			return ci > cj
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "OPERATION\tCALLS\tTHROTTLES\tERRORS\tP95 LATENCY")
+
+	for _, k := range keys {
+		stats := t.stats[k]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\n", k, stats.calls, stats.throttles, stats.errors, stats.latencies.percentile(95).Round(time.Millisecond))
+	}
+
+	tw.Flush()
+
+	if len(t.resources) == 0 {
+		return
+	}
+
+	keys = make([]string, 0, len(t.resources))
+	for k := range t.resources {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if li, lj := t.resources[keys[i]].latency, t.resources[keys[j]].latency; li != lj {
+			return li > lj
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	if len(keys) > summaryResourcesTableSize {
+		keys = keys[:summaryResourcesTableSize]
+	}
+
+	fmt.Fprintln(w)
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "RESOURCE\tCALLS\tTHROTTLES\tERRORS\tTOTAL LATENCY")
+
+	for _, k := range keys {
+		stats := t.resources[k]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\n", k, stats.calls, stats.throttles, stats.errors, stats.latency.Round(time.Millisecond))
+	}
+
+	tw.Flush()
+}
+
+func appendFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// operationSummary returns a one-line summary of the API calls made by the resource's CRUD operation,
+// or an empty string if no calls were made.
+func (t *apiCallTracer) operationSummary(resource tracedResource) string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	operation := resource.operation
+
+	if operation == nil || operation.stats.calls == 0 {
+		return ""
+	}
+
+	apiCalls := make([]string, 0, len(operation.apiCalls))
+	for k, v := range operation.apiCalls {
+		apiCalls = append(apiCalls, fmt.Sprintf("%s: %d", k, v))
+	}
+
+	sort.Strings(apiCalls)
+
+	name := resource.resourceType
+	if id := resource.id(); id != "" {
+		name += " " + id
+	}
+
+	stats := operation.stats
+
+	return fmt.Sprintf("AWS API calls for %s %s: %d calls, %d throttles, %d errors, %s total latency (%s)",
+		name, operation.name, stats.calls, stats.throttles, stats.errors, stats.latency.Round(time.Millisecond), strings.Join(apiCalls, ", "))
+}
+
+// jsonlAPICallExporter appends API call records to a JSON Lines file.
+type jsonlAPICallExporter struct {
+	encoder *json.Encoder
+	file    *os.File
+}
+
+func newJSONLAPICallExporter(path string) (*jsonlAPICallExporter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening AWS API call trace file: %w", err)
+	}
+
+	return &jsonlAPICallExporter{
+		encoder: json.NewEncoder(file),
+		file:    file,
+	}, nil
+}
+
+func (e *jsonlAPICallExporter) Export(record *APICallRecord) error {
+	return e.encoder.Encode(record)
+}
+
+func (e *jsonlAPICallExporter) Close() error {
+	return e.file.Close()
+}
+
+// otlpAPICallExporter sends API call records as client spans to an
+// OpenTelemetry collector using the OTLP/HTTP JSON encoding.
+// All spans from a provider process belong to a single trace.
+type otlpAPICallExporter struct {
+	client   *http.Client
+	endpoint string
+	spans    []otlpSpan
+	traceID  string
+}
+
+func newOTLPAPICallExporter(endpoint string, client *http.Client) *otlpAPICallExporter {
+	return &otlpAPICallExporter{
+		client:   client,
+		endpoint: endpoint,
+		traceID:  randomHexID(16),
+	}
+}
+
+type otlpKeyValue struct {
+	Key   string            `json:"key"`
+	Value map[string]string `json:"value"`
+}
+
+type otlpSpan struct {
+	Attributes        []otlpKeyValue    `json:"attributes"`
+	EndTimeUnixNano   string            `json:"endTimeUnixNano"`
+	Kind              int               `json:"kind"`
+	Name              string            `json:"name"`
+	SpanID            string            `json:"spanId"`
+	StartTimeUnixNano string            `json:"startTimeUnixNano"`
+	Status            map[string]string `json:"status,omitempty"`
+	TraceID           string            `json:"traceId"`
+}
+
+const (
+	otlpSpanKindClient  = 3
+	otlpStatusCodeError = "STATUS_CODE_ERROR"
+	otlpStringValue     = "stringValue"
+	otlpIntValue        = "intValue"
+	otlpServiceName     = "terraform-provider-aws"
+	otlpRPCSystemAWSAPI = "aws-api"
+)
+
+func (e *otlpAPICallExporter) Export(record *APICallRecord) error {
+	attributes := []otlpKeyValue{
+		{Key: "rpc.system", Value: map[string]string{otlpStringValue: otlpRPCSystemAWSAPI}},
+		{Key: "rpc.service", Value: map[string]string{otlpStringValue: record.Service}},
+		{Key: "rpc.method", Value: map[string]string{otlpStringValue: record.Operation}},
+		{Key: "aws.retry_count", Value: map[string]string{otlpIntValue: strconv.Itoa(record.RetryCount)}},
+		{Key: "aws.throttles", Value: map[string]string{otlpIntValue: strconv.Itoa(record.Throttles)}},
+	}
+
+	for _, v := range []struct {
+		key   string
+		value string
+	}{
+		{"aws.region", record.Region},
+		{"aws.request_id", record.RequestID},
+		{"aws.error_code", record.ErrorCode},
+		{"terraform.resource.type", record.ResourceType},
+		{"terraform.resource.id", record.ResourceID},
+	} {
+		if v.value != "" {
+			attributes = append(attributes, otlpKeyValue{Key: v.key, Value: map[string]string{otlpStringValue: v.value}})
+		}
+	}
+
+	if record.HTTPStatusCode != 0 {
+		attributes = append(attributes, otlpKeyValue{Key: "http.status_code", Value: map[string]string{otlpIntValue: strconv.Itoa(record.HTTPStatusCode)}})
+	}
+
+	span := otlpSpan{
+		Attributes:        attributes,
+		EndTimeUnixNano:   strconv.FormatInt(record.StartTime.Add(record.latency()).UnixNano(), 10),
+		Kind:              otlpSpanKindClient,
+		Name:              record.Service + "/" + record.Operation,
+		SpanID:            randomHexID(8),
+		StartTimeUnixNano: strconv.FormatInt(record.StartTime.UnixNano(), 10),
+		TraceID:           e.traceID,
+	}
+
+	if record.ErrorCode != "" {
+		span.Status = map[string]string{"code": otlpStatusCodeError, "message": record.ErrorCode}
+	}
+
+	e.spans = append(e.spans, span)
+
+	if len(e.spans) >= otlpExportBatchSize {
+		return e.flush()
+	}
+
+	return nil
+}
+
+func (e *otlpAPICallExporter) Close() error {
+	return e.flush()
+}
+
+func (e *otlpAPICallExporter) flush() error {
+	if len(e.spans) == 0 {
+		return nil
+	}
+
+	spans := e.spans
+	e.spans = nil
+
+	body, err := json.Marshal(map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []otlpKeyValue{
+						{Key: "service.name", Value: map[string]string{otlpStringValue: otlpServiceName}},
+					},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]string{"name": otlpInstrumentationName},
+						"spans": spans,
+					},
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error encoding OTLP spans: %w", err)
+	}
+
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+
+	if err != nil {
+		return fmt.Errorf("error exporting OTLP spans: %w", err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("error exporting OTLP spans: unexpected HTTP status %s", resp.Status)
+	}
+
+	return nil
+}
+
+func randomHexID(n int) string {
+	b := make([]byte, n)
+
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand does not fail on supported platforms.
+		panic(err)
+	}
+
+	return hex.EncodeToString(b)
+}
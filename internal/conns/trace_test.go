@@ -0,0 +1,626 @@
+package conns
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/sts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+type testAPICallExporter struct {
+	closed  bool
+	records []*APICallRecord
+}
+
+func (e *testAPICallExporter) Export(record *APICallRecord) error {
+	e.records = append(e.records, record)
+	return nil
+}
+
+func (e *testAPICallExporter) Close() error {
+	e.closed = true
+	return nil
+}
+
+func TestAPICallTracer(t *testing.T) {
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+
+		// Throttle the first request.
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`)
+			return
+		}
+
+		fmt.Fprintln(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(awsbase.MockStaticAccessKey, awsbase.MockStaticSecretKey, ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(3),
+		Region:      aws.String(endpoints.UsEast1RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exporter := &testAPICallExporter{}
+	tracer := newAPICallTracer(exporter)
+	tracer.installHandlers(&sess.Handlers)
+
+	// The resource ID is set by the call, as when creating a resource.
+	var id string
+	installTracedResourceHandler(&sess.Handlers, tracedResource{id: func() string { return id }, resourceType: "aws_caller_identity"})
+	sess.Handlers.Complete.PushFront(func(r *request.Request) { id = "123456789012" })
+
+	if _, err := sts.New(sess).GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(tracer.throttles) != 0 {
+		t.Errorf("expected completed requests to be removed from throttle counts, got %d", len(tracer.throttles))
+	}
+
+	tracer.stop()
+
+	if !exporter.closed {
+		t.Error("expected exporter to be closed")
+	}
+
+	if got, expected := len(exporter.records), 1; got != expected {
+		t.Fatalf("got %d records, expected %d", got, expected)
+	}
+
+	record := exporter.records[0]
+
+	if got, expected := record.Service, sts.ServiceID; got != expected {
+		t.Errorf("service: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.Operation, "GetCallerIdentity"; got != expected {
+		t.Errorf("operation: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.Region, endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("region: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.ResourceType, "aws_caller_identity"; got != expected {
+		t.Errorf("resource type: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.ResourceID, "123456789012"; got != expected {
+		t.Errorf("resource ID: got %s, expected %s", got, expected)
+	}
+
+	if got, expected := record.RetryCount, 1; got != expected {
+		t.Errorf("retry count: got %d, expected %d", got, expected)
+	}
+
+	if got, expected := record.Throttles, 1; got != expected {
+		t.Errorf("throttles: got %d, expected %d", got, expected)
+	}
+
+	if record.ErrorCode != "" {
+		t.Errorf("error code: got %s, expected none", record.ErrorCode)
+	}
+
+	if got, expected := tracer.resources["aws_caller_identity 123456789012"].calls, 1; got != expected {
+		t.Errorf("resource calls: got %d, expected %d", got, expected)
+	}
+}
+
+func TestAPICallTracerTracedResourceContext(t *testing.T) {
+	handlers := request.Handlers{}
+	installTracedResourceHandler(&handlers, tracedResource{id: func() string { return "default" }, resourceType: "aws_vpc"})
+
+	testCases := []struct {
+		Name     string
+		Context  context.Context
+		Expected string
+	}{
+		{
+			Name:     "no resource",
+			Context:  context.Background(),
+			Expected: "default",
+		},
+		{
+			Name:     "resource",
+			Context:  contextWithTracedResource(context.Background(), tracedResource{id: func() string { return "context" }, resourceType: "aws_vpc"}),
+			Expected: "context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{HTTPRequest: &http.Request{}}
+			r.SetContext(testCase.Context)
+
+			handlers.Build.Run(r)
+
+			v, ok := r.Context().Value(tracedResourceContextKey{}).(tracedResource)
+
+			if !ok {
+				t.Fatal("expected traced resource in request context")
+			}
+
+			if got := v.id(); got != testCase.Expected {
+				t.Errorf("got resource ID %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+// blockingAPICallExporter blocks each export until released.
+type blockingAPICallExporter struct {
+	testAPICallExporter
+	release chan struct{}
+}
+
+func (e *blockingAPICallExporter) Export(record *APICallRecord) error {
+	<-e.release
+	return e.testAPICallExporter.Export(record)
+}
+
+func TestAPICallTracerSlowExporter(t *testing.T) {
+	exporter := &blockingAPICallExporter{release: make(chan struct{})}
+	tracer := newAPICallTracer(exporter)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < apiCallRecordBufferSize+10; i++ {
+			tracer.record(&request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceID: ec2.ServiceID},
+				Operation:  &request.Operation{Name: "DescribeVpcs"},
+				Time:       time.Now(),
+			})
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("recording API calls blocked on the exporter")
+	}
+
+	close(exporter.release)
+	tracer.stop()
+
+	if got, expected := tracer.stats["EC2.DescribeVpcs"].calls, apiCallRecordBufferSize+10; got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+
+	if got := len(exporter.records) + tracer.dropped; got != apiCallRecordBufferSize+10 {
+		t.Errorf("got %d exported and %d dropped records, expected %d in total", len(exporter.records), tracer.dropped, apiCallRecordBufferSize+10)
+	}
+
+	if tracer.dropped == 0 {
+		t.Error("expected records to be dropped")
+	}
+}
+
+func TestAPICallTracerSummaryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.txt")
+
+	// Summaries from successive provider processes are appended to the same file.
+	for i := 0; i < 2; i++ {
+		tracer := newAPICallTracer(&testAPICallExporter{})
+		tracer.summaryPath = path
+		tracer.stats["EC2.DescribeVpcs"] = &operationStats{calls: 1, latencies: testLatencyHistogram(20 * time.Millisecond)}
+		tracer.stop()
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := strings.Count(string(b), "EC2.DescribeVpcs"), 2; got != expected {
+		t.Errorf("got %d summaries, expected %d:\n%s", got, expected, b)
+	}
+}
+
+func TestAPICallTracerSummary(t *testing.T) {
+	exporter := &testAPICallExporter{}
+	tracer := newAPICallTracer(exporter)
+
+	tracer.stats["EC2.DescribeVpcs"] = &operationStats{calls: 1, latencies: testLatencyHistogram(20 * time.Millisecond)}
+	tracer.stats["Route 53.ListResourceRecordSets"] = &operationStats{
+		calls:     3,
+		errors:    1,
+		latencies: testLatencyHistogram(300*time.Millisecond, 100*time.Millisecond, 200*time.Millisecond),
+		throttles: 2,
+	}
+
+	tracer.resources["aws_route53_record Z123_example.com_A"] = &resourceStats{calls: 3, errors: 1, latency: 600 * time.Millisecond, throttles: 2}
+	tracer.resources["aws_vpc vpc-12345678"] = &resourceStats{calls: 1, latency: 20 * time.Millisecond}
+
+	var summary bytes.Buffer
+	tracer.writeSummary(&summary)
+
+	lines := strings.Split(strings.TrimSpace(summary.String()), "\n")
+
+	if got, expected := len(lines), 7; got != expected {
+		t.Fatalf("got %d summary lines, expected %d:\n%s", got, expected, summary.String())
+	}
+
+	for i, expected := range [][]string{
+		{"OPERATION", "CALLS", "THROTTLES", "ERRORS", "P95", "LATENCY"},
+		{"Route", "53.ListResourceRecordSets", "3", "2", "1", "300ms"},
+		{"EC2.DescribeVpcs", "1", "0", "0", "20ms"},
+		{},
+		{"RESOURCE", "CALLS", "THROTTLES", "ERRORS", "TOTAL", "LATENCY"},
+		{"aws_route53_record", "Z123_example.com_A", "3", "2", "1", "600ms"},
+		{"aws_vpc", "vpc-12345678", "1", "0", "0", "20ms"},
+	} {
+		if got := strings.Fields(lines[i]); strings.Join(got, " ") != strings.Join(expected, " ") {
+			t.Errorf("summary line %d: got %q, expected %q", i, got, expected)
+		}
+	}
+}
+
+func testLatencyHistogram(latencies ...time.Duration) latencyHistogram {
+	var h latencyHistogram
+
+	for _, latency := range latencies {
+		h.add(latency)
+	}
+
+	return h
+}
+
+func TestLatencyHistogramPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 0, 1000)
+	for i := 1000; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	testCases := []struct {
+		Name      string
+		Latencies []time.Duration
+		Expected  time.Duration
+	}{
+		{
+			Name:     "empty",
+			Expected: 0,
+		},
+		{
+			Name:      "one",
+			Latencies: []time.Duration{time.Second},
+			Expected:  time.Second,
+		},
+		{
+			Name:      "submillisecond",
+			Latencies: []time.Duration{time.Microsecond, 500 * time.Microsecond},
+			Expected:  500 * time.Microsecond,
+		},
+		{
+			// The 950ms nearest-rank percentile is in the bucket from 2^(39/4) to 2^(40/4) milliseconds,
+			// whose upper bound is above the maximum latency.
+			Name:      "many",
+			Latencies: latencies,
+			Expected:  1000 * time.Millisecond,
+		},
+		{
+			Name:      "beyond last bucket",
+			Latencies: []time.Duration{1000 * time.Hour},
+			Expected:  1000 * time.Hour,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			h := testLatencyHistogram(testCase.Latencies...)
+
+			if got := h.percentile(95); got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestLatencyHistogramPercentileAccuracy(t *testing.T) {
+	for _, latency := range []time.Duration{3 * time.Millisecond, 95 * time.Millisecond, 1234 * time.Millisecond, 17 * time.Minute} {
+		// A larger maximum latency does not clamp the percentile.
+		h := testLatencyHistogram(latency, latency, time.Duration(float64(latency)*1.5))
+
+		if got := h.percentile(50); got < latency || float64(got) > float64(latency)*1.19 {
+			t.Errorf("%s: got %s, expected within 19%%", latency, got)
+		}
+	}
+}
+
+func TestAPICallTracerOperationSummary(t *testing.T) {
+	tracer := newAPICallTracer(&testAPICallExporter{})
+	defer tracer.stop()
+
+	var id string
+	resource := tracedResource{
+		id:           func() string { return id },
+		operation:    &tracedOperation{apiCalls: make(map[string]int), name: "Create"},
+		resourceType: "aws_vpc",
+	}
+
+	if got := tracer.operationSummary(resource); got != "" {
+		t.Errorf("got summary %q, expected none", got)
+	}
+
+	for _, operation := range []string{"CreateVpc", "DescribeVpcs", "DescribeVpcs"} {
+		r := &request.Request{
+			ClientInfo:  metadata.ClientInfo{ServiceID: ec2.ServiceID},
+			HTTPRequest: &http.Request{},
+			Operation:   &request.Operation{Name: operation},
+			Time:        time.Now(),
+		}
+		r.SetContext(contextWithTracedResource(context.Background(), resource))
+
+		tracer.record(r)
+
+		id = "vpc-12345678"
+	}
+
+	// Calls made by other operations of the resource are not included.
+	other := resource
+	other.operation = &tracedOperation{apiCalls: make(map[string]int), name: "Read"}
+	r := &request.Request{
+		ClientInfo:  metadata.ClientInfo{ServiceID: ec2.ServiceID},
+		HTTPRequest: &http.Request{},
+		Operation:   &request.Operation{Name: "DescribeVpcAttribute"},
+		Time:        time.Now(),
+	}
+	r.SetContext(contextWithTracedResource(context.Background(), other))
+	tracer.record(r)
+
+	summary := tracer.operationSummary(resource)
+
+	for _, expected := range []string{
+		"aws_vpc vpc-12345678 Create: 3 calls, 0 throttles, 0 errors",
+		"(EC2.CreateVpc: 1, EC2.DescribeVpcs: 2)",
+	} {
+		if !strings.Contains(summary, expected) {
+			t.Errorf("got summary %q, expected it to contain %q", summary, expected)
+		}
+	}
+}
+
+func TestJSONLAPICallExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	// Records from successive provider processes are appended to the same file.
+	for i := 0; i < 2; i++ {
+		exporter, err := newJSONLAPICallExporter(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := exporter.Export(&APICallRecord{Operation: "DescribeVpcs", Service: "EC2", RetryCount: i}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := exporter.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var records []APICallRecord
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var record APICallRecord
+
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("error decoding %q: %s", scanner.Text(), err)
+		}
+
+		records = append(records, record)
+	}
+
+	if got, expected := len(records), 2; got != expected {
+		t.Fatalf("got %d records, expected %d", got, expected)
+	}
+
+	if got, expected := records[1].RetryCount, 1; got != expected {
+		t.Errorf("got retry count %d, expected %d", got, expected)
+	}
+}
+
+func TestOTLPAPICallExporter(t *testing.T) {
+	var body []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	exporter := newOTLPAPICallExporter(server.URL+"/v1/traces", server.Client())
+
+	for _, record := range []*APICallRecord{
+		{Operation: "DescribeVpcs", Service: "EC2", StartTime: time.Unix(1, 0), LatencyMillis: 1000},
+		{Operation: "DescribeSubnets", Service: "EC2", ErrorCode: "RequestLimitExceeded", StartTime: time.Unix(2, 0)},
+	} {
+		if err := exporter.Export(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if body != nil {
+		t.Fatal("expected spans to be buffered until closed")
+	}
+
+	if err := exporter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var request struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []otlpSpan
+			}
+		}
+	}
+
+	if err := json.Unmarshal(body, &request); err != nil {
+		t.Fatalf("error decoding %q: %s", body, err)
+	}
+
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+
+	if got, expected := len(spans), 2; got != expected {
+		t.Fatalf("got %d spans, expected %d", got, expected)
+	}
+
+	if got, expected := spans[0].Name, "EC2/DescribeVpcs"; got != expected {
+		t.Errorf("got span name %s, expected %s", got, expected)
+	}
+
+	if got, expected := spans[0].EndTimeUnixNano, "2000000000"; got != expected {
+		t.Errorf("got span end time %s, expected %s", got, expected)
+	}
+
+	if spans[0].TraceID != spans[1].TraceID {
+		t.Errorf("expected spans to share a trace ID, got %s and %s", spans[0].TraceID, spans[1].TraceID)
+	}
+
+	if got, expected := spans[1].Status["code"], otlpStatusCodeError; got != expected {
+		t.Errorf("got span status %s, expected %s", got, expected)
+	}
+}
+
+func TestAWSClientWithTracedResource(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("test", "test", ""),
+		Region:      aws.String(endpoints.UsEast1RegionID),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parent := &AWSClient{session: sess}
+	traced := parent.withTracedResource(tracedResource{id: func() string { return "vpc-12345678" }, resourceType: "aws_vpc"})
+
+	var created int
+	newConn := func(sess *session.Session) interface{} {
+		created++
+		return ec2.New(sess)
+	}
+
+	conn := traced.conn(EC2, newConn).(*ec2.EC2)
+	traced.conn(EC2, newConn)
+	parentConn := parent.conn(EC2, newConn).(*ec2.EC2)
+
+	// Service clients are created once and shared with the parent client.
+	if created != 1 {
+		t.Errorf("got %d service clients created, expected 1", created)
+	}
+
+	for _, testCase := range []struct {
+		Name     string
+		Conn     *ec2.EC2
+		Expected string
+	}{
+		{Name: "traced", Conn: conn, Expected: "vpc-12345678"},
+		{Name: "parent", Conn: parentConn},
+	} {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r, _ := testCase.Conn.DescribeVpcsRequest(&ec2.DescribeVpcsInput{})
+			r.Handlers.Build.Run(r)
+
+			var got string
+			if v, ok := r.Context().Value(tracedResourceContextKey{}).(tracedResource); ok {
+				got = v.id()
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got resource ID %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+
+	var describedEndpoints int
+	newMediaConvertConn := func(client *AWSClient) (*mediaconvert.MediaConvert, error) {
+		describedEndpoints++
+
+		// The cached client is created from the untraced client so that no resource's handler is added to it.
+		if client.tracedResource != nil {
+			t.Error("expected MediaConvert account client to be created from the untraced client")
+		}
+
+		return mediaconvert.New(client.session), nil
+	}
+
+	queue := parent.withTracedResource(tracedResource{id: func() string { return "queue" }, resourceType: "aws_media_convert_queue"})
+
+	for _, testCase := range []struct {
+		Name     string
+		Client   *AWSClient
+		Expected string
+	}{
+		{Name: "traced", Client: traced, Expected: "vpc-12345678"},
+		{Name: "other traced", Client: queue, Expected: "queue"},
+		{Name: "parent", Client: parent},
+	} {
+		t.Run("MediaConvert account "+testCase.Name, func(t *testing.T) {
+			conn, err := testCase.Client.MediaConvertAccountConn(newMediaConvertConn)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r, _ := conn.ListQueuesRequest(&mediaconvert.ListQueuesInput{})
+			r.Handlers.Build.Run(r)
+
+			var got string
+			if v, ok := r.Context().Value(tracedResourceContextKey{}).(tracedResource); ok {
+				got = v.id()
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got resource ID %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+
+	if describedEndpoints != 1 {
+		t.Errorf("got %d MediaConvert account clients created, expected 1", describedEndpoints)
+	}
+}
@@ -0,0 +1,63 @@
+package conns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// validateRegionAllowed returns an error if the region is forbidden or, when any regions are allowed, is not one of them.
+func validateRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	for _, forbiddenRegion := range forbiddenRegions {
+		if region == forbiddenRegion {
+			return fmt.Errorf("Forbidden AWS Region: %s (forbidden_regions: %s)", region, strings.Join(forbiddenRegions, ", "))
+		}
+	}
+
+	if len(allowedRegions) == 0 {
+		return nil
+	}
+
+	for _, allowedRegion := range allowedRegions {
+		if region == allowedRegion {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("AWS Region not allowed: %s (allowed_regions: %s)", region, strings.Join(allowedRegions, ", "))
+}
+
+// validateOrganizationID returns an error if, when any AWS Organizations are allowed,
+// the caller's account is not a member of one of them.
+func validateOrganizationID(conn *organizations.Organizations, accountID string, allowedOrganizationIDs []string) error {
+	if len(allowedOrganizationIDs) == 0 {
+		return nil
+	}
+
+	output, err := conn.DescribeOrganization(&organizations.DescribeOrganizationInput{})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		return fmt.Errorf("AWS Account (%s) is not a member of an AWS Organization (allowed_organization_ids: %s)", accountID, strings.Join(allowedOrganizationIDs, ", "))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AWS Organization to validate allowed_organization_ids: %w", err)
+	}
+
+	if output == nil || output.Organization == nil {
+		return fmt.Errorf("error reading AWS Organization to validate allowed_organization_ids: empty response")
+	}
+
+	organizationID := aws.StringValue(output.Organization.Id)
+
+	for _, allowedOrganizationID := range allowedOrganizationIDs {
+		if organizationID == allowedOrganizationID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("AWS Organization ID not allowed: %s for AWS Account (%s) (allowed_organization_ids: %s)", organizationID, accountID, strings.Join(allowedOrganizationIDs, ", "))
+}
@@ -0,0 +1,131 @@
+package conns
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestValidateRegionAllowed(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		AllowedRegions   []string
+		ForbiddenRegions []string
+		ExpectError      bool
+	}{
+		{
+			Name: "no restrictions",
+		},
+		{
+			Name:           "allowed",
+			AllowedRegions: []string{endpoints.UsEast1RegionID, endpoints.UsWest2RegionID},
+		},
+		{
+			Name:           "not allowed",
+			AllowedRegions: []string{endpoints.UsWest2RegionID},
+			ExpectError:    true,
+		},
+		{
+			Name:             "forbidden",
+			ForbiddenRegions: []string{endpoints.UsEast1RegionID},
+			ExpectError:      true,
+		},
+		{
+			Name:             "not forbidden",
+			ForbiddenRegions: []string{endpoints.UsWest2RegionID},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validateRegionAllowed(endpoints.UsEast1RegionID, testCase.AllowedRegions, testCase.ForbiddenRegions)
+
+			if got := err != nil; got != testCase.ExpectError {
+				t.Errorf("got error %v, expected error %t", err, testCase.ExpectError)
+			}
+		})
+	}
+}
+
+func TestConfigClientAllowedOrganizationIDs(t *testing.T) {
+	testCases := []struct {
+		Name                   string
+		AllowedOrganizationIDs []string
+		OrganizationsResponse  string
+		OrganizationsStatus    int
+		ExpectedError          string
+	}{
+		{
+			Name: "no restrictions",
+		},
+		{
+			Name:                   "allowed",
+			AllowedOrganizationIDs: []string{"o-exampleorgid"},
+			OrganizationsResponse:  `{"Organization":{"Id":"o-exampleorgid","MasterAccountId":"111111111111"}}`,
+			OrganizationsStatus:    http.StatusOK,
+		},
+		{
+			Name:                   "not allowed",
+			AllowedOrganizationIDs: []string{"o-otherorgid"},
+			OrganizationsResponse:  `{"Organization":{"Id":"o-exampleorgid","MasterAccountId":"111111111111"}}`,
+			OrganizationsStatus:    http.StatusOK,
+			ExpectedError:          "AWS Organization ID not allowed: o-exampleorgid",
+		},
+		{
+			Name:                   "not a member",
+			AllowedOrganizationIDs: []string{"o-exampleorgid"},
+			OrganizationsResponse:  `{"__type":"AWSOrganizationsNotInUseException","Message":"Your account is not a member of an organization."}`,
+			OrganizationsStatus:    http.StatusBadRequest,
+			ExpectedError:          "is not a member of an AWS Organization",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var organizationsRequests int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("X-Amz-Target") == "AWSOrganizationsV20161128.DescribeOrganization" {
+					organizationsRequests++
+					w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+					w.WriteHeader(testCase.OrganizationsStatus)
+					fmt.Fprintln(w, testCase.OrganizationsResponse)
+					return
+				}
+
+				w.Header().Set("Content-Type", "text/xml")
+				fmt.Fprintln(w, awsbase.MockStsGetCallerIdentityValidResponseBody)
+			}))
+			defer server.Close()
+
+			config := &Config{
+				AccessKey:              awsbase.MockStaticAccessKey,
+				AllowedOrganizationIds: testCase.AllowedOrganizationIDs,
+				Endpoints:              map[string]string{Organizations: server.URL, STS: server.URL},
+				MaxRetries:             1,
+				Region:                 endpoints.UsEast1RegionID,
+				SecretKey:              awsbase.MockStaticSecretKey,
+				SkipGetEC2Platforms:    true,
+			}
+
+			_, err := config.Client()
+
+			if testCase.ExpectedError == "" && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), testCase.ExpectedError)) {
+				t.Fatalf("got error %v, expected error containing %q", err, testCase.ExpectedError)
+			}
+
+			if got, expected := organizationsRequests > 0, len(testCase.AllowedOrganizationIDs) > 0; got != expected {
+				t.Errorf("got Organizations requested %t, expected %t", got, expected)
+			}
+		})
+	}
+}
@@ -27,6 +27,76 @@ func NameWithSuffix(name string, namePrefix string, nameSuffix string) string {
 	return resource.UniqueId() + nameSuffix
 }
 
+// NamingConfig contains a provider-level policy for names generated by resources.
+type NamingConfig struct {
+	MaxLength int
+	Prefix    string
+	Suffix    string
+}
+
+// GetPrefix is convenience method that returns the NamingConfig's Prefix, if any.
+func (nc *NamingConfig) GetPrefix() string {
+	if nc == nil {
+		return ""
+	}
+
+	return nc.Prefix
+}
+
+// Name returns in order the name if non-empty, a prefix generated name if non-empty,
+// or a name generated from the NamingConfig's Prefix, or fully generated name prefixed with "terraform-".
+// In all but the first case, the NamingConfig's Suffix is appended to the generated name.
+func (nc *NamingConfig) Name(name string, namePrefix string) string {
+	return nc.NameWithSuffix(name, namePrefix, "")
+}
+
+// NameWithSuffix returns a name as Name does, appending any suffix to a generated name
+// after the NamingConfig's Suffix.
+func (nc *NamingConfig) NameWithSuffix(name string, namePrefix string, nameSuffix string) string {
+	if nc == nil || name != "" {
+		return NameWithSuffix(name, namePrefix, nameSuffix)
+	}
+
+	if namePrefix == "" {
+		namePrefix = nc.Prefix
+	}
+
+	return NameWithSuffix(name, namePrefix, nc.Suffix+nameSuffix)
+}
+
+// NamePrefixFromName returns a name prefix if the string matches prefix criteria,
+// allowing for the NamingConfig's Suffix.
+func (nc *NamingConfig) NamePrefixFromName(name string) *string {
+	return nc.NamePrefixFromNameWithSuffix(name, "")
+}
+
+// NamePrefixFromNameWithSuffix returns a name prefix if the string matches prefix criteria,
+// allowing for the NamingConfig's Suffix.
+// Names generated before the Suffix was configured are also matched.
+func (nc *NamingConfig) NamePrefixFromNameWithSuffix(name, nameSuffix string) *string {
+	if nc != nil && nc.Suffix != "" {
+		if namePrefix := NamePrefixFromNameWithSuffix(name, nc.Suffix+nameSuffix); namePrefix != nil {
+			return namePrefix
+		}
+	}
+
+	return NamePrefixFromNameWithSuffix(name, nameSuffix)
+}
+
+// ValidateNameLength returns an error if the name is longer than the specified maximum length
+// or the NamingConfig's MaxLength. A maximum length of zero is not enforced.
+func (nc *NamingConfig) ValidateNameLength(name string, maxLength int) error {
+	if nc != nil && nc.MaxLength > 0 && (maxLength == 0 || nc.MaxLength < maxLength) {
+		maxLength = nc.MaxLength
+	}
+
+	if maxLength > 0 && len(name) > maxLength {
+		return fmt.Errorf("%q is %d characters long, exceeding the maximum length of %d", name, len(name), maxLength)
+	}
+
+	return nil
+}
+
 // HasResourceUniqueIdSuffix returns true if the string has the built-in unique ID suffix
 func HasResourceUniqueIdSuffix(s string) bool {
 	return HasResourceUniqueIdPlusAdditionalSuffix(s, "")
@@ -390,3 +390,164 @@ func TestNamePrefixFromNameWithSuffix(t *testing.T) {
 		}
 	})
 }
+
+func TestNamingConfigNameWithSuffix(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		Config                *NamingConfig
+		Name                  string
+		NamePrefix            string
+		NameSuffix            string
+		ExpectedRegexpPattern string
+	}{
+		{
+			TestName:              "nil config",
+			Config:                nil,
+			NameSuffix:            ".fifo",
+			ExpectedRegexpPattern: resourceUniqueIDPrefixPlusAdditionalSuffixRegexpPattern(`\.fifo`),
+		},
+		{
+			TestName:              "name ignores config",
+			Config:                &NamingConfig{Prefix: "acme-", Suffix: "-dev"},
+			Name:                  "test",
+			NameSuffix:            ".fifo",
+			ExpectedRegexpPattern: "^test$",
+		},
+		{
+			TestName:              "name prefix overrides config prefix",
+			Config:                &NamingConfig{Prefix: "acme-", Suffix: "-dev"},
+			NamePrefix:            "prefix-",
+			NameSuffix:            ".fifo",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDPlusAdditionalSuffixRegexpPattern("prefix-", `-dev\.fifo`),
+		},
+		{
+			TestName:              "config prefix",
+			Config:                &NamingConfig{Prefix: "acme-", Suffix: "-dev"},
+			NameSuffix:            ".fifo",
+			ExpectedRegexpPattern: resourcePrefixedUniqueIDPlusAdditionalSuffixRegexpPattern("acme-", `-dev\.fifo`),
+		},
+		{
+			TestName:              "config suffix only",
+			Config:                &NamingConfig{Suffix: "-dev"},
+			ExpectedRegexpPattern: resourceUniqueIDPrefixPlusAdditionalSuffixRegexpPattern("-dev"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := testCase.Config.NameWithSuffix(testCase.Name, testCase.NamePrefix, testCase.NameSuffix)
+
+			expectedRegexp, err := regexp.Compile(testCase.ExpectedRegexpPattern)
+
+			if err != nil {
+				t.Errorf("unable to compile regular expression pattern %s: %s", testCase.ExpectedRegexpPattern, err)
+			}
+
+			if !expectedRegexp.MatchString(got) {
+				t.Errorf("got %s, expected to match regular expression pattern %s", got, testCase.ExpectedRegexpPattern)
+			}
+		})
+	}
+}
+
+func TestNamingConfigNamePrefixFromNameWithSuffix(t *testing.T) {
+	config := &NamingConfig{Prefix: "acme-", Suffix: "-dev"}
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected *string
+	}{
+		{
+			TestName: "incorrect suffix",
+			Input:    "test-123",
+			Expected: nil,
+		},
+		{
+			TestName: "config suffix",
+			Input:    "acme-20060102150405000000000001-dev.fifo",
+			Expected: strPtr("acme-"),
+		},
+		{
+			TestName: "generated before config suffix",
+			Input:    "terraform-20060102150405000000000001.fifo",
+			Expected: strPtr("terraform-"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			expected := testCase.Expected
+			got := config.NamePrefixFromNameWithSuffix(testCase.Input, ".fifo")
+
+			if expected == nil && got != nil {
+				t.Errorf("got %s, expected nil", *got)
+			}
+
+			if expected != nil && got == nil {
+				t.Errorf("got nil, expected %s", *expected)
+			}
+
+			if expected != nil && got != nil && *expected != *got {
+				t.Errorf("got %s, expected %s", *got, *expected)
+			}
+		})
+	}
+}
+
+func TestNamingConfigValidateNameLength(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Config        *NamingConfig
+		Name          string
+		MaxLength     int
+		ExpectedError bool
+	}{
+		{
+			TestName:  "nil config, within limit",
+			Name:      "test",
+			MaxLength: 4,
+		},
+		{
+			TestName:      "nil config, exceeds limit",
+			Name:          "test",
+			MaxLength:     3,
+			ExpectedError: true,
+		},
+		{
+			TestName: "no limits",
+			Config:   &NamingConfig{},
+			Name:     "test",
+		},
+		{
+			TestName:      "config limit lower than service limit",
+			Config:        &NamingConfig{MaxLength: 3},
+			Name:          "test",
+			MaxLength:     64,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "config limit higher than service limit",
+			Config:        &NamingConfig{MaxLength: 255},
+			Name:          "test",
+			MaxLength:     3,
+			ExpectedError: true,
+		},
+		{
+			TestName:      "config limit without service limit",
+			Config:        &NamingConfig{MaxLength: 3},
+			Name:          "test",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := testCase.Config.ValidateNameLength(testCase.Name, testCase.MaxLength)
+
+			if got := err != nil; got != testCase.ExpectedError {
+				t.Errorf("got error %v, expected error %t", err, testCase.ExpectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,840 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	filename = "find_gen.go"
+
+	outputListFirst  = "first"
+	outputListSingle = "single"
+)
+
+var (
+	specFile = flag.String("Spec", "finders.yaml", "path of the finders spec file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Spec is the contents of a finders spec file.
+type Spec struct {
+	Finders []*FinderSpec `yaml:"finders"`
+}
+
+// FinderSpec declares a finder for a single API object.
+type FinderSpec struct {
+	// Context indicates that the finder takes a context.Context and calls the operation's WithContext variant.
+	Context     bool              `yaml:"context"`
+	Identifiers []*IdentifierSpec `yaml:"identifiers"`
+	Name        string            `yaml:"name"`
+	// NotFoundErrCodes are the error codes returned when the object does not exist.
+	NotFoundErrCodes []string `yaml:"notFoundErrCodes"`
+	// NotFoundErrMessages are the error codes, with a message they must contain, returned when the object does not exist.
+	NotFoundErrMessages []*ErrMessageSpec `yaml:"notFoundErrMessages"`
+	Op                  string            `yaml:"op"`
+	// OutputElem is the output element, dot-separated for nested elements, holding the object.
+	OutputElem string `yaml:"outputElem"`
+	// OutputList is how an output element holding a list of objects is handled, "first" or "single".
+	OutputList string `yaml:"outputList"`
+	Paginated  bool   `yaml:"paginated"`
+	ResultType string `yaml:"resultType"`
+}
+
+// IdentifierSpec declares a finder parameter and the input element it is set in.
+type IdentifierSpec struct {
+	Elem  string `yaml:"elem"`
+	Name  string `yaml:"name"`
+	Slice bool   `yaml:"slice"`
+}
+
+// ErrMessageSpec declares an error code and message.
+type ErrMessageSpec struct {
+	Code    string `yaml:"code"`
+	Message string `yaml:"message"`
+}
+
+type TemplateData struct {
+	AWSService     string
+	ClientType     string
+	ServicePackage string
+
+	Finders []*FinderSpec
+
+	ContextPkg  bool
+	NotFoundErr bool
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+	awsService, err := awsServiceName(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	awsServiceUpper, err := awsServiceNameUpper(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	spec, err := loadSpec(*specFile)
+
+	if err != nil {
+		log.Fatalf("error loading spec (%s): %s", *specFile, err)
+	}
+
+	templateData := TemplateData{
+		AWSService:     awsService,
+		ClientType:     fmt.Sprintf("*%s.%s", awsService, awsServiceUpper),
+		ServicePackage: servicePackage,
+		Finders:        spec.Finders,
+	}
+
+	for _, finder := range spec.Finders {
+		if finder.Context {
+			templateData.ContextPkg = true
+		}
+
+		if len(finder.NotFoundErrCodes) > 0 || len(finder.NotFoundErrMessages) > 0 {
+			templateData.NotFoundErr = true
+		}
+	}
+
+	tmpl := template.Must(template.New("finders").Funcs(template.FuncMap{
+		"NotFound": func(f *FinderSpec) string {
+			return f.notFoundCondition(awsService)
+		},
+	}).Parse(templateBody))
+
+	var buffer bytes.Buffer
+
+	if err := tmpl.Execute(&buffer, templateData); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	generatedFileContents, err := format.Source(buffer.Bytes())
+
+	if err != nil {
+		log.Fatalf("error formatting generated file: %s\n%s", err, buffer.String())
+	}
+
+	if err := os.WriteFile(filename, generatedFileContents, 0644); err != nil {
+		log.Fatalf("error writing to file (%s): %s", filename, err)
+	}
+}
+
+func loadSpec(filename string) (*Spec, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &Spec{}
+
+	if err := yaml.UnmarshalStrict(b, spec); err != nil {
+		return nil, err
+	}
+
+	if len(spec.Finders) == 0 {
+		return nil, errors.New("no finders")
+	}
+
+	names := map[string]bool{}
+
+	for _, finder := range spec.Finders {
+		if err := finder.init(); err != nil {
+			return nil, fmt.Errorf("finder %q: %w", finder.Name, err)
+		}
+
+		if names[finder.Name] {
+			return nil, fmt.Errorf("duplicate finder %q", finder.Name)
+		}
+
+		names[finder.Name] = true
+	}
+
+	sort.Slice(spec.Finders, func(i, j int) bool {
+		return spec.Finders[i].Name < spec.Finders[j].Name
+	})
+
+	return spec, nil
+}
+
+var goIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (f *FinderSpec) init() error {
+	if !goIdentifierRegexp.MatchString(f.Name) {
+		return errors.New("name must be a Go identifier")
+	}
+
+	if f.Op == "" || f.OutputElem == "" || f.ResultType == "" {
+		return errors.New("op, outputElem and resultType are required")
+	}
+
+	if len(f.Identifiers) == 0 {
+		return errors.New("at least one identifier is required")
+	}
+
+	for _, identifier := range f.Identifiers {
+		if identifier.Elem == "" {
+			return errors.New("identifier elem is required")
+		}
+
+		if identifier.Name == "" {
+			identifier.Name = "id"
+		}
+
+		if !goIdentifierRegexp.MatchString(identifier.Name) {
+			return fmt.Errorf("identifier name %q must be a Go identifier", identifier.Name)
+		}
+	}
+
+	switch f.OutputList {
+	case "", outputListFirst, outputListSingle:
+	default:
+		return fmt.Errorf("outputList must be %q or %q", outputListFirst, outputListSingle)
+	}
+
+	if f.Paginated && f.OutputList == "" {
+		return errors.New("paginated requires outputList")
+	}
+
+	for _, m := range f.NotFoundErrMessages {
+		if m.Code == "" || m.Message == "" {
+			return errors.New("notFoundErrMessages require code and message")
+		}
+	}
+
+	return nil
+}
+
+// errCodeExpr returns the Go expression for an error code. Codes that are Go identifiers
+// name AWS Go SDK error code constants, e.g. ResourceNotFoundException is kinesis.ErrCodeResourceNotFoundException.
+func errCodeExpr(awsService, code string) string {
+	if goIdentifierRegexp.MatchString(code) {
+		return fmt.Sprintf("%s.ErrCode%s", awsService, code)
+	}
+
+	return fmt.Sprintf("%q", code)
+}
+
+// notFoundCondition returns the Go expression for whether err is a NotFound error.
+func (f *FinderSpec) notFoundCondition(awsService string) string {
+	var conditions []string
+
+	if len(f.NotFoundErrCodes) > 0 {
+		codes := make([]string, 0, len(f.NotFoundErrCodes))
+
+		for _, code := range f.NotFoundErrCodes {
+			codes = append(codes, errCodeExpr(awsService, code))
+		}
+
+		conditions = append(conditions, fmt.Sprintf("tfawserr.ErrCodeEquals(err, %s)", strings.Join(codes, ", ")))
+	}
+
+	for _, m := range f.NotFoundErrMessages {
+		conditions = append(conditions, fmt.Sprintf("tfawserr.ErrMessageContains(err, %s, %q)", errCodeExpr(awsService, m.Code), m.Message))
+	}
+
+	return strings.Join(conditions, " || ")
+}
+
+// Params returns the finder's parameters.
+func (f *FinderSpec) Params() string {
+	params := make([]string, 0, len(f.Identifiers))
+
+	for _, identifier := range f.Identifiers {
+		params = append(params, identifier.Name)
+	}
+
+	return strings.Join(params, ", ") + " string"
+}
+
+// OutputElemParents returns the output elements, if nested, containing OutputElem.
+func (f *FinderSpec) OutputElemParents() []string {
+	parts := strings.Split(f.OutputElem, ".")
+	parents := make([]string, 0, len(parts)-1)
+
+	for i := 1; i < len(parts); i++ {
+		parents = append(parents, strings.Join(parts[:i], "."))
+	}
+
+	return parents
+}
+
+var templateBody = `
+// Code generated by internal/generate/finders/main.go; DO NOT EDIT.
+
+package {{ .ServicePackage }}
+
+import (
+{{- if .ContextPkg }}
+	"context"
+
+{{ end }}
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/{{ .AWSService }}"
+{{- if .NotFoundErr }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+{{- end }}
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+{{ range .Finders }}
+func {{ .Name }}({{ if .Context }}ctx context.Context, {{ end }}conn {{ $.ClientType }}, {{ .Params }}) (*{{ $.AWSService }}.{{ .ResultType }}, error) {
+	input := &{{ $.AWSService }}.{{ .Op }}Input{
+	{{- range .Identifiers }}
+		{{ .Elem }}: {{ if .Slice }}aws.StringSlice([]string{ {{- .Name -}} }){{ else }}aws.String({{ .Name }}){{ end }},
+	{{- end }}
+	}
+{{ if .Paginated }}
+	var output []*{{ $.AWSService }}.{{ .ResultType }}
+
+	err := conn.{{ .Op }}Pages{{ if .Context }}WithContext(ctx, {{ else }}({{ end }}input, func(page *{{ $.AWSService }}.{{ .Op }}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+{{ range .OutputElemParents }}
+		if page.{{ . }} == nil {
+			return !lastPage
+		}
+{{ end }}
+		for _, v := range page.{{ .OutputElem }} {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+{{ else }}
+	output, err := conn.{{ .Op }}{{ if .Context }}WithContext(ctx, {{ else }}({{ end }}input)
+{{ end }}
+{{- if or .NotFoundErrCodes .NotFoundErrMessages }}
+	if {{ NotFound . }} {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	if err != nil {
+		return nil, err
+	}
+{{ if .Paginated }}
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+{{ if eq .OutputList "single" }}
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+{{ end }}
+	return output[0], nil
+{{- else if .OutputList }}
+	if output == nil{{ range .OutputElemParents }} || output.{{ . }} == nil{{ end }} || len(output.{{ .OutputElem }}) == 0 || output.{{ .OutputElem }}[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+{{ if eq .OutputList "single" }}
+	if count := len(output.{{ .OutputElem }}); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+{{ end }}
+	return output.{{ .OutputElem }}[0], nil
+{{- else }}
+	if output == nil{{ range .OutputElemParents }} || output.{{ . }} == nil{{ end }} || output.{{ .OutputElem }} == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.{{ .OutputElem }}, nil
+{{- end }}
+}
+{{ end }}`
+
+func awsServiceName(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if _, ok := awsServiceNames[s]; ok {
+		return s, nil
+	}
+
+	switch s {
+	case "amp":
+		return "prometheusservice", nil
+	case "cloudcontrol":
+		return "cloudcontrolapi", nil
+	case "cognitoidp":
+		return "cognitoidentityprovider", nil
+	case "dms":
+		return "databasemigrationservice", nil
+	case "ds":
+		return "directoryservice", nil
+	case "events":
+		return "eventbridge", nil
+	case "lexmodels":
+		return "lexmodelbuildingservice", nil
+	case "serverlessrepo":
+		return "serverlessapplicationrepository", nil
+	}
+
+	if _, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
+		return fmt.Sprintf("%sservice", s), nil
+	}
+
+	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+}
+
+func awsServiceNameUpper(s string) (string, error) {
+	s = strings.ToLower(s)
+
+	if v, ok := awsServiceNames[s]; ok {
+		return v, nil
+	}
+
+	switch s {
+	case "amp":
+		return awsServiceNames["prometheusservice"], nil
+	case "appautoscaling":
+		return awsServiceNames["applicationautoscaling"], nil
+	case "cloudcontrol":
+		return awsServiceNames["cloudcontrolapi"], nil
+	case "cognitoidp":
+		return awsServiceNames["cognitoidentityprovider"], nil
+	case "dms":
+		return awsServiceNames["databasemigrationservice"], nil
+	case "ds":
+		return awsServiceNames["directoryservice"], nil
+	case "events":
+		return awsServiceNames["eventbridge"], nil
+	case "lexmodels":
+		return awsServiceNames["lexmodelbuildingservice"], nil
+	case "serverlessrepo":
+		return awsServiceNames["serverlessapplicationrepository"], nil
+	}
+
+	if v, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("unable to find AWS service name for %s", s)
+}
+
+// awsServiceNames provides correct names and capitalization as used by AWS in client var
+var awsServiceNames map[string]string
+
+func init() {
+	awsServiceNames = make(map[string]string)
+
+	awsServiceNames["accessanalyzer"] = "AccessAnalyzer"
+	awsServiceNames["acm"] = "ACM"
+	awsServiceNames["acmpca"] = "ACMPCA"
+	awsServiceNames["alexaforbusiness"] = "AlexaForBusiness"
+	awsServiceNames["amplify"] = "Amplify"
+	awsServiceNames["amplifybackend"] = "AmplifyBackend"
+	awsServiceNames["apigateway"] = "APIGateway"
+	awsServiceNames["apigatewaymanagement"] = "APIGatewayManagement"
+	awsServiceNames["apigatewayv2"] = "APIGatewayV2"
+	awsServiceNames["appconfig"] = "AppConfig"
+	awsServiceNames["appflow"] = "AppFlow"
+	awsServiceNames["appintegrations"] = "AppIntegrations"
+	awsServiceNames["applicationautoscaling"] = "ApplicationAutoScaling"
+	awsServiceNames["applicationcostprofiler"] = "ApplicationCostProfiler"
+	awsServiceNames["applicationdiscovery"] = "ApplicationDiscovery"
+	awsServiceNames["applicationinsights"] = "ApplicationInsights"
+	awsServiceNames["appmesh"] = "AppMesh"
+	awsServiceNames["appregistry"] = "AppRegistry"
+	awsServiceNames["apprunner"] = "AppRunner"
+	awsServiceNames["appstream"] = "AppStream"
+	awsServiceNames["appsync"] = "AppSync"
+	awsServiceNames["athena"] = "Athena"
+	awsServiceNames["auditmanager"] = "AuditManager"
+	awsServiceNames["augmentedairuntime"] = "AugmentedAiruntime"
+	awsServiceNames["autoscaling"] = "AutoScaling"
+	awsServiceNames["autoscalingplans"] = "AutoScalingPlans"
+	awsServiceNames["backup"] = "Backup"
+	awsServiceNames["batch"] = "Batch"
+	awsServiceNames["braket"] = "Braket"
+	awsServiceNames["budgets"] = "Budgets"
+	awsServiceNames["chime"] = "Chime"
+	awsServiceNames["cloud9"] = "Cloud9"
+	awsServiceNames["cloudcontrolapi"] = "CloudControlApi"
+	awsServiceNames["clouddirectory"] = "CloudDirectory"
+	awsServiceNames["cloudformation"] = "CloudFormation"
+	awsServiceNames["cloudfront"] = "CloudFront"
+	awsServiceNames["cloudhsm"] = "CloudHSM"
+	awsServiceNames["cloudhsmv2"] = "CloudHSMV2"
+	awsServiceNames["cloudsearch"] = "CloudSearch"
+	awsServiceNames["cloudsearchdomain"] = "CloudSearchDomain"
+	awsServiceNames["cloudtrail"] = "CloudTrail"
+	awsServiceNames["cloudwatch"] = "CloudWatch"
+	awsServiceNames["cloudwatchlogs"] = "CloudWatchLogs"
+	awsServiceNames["codeartifact"] = "CodeArtifact"
+	awsServiceNames["codebuild"] = "CodeBuild"
+	awsServiceNames["codecommit"] = "CodeCommit"
+	awsServiceNames["codedeploy"] = "CodeDeploy"
+	awsServiceNames["codeguruprofiler"] = "CodeGuruProfiler"
+	awsServiceNames["codegurureviewer"] = "CodeGuruReviewer"
+	awsServiceNames["codepipeline"] = "CodePipeline"
+	awsServiceNames["codestar"] = "CodeStar"
+	awsServiceNames["codestarconnections"] = "CodeStarConnections"
+	awsServiceNames["codestarnotifications"] = "CodeStarNotifications"
+	awsServiceNames["cognitoidentity"] = "CognitoIdentity"
+	awsServiceNames["cognitoidentityprovider"] = "CognitoIdentityProvider"
+	awsServiceNames["cognitosync"] = "CognitoSync"
+	awsServiceNames["comprehend"] = "Comprehend"
+	awsServiceNames["comprehendmedical"] = "ComprehendMedical"
+	awsServiceNames["computeoptimizer"] = "ComputeOptimizer"
+	awsServiceNames["configservice"] = "ConfigService"
+	awsServiceNames["connect"] = "Connect"
+	awsServiceNames["connectcontactlens"] = "ConnectContactLens"
+	awsServiceNames["connectparticipant"] = "ConnectParticipant"
+	awsServiceNames["costexplorer"] = "CostExplorer"
+	awsServiceNames["cur"] = "CUR"
+	awsServiceNames["customerprofiles"] = "CustomerProfiles"
+	awsServiceNames["databasemigrationservice"] = "DatabaseMigrationService"
+	awsServiceNames["dataexchange"] = "DataExchange"
+	awsServiceNames["datapipeline"] = "DataPipeline"
+	awsServiceNames["datasync"] = "DataSync"
+	awsServiceNames["dax"] = "DAX"
+	awsServiceNames["detective"] = "Detective"
+	awsServiceNames["devicefarm"] = "DeviceFarm"
+	awsServiceNames["devopsguru"] = "DevOpsGuru"
+	awsServiceNames["directconnect"] = "DirectConnect"
+	awsServiceNames["directoryservice"] = "DirectoryService"
+	awsServiceNames["dlm"] = "DLM"
+	awsServiceNames["docdb"] = "DocDB"
+	awsServiceNames["dynamodb"] = "DynamoDB"
+	awsServiceNames["dynamodbattribute"] = "DynamoDBAttribute"
+	awsServiceNames["dynamodbstreams"] = "DynamoDBStreams"
+	awsServiceNames["ec2"] = "EC2"
+	awsServiceNames["ec2instanceconnect"] = "EC2InstanceConnect"
+	awsServiceNames["ecr"] = "ECR"
+	awsServiceNames["ecrpublic"] = "ECRPublic"
+	awsServiceNames["ecs"] = "ECS"
+	awsServiceNames["efs"] = "EFS"
+	awsServiceNames["eks"] = "EKS"
+	awsServiceNames["elasticache"] = "ElastiCache"
+	awsServiceNames["elasticbeanstalk"] = "ElasticBeanstalk"
+	awsServiceNames["elasticinference"] = "ElasticInference"
+	awsServiceNames["elasticsearchservice"] = "ElasticsearchService"
+	awsServiceNames["elastictranscoder"] = "ElasticTranscoder"
+	awsServiceNames["elb"] = "ELB"
+	awsServiceNames["elbv2"] = "ELBV2"
+	awsServiceNames["emr"] = "EMR"
+	awsServiceNames["emrcontainers"] = "EMRContainers"
+	awsServiceNames["eventbridge"] = "EventBridge"
+	awsServiceNames["expression"] = "Expression"
+	awsServiceNames["finspace"] = "FinSpace"
+	awsServiceNames["finspacedata"] = "FinSpaceData"
+	awsServiceNames["firehose"] = "Firehose"
+	awsServiceNames["fis"] = "FIS"
+	awsServiceNames["fms"] = "FMS"
+	awsServiceNames["forecast"] = "Forecast"
+	awsServiceNames["forecastquery"] = "ForecastQuery"
+	awsServiceNames["frauddetector"] = "FraudDetector"
+	awsServiceNames["fsx"] = "FSx"
+	awsServiceNames["gamelift"] = "GameLift"
+	awsServiceNames["glacier"] = "Glacier"
+	awsServiceNames["globalaccelerator"] = "GlobalAccelerator"
+	awsServiceNames["glue"] = "Glue"
+	awsServiceNames["gluedatabrew"] = "GlueDataBrew"
+	awsServiceNames["greengrass"] = "Greengrass"
+	awsServiceNames["greengrassv2"] = "GreengrassV2"
+	awsServiceNames["groundstation"] = "GroundStation"
+	awsServiceNames["guardduty"] = "GuardDuty"
+	awsServiceNames["health"] = "Health"
+	awsServiceNames["healthlake"] = "HealthLake"
+	awsServiceNames["honeycode"] = "HoneyCode"
+	awsServiceNames["iam"] = "IAM"
+	awsServiceNames["identitystore"] = "IdentityStore"
+	awsServiceNames["imagebuilder"] = "ImageBuilder"
+	awsServiceNames["imagebuilder"] = "Imagebuilder"
+	awsServiceNames["inspector"] = "Inspector"
+	awsServiceNames["iot"] = "IoT"
+	awsServiceNames["iot1clickdevices"] = "IoT1ClickDevices"
+	awsServiceNames["iot1clickprojects"] = "IoT1ClickProjects"
+	awsServiceNames["iotanalytics"] = "IoTAnalytics"
+	awsServiceNames["iotdataplane"] = "IoTDataPlane"
+	awsServiceNames["iotdeviceadvisor"] = "IoTDeviceAdvisor"
+	awsServiceNames["iotevents"] = "IoTEvents"
+	awsServiceNames["ioteventsdata"] = "IoTEventsData"
+	awsServiceNames["iotfleethub"] = "IoTFleetHub"
+	awsServiceNames["iotjobsdataplane"] = "IoTJobsDataPlane"
+	awsServiceNames["iotsecuretunneling"] = "IoTSecureTunneling"
+	awsServiceNames["iotsitewise"] = "IoTSiteWise"
+	awsServiceNames["iotthingsgraph"] = "IoTThingsGraph"
+	awsServiceNames["iotwireless"] = "IoTWireless"
+	awsServiceNames["ivs"] = "IVS"
+	awsServiceNames["kafka"] = "Kafka"
+	awsServiceNames["kendra"] = "Kendra"
+	awsServiceNames["kinesis"] = "Kinesis"
+	awsServiceNames["kinesisanalytics"] = "KinesisAnalytics"
+	awsServiceNames["kinesisanalyticsv2"] = "KinesisAnalyticsV2"
+	awsServiceNames["kinesisvideo"] = "KinesisVideo"
+	awsServiceNames["kinesisvideoarchivedmedia"] = "KinesisVideoArchivedMedia"
+	awsServiceNames["kinesisvideomedia"] = "KinesisVideoMedia"
+	awsServiceNames["kinesisvideosignalingchannels"] = "KinesisVideoSignalingChannels"
+	awsServiceNames["kms"] = "KMS"
+	awsServiceNames["lakeformation"] = "LakeFormation"
+	awsServiceNames["lambda"] = "Lambda"
+	awsServiceNames["lexmodelbuildingservice"] = "LexModelBuildingService"
+	awsServiceNames["lexmodelsv2"] = "LexModelsV2"
+	awsServiceNames["lexruntime"] = "LexRuntime"
+	awsServiceNames["lexruntimev2"] = "LexRuntimeV2"
+	awsServiceNames["licensemanager"] = "LicenseManager"
+	awsServiceNames["lightsail"] = "Lightsail"
+	awsServiceNames["location"] = "Location"
+	awsServiceNames["lookoutequipment"] = "LookoutEquipment"
+	awsServiceNames["lookoutforvision"] = "LookoutForVision"
+	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
+	awsServiceNames["machinelearning"] = "MachineLearning"
+	awsServiceNames["macie"] = "Macie"
+	awsServiceNames["macie2"] = "Macie2"
+	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
+	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
+	awsServiceNames["marketplacecommerceanalytics"] = "MarketplaceCommerceAnalytics"
+	awsServiceNames["marketplaceentitlement"] = "MarketplaceEntitlement"
+	awsServiceNames["marketplacemetering"] = "MarketplaceMetering"
+	awsServiceNames["mediaconnect"] = "MediaConnect"
+	awsServiceNames["mediaconvert"] = "MediaConvert"
+	awsServiceNames["medialive"] = "MediaLive"
+	awsServiceNames["mediapackage"] = "MediaPackage"
+	awsServiceNames["mediapackagevod"] = "MediaPackageVOD"
+	awsServiceNames["mediastore"] = "MediaStore"
+	awsServiceNames["mediastoredata"] = "MediaStoreData"
+	awsServiceNames["mediatailor"] = "MediaTailor"
+	awsServiceNames["memorydb"] = "MemoryDB"
+	awsServiceNames["mgn"] = "Mgn"
+	awsServiceNames["migrationhub"] = "MigrationHub"
+	awsServiceNames["migrationhubconfig"] = "MigrationHubConfig"
+	awsServiceNames["mobile"] = "Mobile"
+	awsServiceNames["mobileanalytics"] = "MobileAnalytics"
+	awsServiceNames["mq"] = "MQ"
+	awsServiceNames["mturk"] = "MTurk"
+	awsServiceNames["mwaa"] = "MWAA"
+	awsServiceNames["neptune"] = "Neptune"
+	awsServiceNames["networkfirewall"] = "NetworkFirewall"
+	awsServiceNames["networkmanager"] = "NetworkManager"
+	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opsworks"] = "OpsWorks"
+	awsServiceNames["opsworkscm"] = "OpsWorksCM"
+	awsServiceNames["organizations"] = "Organizations"
+	awsServiceNames["outposts"] = "Outposts"
+	awsServiceNames["personalize"] = "Personalize"
+	awsServiceNames["personalizeevents"] = "PersonalizeEvents"
+	awsServiceNames["personalizeruntime"] = "PersonalizeRuntime"
+	awsServiceNames["pi"] = "PI"
+	awsServiceNames["pinpoint"] = "Pinpoint"
+	awsServiceNames["pinpointemail"] = "PinpointEmail"
+	awsServiceNames["pinpointsmsvoice"] = "PinpointSMSVoice"
+	awsServiceNames["polly"] = "Polly"
+	awsServiceNames["pricing"] = "Pricing"
+	awsServiceNames["prometheusservice"] = "PrometheusService"
+	awsServiceNames["proton"] = "Proton"
+	awsServiceNames["qldb"] = "QLDB"
+	awsServiceNames["qldbsession"] = "QLDBSession"
+	awsServiceNames["quicksight"] = "QuickSight"
+	awsServiceNames["ram"] = "RAM"
+	awsServiceNames["rds"] = "RDS"
+	awsServiceNames["rdsdata"] = "RDSData"
+	awsServiceNames["rdsutils"] = "RDSUtils"
+	awsServiceNames["redshift"] = "Redshift"
+	awsServiceNames["redshiftdata"] = "RedshiftData"
+	awsServiceNames["rekognition"] = "Rekognition"
+	awsServiceNames["resourcegroups"] = "ResourceGroups"
+	awsServiceNames["resourcegroupstaggingapi"] = "ResourceGroupsTaggingAPI"
+	awsServiceNames["robomaker"] = "RoboMaker"
+	awsServiceNames["route53"] = "Route53"
+	awsServiceNames["route53domains"] = "Route53Domains"
+	awsServiceNames["route53recoverycontrolconfig"] = "Route53RecoveryControlConfig"
+	awsServiceNames["route53recoveryreadiness"] = "Route53RecoveryReadiness"
+	awsServiceNames["route53resolver"] = "Route53Resolver"
+	awsServiceNames["s3"] = "S3"
+	awsServiceNames["s3control"] = "S3Control"
+	awsServiceNames["s3crypto"] = "S3Crypto"
+	awsServiceNames["s3manager"] = "S3Manager"
+	awsServiceNames["s3outposts"] = "S3Outposts"
+	awsServiceNames["sagemaker"] = "SageMaker"
+	awsServiceNames["sagemakeredgemanager"] = "SageMakerEdgeManager"
+	awsServiceNames["sagemakerfeaturestoreruntime"] = "SageMakerFeatureStoreRuntime"
+	awsServiceNames["sagemakerruntime"] = "SageMakerRuntime"
+	awsServiceNames["savingsplans"] = "SavingsPlans"
+	awsServiceNames["schemas"] = "Schemas"
+	awsServiceNames["secretsmanager"] = "SecretsManager"
+	awsServiceNames["securityhub"] = "SecurityHub"
+	awsServiceNames["serverlessapplicationrepository"] = "ServerlessApplicationRepository"
+	awsServiceNames["servicecatalog"] = "ServiceCatalog"
+	awsServiceNames["servicediscovery"] = "ServiceDiscovery"
+	awsServiceNames["servicequotas"] = "ServiceQuotas"
+	awsServiceNames["ses"] = "SES"
+	awsServiceNames["sesv2"] = "SESV2"
+	awsServiceNames["sfn"] = "SFN"
+	awsServiceNames["shield"] = "Shield"
+	awsServiceNames["sign"] = "Sign"
+	awsServiceNames["signer"] = "Signer"
+	awsServiceNames["simpledb"] = "SimpleDB"
+	awsServiceNames["sms"] = "SMS"
+	awsServiceNames["snowball"] = "Snowball"
+	awsServiceNames["sns"] = "SNS"
+	awsServiceNames["sqs"] = "SQS"
+	awsServiceNames["ssm"] = "SSM"
+	awsServiceNames["ssmcontacts"] = "SSMContacts"
+	awsServiceNames["ssmincidents"] = "SSMIncidents"
+	awsServiceNames["sso"] = "SSO"
+	awsServiceNames["ssoadmin"] = "SSOAdmin"
+	awsServiceNames["ssooidc"] = "SSOOIDC"
+	awsServiceNames["storagegateway"] = "StorageGateway"
+	awsServiceNames["sts"] = "STS"
+	awsServiceNames["support"] = "Support"
+	awsServiceNames["swf"] = "SWF"
+	awsServiceNames["synthetics"] = "Synthetics"
+	awsServiceNames["textract"] = "Textract"
+	awsServiceNames["timestreamquery"] = "TimestreamQuery"
+	awsServiceNames["timestreamwrite"] = "TimestreamWrite"
+	awsServiceNames["transcribe"] = "Transcribe"
+	awsServiceNames["transcribestreaming"] = "TranscribeStreaming"
+	awsServiceNames["transfer"] = "Transfer"
+	awsServiceNames["translate"] = "Translate"
+	awsServiceNames["waf"] = "WAF"
+	awsServiceNames["wafregional"] = "WAFRegional"
+	awsServiceNames["wafv2"] = "WAFV2"
+	awsServiceNames["wellarchitected"] = "WellArchitected"
+	awsServiceNames["workdocs"] = "WorkDocs"
+	awsServiceNames["worklink"] = "WorkLink"
+	awsServiceNames["workmail"] = "WorkMail"
+	awsServiceNames["workmailmessageflow"] = "WorkMailMessageFlow"
+	awsServiceNames["workspaces"] = "WorkSpaces"
+	awsServiceNames["xray"] = "XRay"
+}
+
+func fixSomeInitialisms(s string) string {
+	replace := s
+
+	replace = strings.Replace(replace, "ResourceSes", "ResourceSES", 1)
+	replace = strings.Replace(replace, "ApiGateway", "APIGateway", 1)
+	replace = strings.Replace(replace, "Cloudwatch", "CloudWatch", 1)
+	replace = strings.Replace(replace, "CurReport", "CURReport", 1)
+	replace = strings.Replace(replace, "CloudHsm", "CloudHSM", 1)
+	replace = strings.Replace(replace, "DynamoDb", "DynamoDB", 1)
+	replace = strings.Replace(replace, "Opsworks", "OpsWorks", 1)
+	replace = strings.Replace(replace, "Precheck", "PreCheck", 1)
+	replace = strings.Replace(replace, "Graphql", "GraphQL", 1)
+	replace = strings.Replace(replace, "Haproxy", "HAProxy", 1)
+	replace = strings.Replace(replace, "Acmpca", "ACMPCA", 1)
+	replace = strings.Replace(replace, "AcmPca", "ACMPCA", 1)
+	replace = strings.Replace(replace, "Dnssec", "DNSSEC", 1)
+	replace = strings.Replace(replace, "DocDb", "DocDB", 1)
+	replace = strings.Replace(replace, "Docdb", "DocDB", 1)
+	replace = strings.Replace(replace, "Https", "HTTPS", 1)
+	replace = strings.Replace(replace, "Ipset", "IPSet", 1)
+	replace = strings.Replace(replace, "Iscsi", "iSCSI", 1)
+	replace = strings.Replace(replace, "Mysql", "MySQL", 1)
+	replace = strings.Replace(replace, "Wafv2", "WAFV2", 1)
+	replace = strings.Replace(replace, "Cidr", "CIDR", 1)
+	replace = strings.Replace(replace, "Coip", "CoIP", 1)
+	replace = strings.Replace(replace, "Dhcp", "DHCP", 1)
+	replace = strings.Replace(replace, "Dkim", "DKIM", 1)
+	replace = strings.Replace(replace, "Grpc", "GRPC", 1)
+	replace = strings.Replace(replace, "Http", "HTTP", 1)
+	replace = strings.Replace(replace, "Mwaa", "MWAA", 1)
+	replace = strings.Replace(replace, "Oidc", "OIDC", 1)
+	replace = strings.Replace(replace, "Qldb", "QLDB", 1)
+	replace = strings.Replace(replace, "Smtp", "SMTP", 1)
+	replace = strings.Replace(replace, "Xray", "XRay", 1)
+	replace = strings.Replace(replace, "Acl", "ACL", 1)
+	replace = strings.Replace(replace, "Acm", "ACM", 1)
+	replace = strings.Replace(replace, "Ami", "AMI", 1)
+	replace = strings.Replace(replace, "Api", "API", 1)
+	replace = strings.Replace(replace, "Arn", "ARN", 1)
+	replace = strings.Replace(replace, "Bgp", "BGP", 1)
+	replace = strings.Replace(replace, "Csv", "CSV", 1)
+	replace = strings.Replace(replace, "Dax", "DAX", 1)
+	replace = strings.Replace(replace, "Dlm", "DLM", 1)
+	replace = strings.Replace(replace, "Dms", "DMS", 1)
+	replace = strings.Replace(replace, "Dns", "DNS", 1)
+	replace = strings.Replace(replace, "Ebs", "EBS", 1)
+	replace = strings.Replace(replace, "Ec2", "EC2", 1)
+	replace = strings.Replace(replace, "Ecr", "ECR", 1)
+	replace = strings.Replace(replace, "Ecs", "ECS", 1)
+	replace = strings.Replace(replace, "Efs", "EFS", 1)
+	replace = strings.Replace(replace, "Eip", "EIP", 1)
+	replace = strings.Replace(replace, "Eks", "EKS", 1)
+	replace = strings.Replace(replace, "Elb", "ELB", 1)
+	replace = strings.Replace(replace, "Emr", "EMR", 1)
+	replace = strings.Replace(replace, "Fms", "FMS", 1)
+	replace = strings.Replace(replace, "Fsx", "FSx", 1)
+	replace = strings.Replace(replace, "Hsm", "HSM", 1)
+	replace = strings.Replace(replace, "Iam", "IAM", 1)
+	replace = strings.Replace(replace, "Iot", "IoT", 1)
+	replace = strings.Replace(replace, "Kms", "KMS", 1)
+	replace = strings.Replace(replace, "Msk", "MSK", 1)
+	replace = strings.Replace(replace, "Nat", "NAT", 1)
+	replace = strings.Replace(replace, "Nfs", "NFS", 1)
+	replace = strings.Replace(replace, "Php", "PHP", 1)
+	replace = strings.Replace(replace, "Ram", "RAM", 1)
+	replace = strings.Replace(replace, "Rds", "RDS", 1)
+	replace = strings.Replace(replace, "Rfc", "RFC", 1)
+	replace = strings.Replace(replace, "Sfn", "SFN", 1)
+	replace = strings.Replace(replace, "Smb", "SMB", 1)
+	replace = strings.Replace(replace, "Sms", "SMS", 1)
+	replace = strings.Replace(replace, "Sns", "SNS", 1)
+	replace = strings.Replace(replace, "Sql", "SQL", 1)
+	replace = strings.Replace(replace, "Sqs", "SQS", 1)
+	replace = strings.Replace(replace, "Ssh", "SSH", 1)
+	replace = strings.Replace(replace, "Ssm", "SSM", 1)
+	replace = strings.Replace(replace, "Sso", "SSO", 1)
+	replace = strings.Replace(replace, "Sts", "STS", 1)
+	replace = strings.Replace(replace, "Swf", "SWF", 1)
+	replace = strings.Replace(replace, "Tcp", "TCP", 1)
+	replace = strings.Replace(replace, "Vpc", "VPC", 1)
+	replace = strings.Replace(replace, "Vpn", "VPN", 1)
+	replace = strings.Replace(replace, "Waf", "WAF", 1)
+	replace = strings.Replace(replace, "Xss", "XSS", 1)
+	replace = strings.Replace(replace, "Db", "DB", 1)
+	replace = strings.Replace(replace, "Ip", "IP", 1)
+	replace = strings.Replace(replace, "Mq", "MQ", 1)
+
+	if replace != strings.TrimSuffix(replace, "Ids") {
+		replace = fmt.Sprintf("%s%s", strings.TrimSuffix(replace, "Ids"), "IDs")
+	}
+
+	if replace != strings.TrimSuffix(replace, "Id") {
+		replace = fmt.Sprintf("%s%s", strings.TrimSuffix(replace, "Id"), "ID")
+	}
+
+	return replace
+}
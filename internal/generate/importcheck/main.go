@@ -0,0 +1,618 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	modulePath = "github.com/hashicorp/terraform-provider-aws"
+
+	checkImporter = "importer"
+	checkNotFound = "notfound"
+	checkSet      = "set"
+)
+
+var (
+	checks    = flag.String("Checks", strings.Join([]string{checkImporter, checkNotFound, checkSet}, ","), "comma-separated list of checks to run")
+	resources = flag.String("Resources", "", "only check resources whose type name matches this regular expression")
+	verbose   = flag.Bool("Verbose", false, "whether to list resources whose Read function could not be fully analyzed")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	enabled := make(map[string]bool)
+
+	for _, check := range strings.Split(*checks, ",") {
+		switch check = strings.TrimSpace(check); check {
+		case checkImporter, checkNotFound, checkSet:
+			enabled[check] = true
+		case "":
+		default:
+			log.Fatalf("unknown check: %s", check)
+		}
+	}
+
+	var filter *regexp.Regexp
+
+	if *resources != "" {
+		var err error
+
+		filter, err = regexp.Compile(*resources)
+
+		if err != nil {
+			log.Fatalf("error compiling -Resources: %s", err)
+		}
+	}
+
+	resourcesMap := provider.Provider().ResourcesMap
+	names := make([]string, 0, len(resourcesMap))
+
+	for name := range resourcesMap {
+		if filter == nil || filter.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	c := &checker{
+		fset:     token.NewFileSet(),
+		packages: make(map[string]*sourcePackage),
+	}
+
+	if err := c.loadPackages(names, resourcesMap); err != nil {
+		log.Fatalf("error loading packages: %s", err)
+	}
+
+	var findings, skipped int
+
+	for _, name := range names {
+		r := resourcesMap[name]
+
+		if enabled[checkImporter] && r.Importer == nil {
+			fmt.Printf("%s: resource has no Importer\n", name)
+			findings++
+		}
+
+		if !enabled[checkNotFound] && !enabled[checkSet] {
+			continue
+		}
+
+		result, err := c.analyzeResource(r)
+
+		if err != nil {
+			if *verbose {
+				fmt.Printf("%s: skipped: %s\n", name, err)
+			}
+			skipped++
+
+			continue
+		}
+
+		if enabled[checkNotFound] && !result.clearsID {
+			fmt.Printf("%s: %s never clears the ID (d.SetId(\"\")) when the resource is not found, e.g. on tfresource.NotFound\n", name, result.position)
+			findings++
+		}
+
+		if enabled[checkSet] {
+			for _, attribute := range unsetAttributes(r, result.setAttributes) {
+				fmt.Printf("%s: %s never sets %s attribute %q\n", name, result.position, attributeKind(r.Schema[attribute]), attribute)
+				findings++
+			}
+		}
+	}
+
+	log.Printf("checked %d resources: %d findings, %d Read functions could not be analyzed", len(names), findings, skipped)
+
+	if findings > 0 {
+		os.Exit(1)
+	}
+}
+
+// sourcePackage holds the parsed, non-test source of a Go package.
+type sourcePackage struct {
+	constants map[string]string        // String constants declared at package level.
+	functions map[string]*ast.FuncDecl // Package-level functions (not methods).
+}
+
+type checker struct {
+	fset     *token.FileSet
+	packages map[string]*sourcePackage // Keyed by import path.
+}
+
+// analysis is the result of analyzing a resource's Read (and import) functions.
+type analysis struct {
+	clearsID      bool
+	position      string
+	setAttributes map[string]bool
+}
+
+// errOpaque is returned when d is passed to a function whose body cannot be analyzed.
+type errOpaque struct {
+	call string
+}
+
+func (e *errOpaque) Error() string {
+	return fmt.Sprintf("schema.ResourceData is passed to %s", e.call)
+}
+
+// loadPackages parses the packages that declare the resources' Read and import functions.
+func (c *checker) loadPackages(names []string, resourcesMap map[string]*schema.Resource) error {
+	paths := make(map[string]bool)
+
+	for _, name := range names {
+		r := resourcesMap[name]
+
+		for _, fn := range resourceFuncs(r) {
+			if path, _, ok := splitFuncName(fn); ok {
+				paths[path] = true
+			}
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	patterns := make([]string, 0, len(paths))
+
+	for path := range paths {
+		patterns = append(patterns, path)
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedFiles}, patterns...)
+
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range pkgs {
+		sp := &sourcePackage{
+			constants: make(map[string]string),
+			functions: make(map[string]*ast.FuncDecl),
+		}
+
+		for _, filename := range pkg.GoFiles {
+			file, err := parser.ParseFile(c.fset, filename, nil, 0)
+
+			if err != nil {
+				return err
+			}
+
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Recv == nil && decl.Body != nil {
+						sp.functions[decl.Name.Name] = decl
+					}
+				case *ast.GenDecl:
+					if decl.Tok != token.CONST {
+						continue
+					}
+
+					for _, spec := range decl.Specs {
+						spec := spec.(*ast.ValueSpec)
+
+						for i, name := range spec.Names {
+							if i < len(spec.Values) {
+								if v, ok := stringLiteral(spec.Values[i]); ok {
+									sp.constants[name.Name] = v
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+
+		c.packages[pkg.PkgPath] = sp
+	}
+
+	return nil
+}
+
+// analyzeResource statically analyzes the resource's Read function and any import function declared in this module.
+func (c *checker) analyzeResource(r *schema.Resource) (*analysis, error) {
+	read := readFunc(r)
+
+	if read == nil {
+		return nil, fmt.Errorf("resource has no Read function")
+	}
+
+	result := &analysis{
+		setAttributes: make(map[string]bool),
+	}
+
+	path, name, ok := splitFuncName(read)
+
+	if !ok {
+		return nil, fmt.Errorf("the Read function %s is not a package-level function in this module", funcName(read))
+	}
+
+	decl, err := c.funcDecl(path, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result.position = fmt.Sprintf("%s (%s)", name, c.relativePosition(decl.Pos()))
+
+	if err := c.analyzeFunc(path, decl, result, make(map[*ast.FuncDecl]bool)); err != nil {
+		return nil, err
+	}
+
+	// Attributes set by a custom importer are also populated on import.
+	if r.Importer != nil {
+		for _, fn := range []interface{}{r.Importer.State, r.Importer.StateContext} {
+			if reflect.ValueOf(fn).IsNil() {
+				continue
+			}
+
+			path, name, ok := splitFuncName(fn)
+
+			if !ok {
+				continue
+			}
+
+			decl, err := c.funcDecl(path, name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Clearing the ID on import is not handling a missing resource during refresh.
+			clearsID := result.clearsID
+
+			if err := c.analyzeFunc(path, decl, result, make(map[*ast.FuncDecl]bool)); err != nil {
+				return nil, err
+			}
+
+			result.clearsID = clearsID
+		}
+	}
+
+	return result, nil
+}
+
+func (c *checker) funcDecl(path, name string) (*ast.FuncDecl, error) {
+	pkg, ok := c.packages[path]
+
+	if !ok {
+		return nil, fmt.Errorf("package %s not loaded", path)
+	}
+
+	decl, ok := pkg.functions[name]
+
+	if !ok {
+		return nil, fmt.Errorf("function %s not found in package %s", name, path)
+	}
+
+	return decl, nil
+}
+
+// analyzeFunc records the attributes set and whether the ID is cleared via the function's *schema.ResourceData parameter.
+// Same-package functions that d is passed to are analyzed recursively.
+func (c *checker) analyzeFunc(path string, decl *ast.FuncDecl, result *analysis, seen map[*ast.FuncDecl]bool) error {
+	if seen[decl] {
+		return nil
+	}
+
+	seen[decl] = true
+
+	index := resourceDataParam(decl)
+
+	if index < 0 {
+		return nil
+	}
+
+	d := paramName(decl, index)
+
+	if d == "" || d == "_" {
+		return nil
+	}
+
+	pkg := c.packages[path]
+
+	var err error
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if err != nil {
+			return false
+		}
+
+		call, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		// d.Set(...), d.SetId(...).
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && isIdent(sel.X, d) {
+			switch sel.Sel.Name {
+			case "Set":
+				if len(call.Args) == 0 {
+					return true
+				}
+
+				key, ok := stringLiteral(call.Args[0])
+
+				if !ok {
+					if ident, isIdent := call.Args[0].(*ast.Ident); isIdent {
+						key, ok = pkg.constants[ident.Name]
+					}
+				}
+
+				if !ok {
+					err = &errOpaque{call: fmt.Sprintf("%s.Set with a non-constant key at %s", d, c.relativePosition(call.Pos()))}
+
+					return false
+				}
+
+				if i := strings.Index(key, "."); i >= 0 {
+					key = key[:i]
+				}
+
+				result.setAttributes[key] = true
+			case "SetId":
+				if len(call.Args) == 1 {
+					if v, ok := stringLiteral(call.Args[0]); ok && v == "" {
+						result.clearsID = true
+					}
+				}
+			}
+
+			return true
+		}
+
+		for i, arg := range call.Args {
+			if !isIdent(arg, d) {
+				continue
+			}
+
+			ident, ok := call.Fun.(*ast.Ident)
+
+			if !ok {
+				err = &errOpaque{call: fmt.Sprintf("%s at %s", exprString(call.Fun), c.relativePosition(call.Pos()))}
+
+				return false
+			}
+
+			callee, ok := pkg.functions[ident.Name]
+
+			if !ok {
+				err = &errOpaque{call: fmt.Sprintf("%s at %s", ident.Name, c.relativePosition(call.Pos()))}
+
+				return false
+			}
+
+			if resourceDataParam(callee) != i {
+				continue
+			}
+
+			err = c.analyzeFunc(path, callee, result, seen)
+
+			if err != nil {
+				return false
+			}
+		}
+
+		return true
+	})
+
+	return err
+}
+
+func (c *checker) relativePosition(pos token.Pos) string {
+	position := c.fset.Position(pos)
+
+	if wd, err := os.Getwd(); err == nil {
+		if rel := strings.TrimPrefix(position.Filename, wd+string(os.PathSeparator)); rel != position.Filename {
+			position.Filename = rel
+		}
+	}
+
+	return fmt.Sprintf("%s:%d", position.Filename, position.Line)
+}
+
+// unsetAttributes returns the sorted top-level Required or ForceNew attributes that are not in set.
+func unsetAttributes(r *schema.Resource, set map[string]bool) []string {
+	var attributes []string
+
+	for name, s := range r.Schema {
+		if (s.Required || s.ForceNew) && !set[name] {
+			attributes = append(attributes, name)
+		}
+	}
+
+	sort.Strings(attributes)
+
+	return attributes
+}
+
+func attributeKind(s *schema.Schema) string {
+	if s.Required {
+		return "Required"
+	}
+
+	return "ForceNew"
+}
+
+// readFunc returns the resource's Read function, whichever of the function fields is used.
+func readFunc(r *schema.Resource) interface{} {
+	switch {
+	case r.Read != nil:
+		return r.Read
+	case r.ReadContext != nil:
+		return r.ReadContext
+	case r.ReadWithoutTimeout != nil:
+		return r.ReadWithoutTimeout
+	default:
+		return nil
+	}
+}
+
+// resourceFuncs returns the resource's non-nil Read and import functions.
+func resourceFuncs(r *schema.Resource) []interface{} {
+	var fns []interface{}
+
+	if fn := readFunc(r); fn != nil {
+		fns = append(fns, fn)
+	}
+
+	if r.Importer != nil {
+		if r.Importer.State != nil {
+			fns = append(fns, r.Importer.State)
+		}
+
+		if r.Importer.StateContext != nil {
+			fns = append(fns, r.Importer.StateContext)
+		}
+	}
+
+	return fns
+}
+
+func funcName(fn interface{}) string {
+	return runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+}
+
+// splitFuncName returns the import path and name of a package-level function declared in this module.
+// Methods and function literals are not supported.
+func splitFuncName(fn interface{}) (string, string, bool) {
+	name := funcName(fn)
+
+	if !strings.HasPrefix(name, modulePath+"/") {
+		return "", "", false
+	}
+
+	i := strings.LastIndex(name, "/")
+	j := strings.Index(name[i:], ".")
+
+	if j < 0 {
+		return "", "", false
+	}
+
+	path, name := name[:i+j], name[i+j+1:]
+
+	if strings.ContainsAny(name, ".()") {
+		return "", "", false
+	}
+
+	return path, name, true
+}
+
+// resourceDataParam returns the index of the function's *schema.ResourceData parameter, or -1.
+func resourceDataParam(decl *ast.FuncDecl) int {
+	index := 0
+
+	for _, field := range decl.Type.Params.List {
+		n := len(field.Names)
+
+		if n == 0 {
+			n = 1
+		}
+
+		if star, ok := field.Type.(*ast.StarExpr); ok {
+			if sel, ok := star.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "ResourceData" {
+				if n != 1 {
+					return -1
+				}
+
+				return index
+			}
+		}
+
+		index += n
+	}
+
+	return -1
+}
+
+func paramName(decl *ast.FuncDecl, index int) string {
+	i := 0
+
+	for _, field := range decl.Type.Params.List {
+		if len(field.Names) == 0 {
+			if i == index {
+				return ""
+			}
+
+			i++
+
+			continue
+		}
+
+		for _, name := range field.Names {
+			if i == index {
+				return name.Name
+			}
+
+			i++
+		}
+	}
+
+	return ""
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == name
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	v, err := strconv.Unquote(lit.Value)
+
+	if err != nil {
+		return "", false
+	}
+
+	return v, true
+}
+
+func exprString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return exprString(expr.X) + "." + expr.Sel.Name
+	default:
+		return fmt.Sprintf("%T", expr)
+	}
+}
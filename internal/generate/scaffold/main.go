@@ -0,0 +1,2161 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	awsSDKModule = "github.com/aws/aws-sdk-go"
+
+	findFilename   = "find.go"
+	statusFilename = "status.go"
+	sweepFilename  = "sweep.go"
+	waitFilename   = "wait.go"
+
+	waiterCreated = "created"
+	waiterDeleted = "deleted"
+	waiterUpdated = "updated"
+)
+
+var (
+	// ignoredMembers are input members that are not resource arguments.
+	ignoredMembers = []string{"ClientRequestToken", "ClientToken", "DryRun", "IdempotencyToken"}
+
+	configFile = flag.String("Config", "", "path of the scaffold configuration file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -Config=<file>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *configFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	wd, err := os.Getwd()
+
+	if err != nil {
+		log.Fatalf("unable to get working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	config, err := loadConfig(*configFile)
+
+	if err != nil {
+		log.Fatalf("error loading config (%s): %s", *configFile, err)
+	}
+
+	packageSource, err := readPackageSource(wd)
+
+	if err != nil {
+		log.Fatalf("error reading package %s: %s", servicePackage, err)
+	}
+
+	if config.AWSService == "" {
+		config.AWSService = mostCommonMatch(packageSource, regexp.MustCompile(`"github\.com/aws/aws-sdk-go/service/(\w+)"`), servicePackage)
+	}
+
+	model, err := loadModel(config.Model, config.AWSService)
+
+	if err != nil {
+		log.Fatalf("error loading API model: %s", err)
+	}
+
+	if config.ClientType == "" {
+		config.ClientType = mostCommonMatch(packageSource, regexp.MustCompile(`conn \*`+config.AWSService+`\.(\w+)`), model.structName())
+	}
+
+	if config.Conn == "" {
+		config.Conn = mostCommonMatch(packageSource, regexp.MustCompile(`\*conns\.AWSClient\)\.(\w+Conn)\(\)`), config.ClientType+"Conn")
+	}
+
+	td, err := newTemplateData(config, model, servicePackage)
+
+	if err != nil {
+		log.Fatalf("error configuring %s: %s", config.Resource, err)
+	}
+
+	if strings.HasSuffix(td.Filename, "_test") {
+		log.Fatalf("resource %s would be generated in a test file (%s.go)", config.Resource, td.Filename)
+	}
+
+	files := []struct {
+		filename string
+		header   string
+		tmpl     string
+		create   bool
+	}{
+		{filename: findFilename, tmpl: findTmpl},
+		{filename: statusFilename, tmpl: statusTmpl},
+		{filename: waitFilename, tmpl: waitTmpl},
+		{filename: sweepFilename, header: "//go:build sweep\n// +build sweep\n\n", tmpl: sweepTmpl},
+		{filename: td.Filename + ".go", tmpl: resourceTmpl, create: true},
+		{filename: td.Filename + "_test.go", tmpl: testTmpl, create: true},
+	}
+
+	// Generate and merge everything before writing so that a failure leaves the package untouched.
+	contents := make(map[string][]byte, len(files))
+
+	for _, f := range files {
+		if f.filename == statusFilename && td.StatusElem == "" {
+			continue
+		}
+
+		if f.filename == waitFilename && len(td.Waiters) == 0 {
+			continue
+		}
+
+		if f.filename == sweepFilename && td.List == nil {
+			continue
+		}
+
+		pkg := servicePackage
+
+		if strings.HasSuffix(f.filename, "_test.go") {
+			pkg += "_test"
+		}
+
+		src, err := generate(f.tmpl, f.header, pkg, td)
+
+		if err != nil {
+			log.Fatalf("error generating %s: %s", f.filename, err)
+		}
+
+		existing, err := ioutil.ReadFile(f.filename)
+
+		if err == nil {
+			if f.create {
+				log.Fatalf("%s already exists", f.filename)
+			}
+
+			if src, err = merge(existing, src); err != nil {
+				log.Fatalf("error adding to %s: %s", f.filename, err)
+			}
+		} else if !os.IsNotExist(err) {
+			log.Fatalf("error reading %s: %s", f.filename, err)
+		}
+
+		contents[f.filename] = src
+	}
+
+	for _, f := range files {
+		src, ok := contents[f.filename]
+
+		if !ok {
+			continue
+		}
+
+		if err := ioutil.WriteFile(f.filename, src, 0644); err != nil {
+			log.Fatalf("error writing %s: %s", f.filename, err)
+		}
+
+		log.Printf("wrote %s", f.filename)
+	}
+
+	if td.Tags && !strings.Contains(packageSource, "func ListTags(") {
+		log.Printf("%s has no generated ListTags and UpdateTags functions, add them to generate.go", servicePackage)
+	}
+
+	log.Printf("register %s.Resource%s() as %q in internal/provider/provider.go and add documentation in website/docs/r/", servicePackage, td.Resource, td.TypeName)
+}
+
+// Config is the contents of a scaffold configuration file.
+type Config struct {
+	AWSService       string                   `yaml:"awsService"`
+	ClientType       string                   `yaml:"clientType"`
+	Conn             string                   `yaml:"conn"`
+	Create           OperationConfig          `yaml:"create"`
+	Delete           OperationConfig          `yaml:"delete"`
+	HumanName        string                   `yaml:"humanName"`
+	List             ListConfig               `yaml:"list"`
+	Model            string                   `yaml:"model"`
+	NotFoundErrCodes []string                 `yaml:"notFoundErrCodes"`
+	Read             ReadConfig               `yaml:"read"`
+	Resource         string                   `yaml:"resource"`
+	Status           string                   `yaml:"status"`
+	TypeName         string                   `yaml:"typeName"`
+	Update           OperationConfig          `yaml:"update"`
+	Waiters          map[string]*WaiterConfig `yaml:"waiters"`
+}
+
+// OperationConfig configures an API operation and its identifier element.
+type OperationConfig struct {
+	IDElem string `yaml:"idElem"`
+	Op     string `yaml:"op"`
+}
+
+// ReadConfig configures the API operation used by the finder.
+type ReadConfig struct {
+	OperationConfig `yaml:",inline"`
+	OutputElem      string `yaml:"outputElem"`
+}
+
+// ListConfig configures the API operation used by the sweeper.
+type ListConfig struct {
+	IDElem    string `yaml:"idElem"`
+	ItemsElem string `yaml:"itemsElem"`
+	Op        string `yaml:"op"`
+}
+
+// WaiterConfig configures a waiter by its API status values.
+type WaiterConfig struct {
+	Pending []string `yaml:"pending"`
+	Target  []string `yaml:"target"`
+	Timeout string   `yaml:"timeout"`
+}
+
+func loadConfig(filename string) (*Config, error) {
+	b, err := ioutil.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+
+	if err := yaml.UnmarshalStrict(b, config); err != nil {
+		return nil, err
+	}
+
+	if config.Resource == "" {
+		return nil, errors.New("resource is required")
+	}
+
+	if config.Create.Op == "" || config.Read.Op == "" || config.Delete.Op == "" {
+		return nil, errors.New("create, read and delete operations are required")
+	}
+
+	for name, w := range config.Waiters {
+		if name != waiterCreated && name != waiterDeleted && name != waiterUpdated {
+			return nil, fmt.Errorf("unsupported waiter %q, must be one of %s, %s or %s", name, waiterCreated, waiterDeleted, waiterUpdated)
+		}
+
+		if w == nil || len(w.Pending) == 0 {
+			return nil, fmt.Errorf("waiter %q requires pending states", name)
+		}
+
+		if w.Timeout != "" {
+			if _, err := time.ParseDuration(w.Timeout); err != nil {
+				return nil, fmt.Errorf("waiter %q: %w", name, err)
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// readPackageSource returns the concatenated non-generated source of the package being scaffolded,
+// used to infer names that the package already uses.
+func readPackageSource(dir string) (string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+
+	for _, filename := range filenames {
+		b, err := ioutil.ReadFile(filename)
+
+		if err != nil {
+			return "", err
+		}
+
+		sb.Write(b)
+	}
+
+	return sb.String(), nil
+}
+
+// mostCommonMatch returns the most common first submatch of re in s, or def if there is none.
+func mostCommonMatch(s string, re *regexp.Regexp, def string) string {
+	counts := map[string]int{}
+
+	for _, m := range re.FindAllStringSubmatch(s, -1) {
+		counts[m[1]]++
+	}
+
+	result := def
+	max := 0
+
+	for k, v := range counts {
+		if v > max || (v == max && k < result) {
+			result, max = k, v
+		}
+	}
+
+	return result
+}
+
+//
+// API model.
+//
+
+type apiModel struct {
+	Metadata struct {
+		ServiceAbbreviation string `json:"serviceAbbreviation"`
+		ServiceFullName     string `json:"serviceFullName"`
+		ServiceID           string `json:"serviceId"`
+	} `json:"metadata"`
+	Operations map[string]*apiOperation `json:"operations"`
+	Shapes     map[string]*apiShape     `json:"shapes"`
+
+	paginators map[string]interface{}
+}
+
+type apiOperation struct {
+	Errors []*apiShapeRef `json:"errors"`
+	Input  *apiShapeRef   `json:"input"`
+	Output *apiShapeRef   `json:"output"`
+}
+
+type apiShapeRef struct {
+	Deprecated       bool   `json:"deprecated"`
+	IdempotencyToken bool   `json:"idempotencyToken"`
+	Shape            string `json:"shape"`
+}
+
+type apiShape struct {
+	Enum      []string                `json:"enum"`
+	Exception bool                    `json:"exception"`
+	Key       *apiShapeRef            `json:"key"`
+	Max       *float64                `json:"max"`
+	Member    *apiShapeRef            `json:"member"`
+	Members   map[string]*apiShapeRef `json:"members"`
+	Min       *float64                `json:"min"`
+	Required  []string                `json:"required"`
+	Type      string                  `json:"type"`
+	Value     *apiShapeRef            `json:"value"`
+}
+
+// loadModel loads an API model. The location is either the path of an api-2.json file,
+// a <service>/<version> directory under the aws-sdk-go module's models/apis directory,
+// or if empty the latest version of the awsService model.
+func loadModel(location, awsService string) (*apiModel, error) {
+	filename := location
+
+	if !strings.HasSuffix(location, ".json") {
+		out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", awsSDKModule).Output()
+
+		if err != nil {
+			return nil, fmt.Errorf("error locating %s module: %w", awsSDKModule, err)
+		}
+
+		apis := filepath.Join(strings.TrimSpace(string(out)), "models", "apis")
+
+		if location == "" {
+			versions, err := filepath.Glob(filepath.Join(apis, awsService, "*", "api-2.json"))
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(versions) == 0 {
+				return nil, fmt.Errorf("no %s model found in %s, set model in the config", awsService, apis)
+			}
+
+			sort.Strings(versions)
+			filename = versions[len(versions)-1]
+		} else {
+			filename = filepath.Join(apis, location, "api-2.json")
+		}
+	}
+
+	b, err := ioutil.ReadFile(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	model := &apiModel{}
+
+	if err := json.Unmarshal(b, model); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", filename, err)
+	}
+
+	var paginators struct {
+		Pagination map[string]interface{} `json:"pagination"`
+	}
+
+	if b, err := ioutil.ReadFile(filepath.Join(filepath.Dir(filename), "paginators-1.json")); err == nil {
+		if err := json.Unmarshal(b, &paginators); err != nil {
+			return nil, fmt.Errorf("error parsing paginators: %w", err)
+		}
+	}
+
+	model.paginators = paginators.Pagination
+
+	// aws-sdk-go exports member names, e.g. "environmentIds" is EnvironmentIds.
+	for _, s := range model.Shapes {
+		members := make(map[string]*apiShapeRef, len(s.Members))
+
+		for name, ref := range s.Members {
+			members[exportedName(name)] = ref
+		}
+
+		s.Members = members
+
+		for i, name := range s.Required {
+			s.Required[i] = exportedName(name)
+		}
+	}
+
+	return model, nil
+}
+
+// structName returns the aws-sdk-go client type name.
+func (m *apiModel) structName() string {
+	name := m.Metadata.ServiceAbbreviation
+
+	if name == "" {
+		name = m.Metadata.ServiceFullName
+	}
+
+	name = strings.TrimPrefix(name, "Amazon")
+	name = strings.TrimPrefix(name, "AWS")
+
+	return regexp.MustCompile(`[^a-zA-Z0-9]`).ReplaceAllString(name, "")
+}
+
+func (m *apiModel) operation(name string) (*apiOperation, error) {
+	op, ok := m.Operations[name]
+
+	if !ok {
+		return nil, fmt.Errorf("operation %s not found in %s model", name, m.Metadata.ServiceID)
+	}
+
+	return op, nil
+}
+
+func (m *apiModel) shape(ref *apiShapeRef) *apiShape {
+	if ref == nil {
+		return &apiShape{Type: "structure"}
+	}
+
+	if s, ok := m.Shapes[ref.Shape]; ok {
+		return s
+	}
+
+	return &apiShape{Type: "structure"}
+}
+
+// member returns the member's shape reference, following a dotted path.
+func (m *apiModel) member(ref *apiShapeRef, path string) (*apiShapeRef, error) {
+	for _, name := range strings.Split(path, ".") {
+		member, ok := m.shape(ref).Members[name]
+
+		if !ok {
+			return nil, fmt.Errorf("%s has no member %s", ref.Shape, name)
+		}
+
+		ref = member
+	}
+
+	return ref, nil
+}
+
+// enumName returns the aws-sdk-go constant name for an enum value.
+func enumName(shape, value string) string {
+	value = regexp.MustCompile(`[^a-zA-Z0-9_:\./-]`).ReplaceAllString(value, "")
+	value = regexp.MustCompile(`([a-z])([A-Z])`).ReplaceAllString(value, "$1-$2")
+
+	var sb strings.Builder
+
+	for _, part := range regexp.MustCompile(`[-_:\./]+`).Split(value, -1) {
+		if part == "" {
+			continue
+		}
+
+		part = strings.ToLower(part)
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+
+	return exportedName(shape) + sb.String()
+}
+
+func exportedName(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func unexportedName(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+var wordBoundaryRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])|([A-Z])([A-Z][a-z])`)
+
+// words splits a Go name into words, e.g. "VPCConfigID" into "VPC Config ID".
+func words(s string) []string {
+	return strings.Fields(wordBoundaryRegexp.ReplaceAllString(wordBoundaryRegexp.ReplaceAllString(s, "$1$3 $2$4"), "$1$3 $2$4"))
+}
+
+func snakeCase(s string) string {
+	return strings.ToLower(strings.Join(words(s), "_"))
+}
+
+//
+// Template data.
+//
+
+// TemplateData is the data for all of the templates.
+type TemplateData struct {
+	AWSService string
+	ClientType string
+	Conn       string
+	Filename   string
+	HumanName  string
+	// HumanNamePlural is the plural of HumanName, e.g. "Cloud9 Environments".
+	HumanNamePlural string
+	ItemType        string
+	NotFound        string
+	Resource        string
+	ResourcePlural  string
+	ServicePackage  string
+	TestPrefix      string
+	TypeName        string
+
+	Create *Operation
+	Delete *Operation
+	List   *List
+	Read   *Read
+	// StatusElem is the status member of the finder's result, e.g. "Status" or "Lifecycle.Status".
+	StatusElem string
+	Update     *Operation
+	Waiters    []*Waiter
+
+	Attributes []*Attribute
+	// CreateIDFromInput indicates that the resource ID is the value of the create input's IDElem.
+	CreateIDFromInput bool
+	NameAttribute     string
+	Tags              bool
+	TagsIdentifier    string
+}
+
+// Operation is an API operation with a resource identifier element.
+type Operation struct {
+	IDElem string
+	IDList bool
+	Op     string
+}
+
+// Read is the finder's API operation.
+type Read struct {
+	Operation
+	OutputElem string
+	OutputList bool
+}
+
+// List is the sweeper's API operation.
+type List struct {
+	IDElem    string
+	ItemsElem string
+	Op        string
+	Paginated bool
+}
+
+// Waiter is a resource.StateChangeConf waiter.
+type Waiter struct {
+	Name        string
+	Pending     []string
+	Target      []string
+	Timeout     string
+	TimeoutName string
+}
+
+// Attribute is a resource schema attribute mapped to an API member.
+type Attribute struct {
+	Comment      string
+	Computed     bool
+	Elem         string
+	ForceNew     bool
+	Member       string
+	Name         string
+	Optional     bool
+	Required     bool
+	Type         string
+	ValidateFunc string
+
+	InCreate bool
+	InRead   bool
+	InUpdate bool
+
+	kind string
+}
+
+// Expand returns the expression converting the raw schema value v to the API type.
+func (a *Attribute) Expand(v string) string {
+	switch a.kind {
+	case "boolean":
+		return fmt.Sprintf("aws.Bool(%s.(bool))", v)
+	case "double", "float":
+		return fmt.Sprintf("aws.Float64(%s.(float64))", v)
+	case "integer", "long":
+		return fmt.Sprintf("aws.Int64(int64(%s.(int)))", v)
+	case "list":
+		return fmt.Sprintf("flex.ExpandStringList(%s.([]interface{}))", v)
+	case "map":
+		return fmt.Sprintf("flex.ExpandStringMap(%s.(map[string]interface{}))", v)
+	default:
+		return fmt.Sprintf("aws.String(%s.(string))", v)
+	}
+}
+
+// Flatten returns the statements setting the attribute from the API value in v.
+func (a *Attribute) Flatten(v string) string {
+	value := v + "." + a.Member
+
+	switch a.kind {
+	case "list":
+		value = fmt.Sprintf("aws.StringValueSlice(%s)", value)
+	case "map":
+		value = fmt.Sprintf("aws.StringValueMap(%s)", value)
+	case "timestamp":
+		return fmt.Sprintf("\nif %[1]s != nil {\nd.Set(%[2]q, aws.TimeValue(%[1]s).Format(time.RFC3339))\n} else {\nd.Set(%[2]q, nil)\n}\n", value, a.Name)
+	default:
+		return fmt.Sprintf("d.Set(%q, %s)", a.Name, value)
+	}
+
+	return fmt.Sprintf("\nif err := d.Set(%[1]q, %[2]s); err != nil {\nreturn fmt.Errorf(\"error setting %[1]s: %%w\", err)\n}\n", a.Name, value)
+}
+
+// TestValue returns an HCL value for the attribute in test configurations.
+func (a *Attribute) TestValue(enum []string) string {
+	switch a.kind {
+	case "boolean":
+		return "true"
+	case "double", "float", "integer", "long":
+		return "1"
+	case "list":
+		return `["TODO"]`
+	case "map":
+		return `{ TODO = "TODO" }`
+	}
+
+	if len(enum) > 0 {
+		return fmt.Sprintf("%q", enum[0])
+	}
+
+	return `"TODO"`
+}
+
+func newTemplateData(config *Config, model *apiModel, servicePackage string) (*TemplateData, error) {
+	td := &TemplateData{
+		AWSService:     config.AWSService,
+		ClientType:     config.ClientType,
+		Conn:           config.Conn,
+		Filename:       snakeCase(config.Resource),
+		HumanName:      config.HumanName,
+		Resource:       config.Resource,
+		ServicePackage: servicePackage,
+		StatusElem:     config.Status,
+		TestPrefix:     strings.ReplaceAll(model.Metadata.ServiceID, " ", ""),
+		TypeName:       config.TypeName,
+	}
+
+	if td.HumanName == "" {
+		td.HumanName = model.Metadata.ServiceID + " " + strings.Join(words(config.Resource), " ")
+	}
+
+	td.HumanNamePlural = plural(td.HumanName)
+	td.ResourcePlural = plural(td.Resource)
+
+	if td.TypeName == "" {
+		td.TypeName = "aws_" + servicePackage + "_" + td.Filename
+	}
+
+	// Finder.
+	readOp, err := model.operation(config.Read.Op)
+
+	if err != nil {
+		return nil, err
+	}
+
+	td.Read = &Read{Operation: Operation{Op: config.Read.Op, IDElem: config.Read.IDElem}, OutputElem: config.Read.OutputElem}
+
+	if err := resolveIDElem(model, readOp.Input, &td.Read.Operation); err != nil {
+		return nil, err
+	}
+
+	if td.Read.OutputElem == "" {
+		var candidates []string
+
+		for name, ref := range model.shape(readOp.Output).Members {
+			if s := model.shape(ref); s.Type == "structure" || (s.Type == "list" && model.shape(s.Member).Type == "structure") {
+				candidates = append(candidates, name)
+			}
+		}
+
+		if len(candidates) != 1 {
+			return nil, fmt.Errorf("%s output has %d structure members, set read.outputElem", config.Read.Op, len(candidates))
+		}
+
+		td.Read.OutputElem = candidates[0]
+	}
+
+	outputRef, err := model.member(readOp.Output, td.Read.OutputElem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	itemRef := outputRef
+
+	if s := model.shape(outputRef); s.Type == "list" {
+		td.Read.OutputList = true
+		itemRef = s.Member
+	}
+
+	td.ItemType = exportedName(itemRef.Shape)
+	item := model.shape(itemRef)
+
+	// NotFound error codes.
+	notFoundErrCodes := config.NotFoundErrCodes
+
+	if len(notFoundErrCodes) == 0 {
+		for _, ref := range readOp.Errors {
+			if strings.Contains(ref.Shape, "NotFound") || strings.HasPrefix(ref.Shape, "NoSuch") {
+				notFoundErrCodes = append(notFoundErrCodes, ref.Shape)
+			}
+		}
+	}
+
+	for i, code := range notFoundErrCodes {
+		if s, ok := model.Shapes[code]; !ok || !s.Exception {
+			return nil, fmt.Errorf("%s is not an exception in the model", code)
+		}
+
+		notFoundErrCodes[i] = fmt.Sprintf("%s.ErrCode%s", td.AWSService, exportedName(code))
+	}
+
+	td.NotFound = strings.Join(notFoundErrCodes, ", ")
+
+	// Create, update and delete.
+	createOp, err := model.operation(config.Create.Op)
+
+	if err != nil {
+		return nil, err
+	}
+
+	td.Create = &Operation{Op: config.Create.Op, IDElem: config.Create.IDElem}
+
+	if td.Create.IDElem == "" {
+		td.Create.IDElem = td.Read.IDElem
+	}
+
+	if _, err := model.member(createOp.Output, td.Create.IDElem); err != nil {
+		if _, err := model.member(createOp.Input, td.Create.IDElem); err != nil {
+			return nil, fmt.Errorf("neither the %s output nor input has member %s, set create.idElem", config.Create.Op, td.Create.IDElem)
+		}
+
+		td.CreateIDFromInput = true
+	}
+
+	deleteOp, err := model.operation(config.Delete.Op)
+
+	if err != nil {
+		return nil, err
+	}
+
+	td.Delete = &Operation{Op: config.Delete.Op, IDElem: config.Delete.IDElem}
+
+	if err := resolveIDElem(model, deleteOp.Input, td.Delete); err != nil {
+		return nil, err
+	}
+
+	var updateInput *apiShape
+
+	if config.Update.Op != "" {
+		updateOp, err := model.operation(config.Update.Op)
+
+		if err != nil {
+			return nil, err
+		}
+
+		td.Update = &Operation{Op: config.Update.Op, IDElem: config.Update.IDElem}
+
+		if err := resolveIDElem(model, updateOp.Input, td.Update); err != nil {
+			return nil, err
+		}
+
+		updateInput = model.shape(updateOp.Input)
+	}
+
+	// Schema.
+	attributes := map[string]*Attribute{}
+	createInput := model.shape(createOp.Input)
+
+	for name, ref := range createInput.Members {
+		if ref.IdempotencyToken || ref.Deprecated || contains(ignoredMembers, name) {
+			continue
+		}
+
+		if name == "Tags" {
+			td.Tags = true
+			continue
+		}
+
+		a := newAttribute(model, td.AWSService, name, ref)
+		a.InCreate = a.Comment == ""
+		a.Required = contains(createInput.Required, name)
+		a.Optional = !a.Required
+
+		if updateInput != nil && name != td.Update.IDElem {
+			if _, ok := updateInput.Members[name]; ok {
+				a.InUpdate = a.Comment == ""
+			}
+		}
+
+		a.ForceNew = !a.InUpdate
+
+		if td.CreateIDFromInput && name == td.Create.IDElem {
+			a.ForceNew = true
+			a.InUpdate = false
+		}
+
+		attributes[a.Name] = a
+	}
+
+	for name, ref := range item.Members {
+		if name == "Tags" || ref.Deprecated {
+			continue
+		}
+
+		a := newAttribute(model, td.AWSService, name, ref)
+
+		if existing, ok := attributes[a.Name]; ok {
+			existing.InRead = existing.Comment == "" && a.Comment == "" && existing.kind == a.kind
+			continue
+		}
+
+		// The resource ID is not an attribute.
+		if a.Comment != "" || a.Name == "id" || name == td.Create.IDElem || name == strings.TrimSuffix(td.Read.IDElem, "s") {
+			continue
+		}
+
+		a.Computed = true
+		a.InRead = true
+		a.ValidateFunc = ""
+		attributes[a.Name] = a
+	}
+
+	for _, name := range []string{"arn", snakeCase(config.Resource) + "_arn"} {
+		if a, ok := attributes[name]; ok && a.Computed {
+			td.TagsIdentifier = fmt.Sprintf("d.Get(%q).(string)", name)
+			break
+		}
+	}
+
+	if td.TagsIdentifier == "" {
+		td.TagsIdentifier = "d.Id()"
+	}
+
+	// Test configurations set the name attribute to a random name.
+	nameCandidates := []string{"name"}
+
+	if td.CreateIDFromInput {
+		nameCandidates = append(nameCandidates, snakeCase(td.Create.IDElem))
+	}
+
+	nameCandidates = append(nameCandidates, snakeCase(config.Resource)+"_name")
+
+	for _, name := range nameCandidates {
+		if a, ok := attributes[name]; ok && a.Required && a.kind == "string" && !strings.HasPrefix(a.ValidateFunc, "validation.StringInSlice") {
+			td.NameAttribute = a.Name
+			break
+		}
+	}
+
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		td.Attributes = append(td.Attributes, attributes[name])
+	}
+
+	// Status and waiters.
+	if td.StatusElem == "" {
+		td.StatusElem = defaultStatusElem(model, item)
+	}
+
+	var statusEnum *apiShape
+	statusEnumName := ""
+
+	if td.StatusElem != "" {
+		ref, err := model.member(itemRef, td.StatusElem)
+
+		if err != nil || model.shape(ref).Type != "string" {
+			return nil, fmt.Errorf("%s has no string member %s, set status", itemRef.Shape, td.StatusElem)
+		}
+
+		statusEnum = model.shape(ref)
+		statusEnumName = ref.Shape
+	}
+
+	waiterConfigs := config.Waiters
+
+	if waiterConfigs == nil && statusEnum != nil {
+		waiterConfigs = defaultWaiters(statusEnum.Enum, td.Update != nil)
+	}
+
+	for _, name := range []string{waiterCreated, waiterUpdated, waiterDeleted} {
+		wc, ok := waiterConfigs[name]
+
+		if !ok {
+			continue
+		}
+
+		if statusEnum == nil {
+			return nil, fmt.Errorf("waiter %q requires status", name)
+		}
+
+		if name == waiterUpdated && td.Update == nil {
+			return nil, fmt.Errorf("waiter %q requires an update operation", name)
+		}
+
+		w := &Waiter{
+			Name:        exportedName(name),
+			TimeoutName: unexportedName(config.Resource) + exportedName(name) + "Timeout",
+			Timeout:     "10 * time.Minute",
+		}
+
+		if wc.Timeout != "" {
+			w.Timeout = durationExpr(wc.Timeout)
+		}
+
+		if w.Pending, err = statusValues(td.AWSService, statusEnumName, statusEnum, wc.Pending); err != nil {
+			return nil, err
+		}
+
+		if w.Target, err = statusValues(td.AWSService, statusEnumName, statusEnum, wc.Target); err != nil {
+			return nil, err
+		}
+
+		td.Waiters = append(td.Waiters, w)
+	}
+
+	// Sweeper.
+	listOpName := config.List.Op
+
+	if listOpName == "" {
+		for _, name := range []string{"List" + config.Resource + "s", "Describe" + config.Resource + "s"} {
+			if _, ok := model.Operations[name]; ok {
+				listOpName = name
+				break
+			}
+		}
+	}
+
+	if listOpName == "" {
+		log.Printf("no list operation found for %s, set list.op to generate a sweeper", config.Resource)
+		return td, nil
+	}
+
+	listOp, err := model.operation(listOpName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, paginated := model.paginators[listOpName]
+	td.List = &List{Op: listOpName, ItemsElem: config.List.ItemsElem, IDElem: config.List.IDElem, Paginated: paginated}
+
+	if td.List.ItemsElem == "" {
+		var candidates []string
+
+		for name, ref := range model.shape(listOp.Output).Members {
+			if model.shape(ref).Type == "list" {
+				candidates = append(candidates, name)
+			}
+		}
+
+		if len(candidates) != 1 {
+			return nil, fmt.Errorf("%s output has %d list members, set list.itemsElem", listOpName, len(candidates))
+		}
+
+		td.List.ItemsElem = candidates[0]
+	}
+
+	itemsRef, err := model.member(listOp.Output, td.List.ItemsElem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listItem := model.shape(model.shape(itemsRef).Member)
+
+	if listItem.Type == "structure" {
+		if td.List.IDElem == "" {
+			td.List.IDElem = strings.TrimSuffix(td.Read.IDElem, "s")
+		}
+
+		if _, ok := listItem.Members[td.List.IDElem]; !ok {
+			return nil, fmt.Errorf("%s items have no member %s, set list.idElem", listOpName, td.List.IDElem)
+		}
+	} else {
+		td.List.IDElem = ""
+	}
+
+	return td, nil
+}
+
+// resolveIDElem defaults an operation's IDElem to its input's only required member.
+func resolveIDElem(model *apiModel, input *apiShapeRef, op *Operation) error {
+	if op.IDElem == "" {
+		if required := model.shape(input).Required; len(required) == 1 {
+			op.IDElem = required[0]
+		} else {
+			return fmt.Errorf("%s input has %d required members, set idElem", op.Op, len(required))
+		}
+	}
+
+	ref, err := model.member(input, op.IDElem)
+
+	if err != nil {
+		return err
+	}
+
+	op.IDList = model.shape(ref).Type == "list"
+
+	return nil
+}
+
+func newAttribute(model *apiModel, awsService, name string, ref *apiShapeRef) *Attribute {
+	s := model.shape(ref)
+	a := &Attribute{
+		Member: name,
+		Name:   snakeCase(name),
+		kind:   s.Type,
+	}
+
+	switch s.Type {
+	case "boolean":
+		a.Type = "schema.TypeBool"
+	case "double", "float":
+		a.Type = "schema.TypeFloat"
+	case "integer", "long":
+		a.Type = "schema.TypeInt"
+
+		if s.Min != nil && s.Max != nil {
+			a.ValidateFunc = fmt.Sprintf("validation.IntBetween(%d, %d)", int64(*s.Min), int64(*s.Max))
+		}
+	case "list":
+		if model.shape(s.Member).Type != "string" {
+			a.Comment = fmt.Sprintf("// TODO: %q (%s)", a.Name, ref.Shape)
+		}
+
+		a.Type = "schema.TypeList"
+		a.Elem = "&schema.Schema{Type: schema.TypeString}"
+	case "map":
+		if model.shape(s.Value).Type != "string" {
+			a.Comment = fmt.Sprintf("// TODO: %q (%s)", a.Name, ref.Shape)
+		}
+
+		a.Type = "schema.TypeMap"
+		a.Elem = "&schema.Schema{Type: schema.TypeString}"
+	case "string":
+		a.Type = "schema.TypeString"
+
+		if len(s.Enum) > 0 {
+			a.ValidateFunc = fmt.Sprintf("validation.StringInSlice(%s.%s_Values(), false)", awsService, exportedName(ref.Shape))
+		} else if s.Min != nil && s.Max != nil && *s.Min > 0 {
+			a.ValidateFunc = fmt.Sprintf("validation.StringLenBetween(%d, %d)", int64(*s.Min), int64(*s.Max))
+		}
+	case "timestamp":
+		a.Type = "schema.TypeString"
+	default:
+		a.Comment = fmt.Sprintf("// TODO: %q (%s)", a.Name, ref.Shape)
+	}
+
+	return a
+}
+
+var (
+	createdPendingRegexp = regexp.MustCompile(`(?i)creat|pending|provision|starting|initializ`)
+	deletedPendingRegexp = regexp.MustCompile(`(?i)^deleting$|delet.*progress|^deprovision`)
+	readyRegexp          = regexp.MustCompile(`(?i)^(active|available|created|enabled|in_?service|ready|running|succeeded)$`)
+	updatedPendingRegexp = regexp.MustCompile(`(?i)updat|modif`)
+)
+
+func defaultStatusElem(model *apiModel, item *apiShape) string {
+	var candidates []string
+
+	for name, ref := range item.Members {
+		if model.shape(ref).Type == "string" && len(model.shape(ref).Enum) > 0 && (strings.HasSuffix(name, "Status") || strings.HasSuffix(name, "State")) {
+			candidates = append(candidates, name)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return len(candidates[i]) < len(candidates[j]) || (len(candidates[i]) == len(candidates[j]) && candidates[i] < candidates[j])
+	})
+
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	return candidates[0]
+}
+
+// defaultWaiters infers waiters from the names of the status values.
+func defaultWaiters(enum []string, update bool) map[string]*WaiterConfig {
+	waiters := map[string]*WaiterConfig{}
+	var ready []string
+
+	for _, v := range enum {
+		if readyRegexp.MatchString(v) {
+			ready = append(ready, v)
+		}
+	}
+
+	filter := func(re *regexp.Regexp) []string {
+		var result []string
+
+		for _, v := range enum {
+			if re.MatchString(v) && !readyRegexp.MatchString(v) && !strings.Contains(strings.ToLower(v), "fail") {
+				result = append(result, v)
+			}
+		}
+
+		return result
+	}
+
+	if pending := filter(createdPendingRegexp); len(pending) > 0 && len(ready) > 0 {
+		waiters[waiterCreated] = &WaiterConfig{Pending: pending, Target: ready}
+	}
+
+	if pending := filter(updatedPendingRegexp); update && len(pending) > 0 && len(ready) > 0 {
+		waiters[waiterUpdated] = &WaiterConfig{Pending: pending, Target: ready}
+	}
+
+	if pending := filter(deletedPendingRegexp); len(pending) > 0 {
+		waiters[waiterDeleted] = &WaiterConfig{Pending: pending, Target: []string{}}
+	}
+
+	return waiters
+}
+
+// statusValues returns the Go expressions for the status values.
+func statusValues(awsService, shapeName string, shape *apiShape, values []string) ([]string, error) {
+	result := make([]string, 0, len(values))
+
+	for _, v := range values {
+		switch {
+		case contains(shape.Enum, v):
+			result = append(result, awsService+"."+enumName(shapeName, v))
+		case len(shape.Enum) > 0:
+			return nil, fmt.Errorf("%q is not a %s value", v, shapeName)
+		default:
+			result = append(result, fmt.Sprintf("%q", v))
+		}
+	}
+
+	return result, nil
+}
+
+// durationExpr returns the Go expression for a duration, e.g. "5m" is "5 * time.Minute".
+func durationExpr(s string) string {
+	d, _ := time.ParseDuration(s)
+
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+	} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d * %s", d/unit.d, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%d", d)
+}
+
+// plural returns the plural of a name ending in an English noun.
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	case strings.HasSuffix(s, "y") && !strings.ContainsAny(s[len(s)-2:len(s)-1], "aeiou"):
+		return s[:len(s)-1] + "ies"
+	default:
+		return s + "s"
+	}
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadsOutput returns whether Read sets any attributes from the finder's result.
+func (td *TemplateData) ReadsOutput() bool {
+	for _, a := range td.Attributes {
+		if a.InRead {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusParents returns the parent structures of a nested status member.
+func (td *TemplateData) StatusParents() []string {
+	parts := strings.Split(td.StatusElem, ".")
+	parents := make([]string, 0, len(parts)-1)
+
+	for i := 1; i < len(parts); i++ {
+		parents = append(parents, strings.Join(parts[:i], "."))
+	}
+
+	return parents
+}
+
+// TestConfigAttributes returns the required attributes for test configurations, aligned as by terraform fmt.
+func (td *TemplateData) TestConfigAttributes() []string {
+	var required []*Attribute
+	width := 0
+
+	for _, a := range td.Attributes {
+		if a.Required && a.Comment == "" {
+			required = append(required, a)
+
+			if len(a.Name) > width {
+				width = len(a.Name)
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(required))
+
+	for _, a := range required {
+		value := a.TestValue(nil)
+
+		if a.Name == td.NameAttribute {
+			value = "%[1]q"
+		} else if strings.HasPrefix(a.ValidateFunc, "validation.StringInSlice") {
+			value = fmt.Sprintf("%s # TODO: one of %s", value, strings.TrimSuffix(strings.TrimPrefix(a.ValidateFunc, "validation.StringInSlice("), ", false)"))
+		}
+
+		lines = append(lines, fmt.Sprintf("%-*s = %s", width, a.Name, value))
+	}
+
+	return lines
+}
+
+// TagArg returns the fmt verb of the n'th tag test configuration argument.
+func (td *TemplateData) TagArg(n int) string {
+	if td.NameAttribute != "" {
+		n++
+	}
+
+	return fmt.Sprintf("%%[%d]q", n)
+}
+
+//
+// Code generation.
+//
+
+var imports = map[string]string{
+	"acctest":    "github.com/hashicorp/terraform-provider-aws/internal/acctest",
+	"aws":        "github.com/aws/aws-sdk-go/aws",
+	"conns":      "github.com/hashicorp/terraform-provider-aws/internal/conns",
+	"flex":       "github.com/hashicorp/terraform-provider-aws/internal/flex",
+	"fmt":        "fmt",
+	"log":        "log",
+	"resource":   "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource",
+	"schema":     "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema",
+	"sdkacctest": "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest",
+	"sweep":      "github.com/hashicorp/terraform-provider-aws/internal/sweep",
+	"terraform":  "github.com/hashicorp/terraform-plugin-sdk/v2/terraform",
+	"testing":    "testing",
+	"tfawserr":   "github.com/hashicorp/aws-sdk-go-base/tfawserr",
+	"tfresource": "github.com/hashicorp/terraform-provider-aws/internal/tfresource",
+	"tftags":     "github.com/hashicorp/terraform-provider-aws/internal/tags",
+	"time":       "time",
+	"validation": "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation",
+	"verify":     "github.com/hashicorp/terraform-provider-aws/internal/verify",
+}
+
+// generate executes the template and adds the imports used by the result.
+func generate(tmpl, header, pkg string, td *TemplateData) ([]byte, error) {
+	t, err := template.New("scaffold").Parse(tmpl)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+
+	if err := t.Execute(&body, td); err != nil {
+		return nil, err
+	}
+
+	known := map[string]string{
+		td.AWSService:            "github.com/aws/aws-sdk-go/service/" + td.AWSService,
+		"tf" + td.ServicePackage: "github.com/hashicorp/terraform-provider-aws/internal/service/" + td.ServicePackage,
+	}
+
+	for k, v := range imports {
+		known[k] = v
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package "+pkg+"\n"+body.String(), 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing generated code: %w\n%s", err, body.String())
+	}
+
+	var std, other []string
+
+	for _, ident := range f.Unresolved {
+		path, ok := known[ident.Name]
+
+		if !ok || contains(std, path) || contains(other, path) {
+			continue
+		}
+
+		if !strings.Contains(path, ".") {
+			std = append(std, path)
+			continue
+		}
+
+		spec := fmt.Sprintf("%q", path)
+
+		if filepath.Base(path) != ident.Name {
+			spec = ident.Name + " " + spec
+		}
+
+		other = append(other, spec)
+	}
+
+	sort.Strings(std)
+	sort.Slice(other, func(i, j int) bool {
+		return importPath(other[i]) < importPath(other[j])
+	})
+
+	var src bytes.Buffer
+
+	fmt.Fprintf(&src, "%spackage %s\n\nimport (\n", header, pkg)
+
+	for _, path := range std {
+		fmt.Fprintf(&src, "%q\n", path)
+	}
+
+	if len(std) > 0 && len(other) > 0 {
+		src.WriteString("\n")
+	}
+
+	for _, spec := range other {
+		fmt.Fprintf(&src, "%s\n", spec)
+	}
+
+	src.WriteString(")\n")
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, src.String())
+	}
+
+	return formatted, nil
+}
+
+func importPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+// merge adds the imports and declarations of src to existing, adding the statements
+// of an init function to the existing init function, if any.
+func merge(existing, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	ef, err := parser.ParseFile(fset, "existing", existing, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sf, err := parser.ParseFile(fset, "generated", src, parser.ParseComments)
+
+	if err != nil {
+		return nil, err
+	}
+
+	offset := func(pos token.Pos) int {
+		return fset.Position(pos).Offset
+	}
+
+	declared := map[string]bool{}
+	var existingInit *ast.FuncDecl
+
+	for _, decl := range ef.Decls {
+		for _, name := range declNames(decl) {
+			declared[name] = true
+		}
+
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Name.Name == "init" {
+			existingInit = fd
+		}
+	}
+
+	type insertion struct {
+		offset int
+		text   string
+	}
+
+	var insertions []insertion
+	var body bytes.Buffer
+	bodyStart := offset(sf.Name.End())
+
+	for _, decl := range sf.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+
+		if ok && gd.Tok == token.IMPORT {
+			bodyStart = offset(gd.End())
+			continue
+		}
+
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Name.Name == "init" && existingInit != nil {
+			stmts := string(src[offset(fd.Body.Lbrace)+1 : offset(fd.Body.Rbrace)])
+			insertions = append(insertions, insertion{offset: offset(existingInit.Body.Rbrace), text: "\n" + stmts})
+			body.Write(src[bodyStart:offset(fd.Pos())])
+			bodyStart = offset(fd.End())
+			continue
+		}
+
+		for _, name := range declNames(decl) {
+			if declared[name] {
+				return nil, fmt.Errorf("%s is already declared", name)
+			}
+		}
+	}
+
+	body.Write(src[bodyStart:])
+
+	// Imports.
+	existingImports := map[string]bool{}
+	var importDecl *ast.GenDecl
+	lastStd := token.NoPos
+
+	for _, decl := range ef.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+
+		if importDecl == nil || !importDecl.Lparen.IsValid() {
+			importDecl = gd
+		}
+
+		for _, spec := range gd.Specs {
+			is := spec.(*ast.ImportSpec)
+			existingImports[is.Path.Value] = true
+
+			if gd == importDecl && !strings.Contains(is.Path.Value, ".") {
+				lastStd = is.End()
+			}
+		}
+	}
+
+	var std, other []string
+
+	for _, spec := range sf.Imports {
+		if existingImports[spec.Path.Value] {
+			continue
+		}
+
+		text := spec.Path.Value
+
+		if spec.Name != nil {
+			text = spec.Name.Name + " " + text
+		}
+
+		if strings.Contains(spec.Path.Value, ".") {
+			other = append(other, text)
+		} else {
+			std = append(std, text)
+		}
+	}
+
+	switch {
+	case len(std) == 0 && len(other) == 0:
+	case importDecl == nil || !importDecl.Lparen.IsValid():
+		text := "\n\nimport (\n" + strings.Join(std, "\n") + "\n\n" + strings.Join(other, "\n") + "\n)"
+		pos := ef.Name.End()
+
+		if importDecl != nil {
+			pos = importDecl.End()
+		}
+
+		insertions = append(insertions, insertion{offset: offset(pos), text: text})
+	default:
+		if len(std) > 0 {
+			if lastStd.IsValid() {
+				insertions = append(insertions, insertion{offset: offset(lastStd), text: "\n" + strings.Join(std, "\n")})
+			} else {
+				insertions = append(insertions, insertion{offset: offset(importDecl.Lparen) + 1, text: "\n" + strings.Join(std, "\n") + "\n"})
+			}
+		}
+
+		if len(other) > 0 {
+			insertions = append(insertions, insertion{offset: offset(importDecl.Rparen), text: strings.Join(other, "\n") + "\n"})
+		}
+	}
+
+	sort.SliceStable(insertions, func(i, j int) bool {
+		return insertions[i].offset > insertions[j].offset
+	})
+
+	result := append([]byte{}, existing...)
+
+	for _, ins := range insertions {
+		result = append(result[:ins.offset], append([]byte(ins.text), result[ins.offset:]...)...)
+	}
+
+	result = append(bytes.TrimRight(result, "\n"), '\n')
+	result = append(result, body.Bytes()...)
+
+	return format.Source(result)
+}
+
+func declNames(decl ast.Decl) []string {
+	var names []string
+
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil && d.Name.Name != "init" {
+			names = append(names, d.Name.Name)
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					names = append(names, name.Name)
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+var findTmpl = `
+func Find{{ .Resource }}ByID(conn *{{ .AWSService }}.{{ .ClientType }}, id string) (*{{ .AWSService }}.{{ .ItemType }}, error) {
+	input := &{{ .AWSService }}.{{ .Read.Op }}Input{
+		{{ .Read.IDElem }}: {{ if .Read.IDList }}aws.StringSlice([]string{id}){{ else }}aws.String(id){{ end }},
+	}
+
+	output, err := conn.{{ .Read.Op }}(input)
+{{ if .NotFound }}
+	if tfawserr.ErrCodeEquals(err, {{ .NotFound }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	if err != nil {
+		return nil, err
+	}
+{{ if .Read.OutputList }}
+	if output == nil || len(output.{{ .Read.OutputElem }}) == 0 || output.{{ .Read.OutputElem }}[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.{{ .Read.OutputElem }}); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.{{ .Read.OutputElem }}[0], nil
+{{- else }}
+	if output == nil || output.{{ .Read.OutputElem }} == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.{{ .Read.OutputElem }}, nil
+{{- end }}
+}
+`
+
+var statusTmpl = `
+func status{{ .Resource }}(conn *{{ .AWSService }}.{{ .ClientType }}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := Find{{ .Resource }}ByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+{{ range .StatusParents }}
+		if output.{{ . }} == nil {
+			return output, "", nil
+		}
+{{ end }}
+		return output, aws.StringValue(output.{{ .StatusElem }}), nil
+	}
+}
+`
+
+var waitTmpl = `
+const (
+{{- range .Waiters }}
+	{{ .TimeoutName }} = {{ .Timeout }}
+{{- end }}
+)
+{{ range .Waiters }}
+func wait{{ $.Resource }}{{ .Name }}(conn *{{ $.AWSService }}.{{ $.ClientType }}, id string) (*{{ $.AWSService }}.{{ $.ItemType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- range $i, $v := .Pending }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} },
+		Target:  []string{ {{- range $i, $v := .Target }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} },
+		Refresh: status{{ $.Resource }}(conn, id),
+		Timeout: {{ .TimeoutName }},
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*{{ $.AWSService }}.{{ $.ItemType }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{ end }}`
+
+var sweepTmpl = `
+func init() {
+	resource.AddTestSweepers("{{ .TypeName }}", &resource.Sweeper{
+		Name: "{{ .TypeName }}",
+		F:    sweep{{ .ResourcePlural }},
+	})
+}
+
+func sweep{{ .ResourcePlural }}(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).{{ .Conn }}()
+	input := &{{ .AWSService }}.{{ .List.Op }}Input{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+{{ if .List.Paginated }}
+	err = conn.{{ .List.Op }}Pages(input, func(page *{{ .AWSService }}.{{ .List.Op }}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .List.ItemsElem }} {
+			r := Resource{{ .Resource }}()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v{{ if .List.IDElem }}.{{ .List.IDElem }}{{ end }}))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("{{ .TypeName }}")))
+		}
+
+		return !lastPage
+	})
+{{ else }}
+	output, err := conn.{{ .List.Op }}(input)
+
+	if output != nil {
+		for _, v := range output.{{ .List.ItemsElem }} {
+			r := Resource{{ .Resource }}()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v{{ if .List.IDElem }}.{{ .List.IDElem }}{{ end }}))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("{{ .TypeName }}")))
+		}
+	}
+{{ end }}
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping {{ .HumanName }} sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing {{ .HumanNamePlural }} (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping {{ .HumanNamePlural }} (%s): %w", region, err)
+	}
+
+	return nil
+}
+`
+
+var resourceTmpl = `
+func Resource{{ .Resource }}() *schema.Resource {
+	return &schema.Resource{
+		Create: resource{{ .Resource }}Create,
+		Read:   resource{{ .Resource }}Read,
+{{- if or .Update .Tags }}
+		Update: resource{{ .Resource }}Update,
+{{- end }}
+		Delete: resource{{ .Resource }}Delete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+{{- range .Attributes }}
+{{- if .Comment }}
+			{{ .Comment }}
+{{- else }}
+			"{{ .Name }}": {
+				Type:         {{ .Type }},
+{{- if .Required }}
+				Required:     true,
+{{- end }}
+{{- if .Optional }}
+				Optional:     true,
+{{- end }}
+{{- if .Computed }}
+				Computed:     true,
+{{- end }}
+{{- if .ForceNew }}
+				ForceNew:     true,
+{{- end }}
+{{- if .Elem }}
+				Elem:         {{ .Elem }},
+{{- end }}
+{{- if .ValidateFunc }}
+				ValidateFunc: {{ .ValidateFunc }},
+{{- end }}
+			},
+{{- end }}
+{{- end }}
+{{- if .Tags }}
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+{{- end }}
+		},
+{{- if .Tags }}
+
+		CustomizeDiff: verify.SetTagsDiff,
+{{- end }}
+	}
+}
+
+func resource{{ .Resource }}Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .Conn }}()
+{{- if .Tags }}
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+{{- end }}
+
+	input := &{{ .AWSService }}.{{ .Create.Op }}Input{
+{{- range .Attributes }}
+{{- if and .InCreate .Required }}
+		{{ .Member }}: {{ .Expand (printf "d.Get(%q)" .Name) }},
+{{- end }}
+{{- end }}
+	}
+{{ range .Attributes }}
+{{- if and .InCreate .Optional }}
+	if v, ok := d.GetOk("{{ .Name }}"); ok {
+		input.{{ .Member }} = {{ .Expand "v" }}
+	}
+{{ end }}
+{{- end }}
+{{- if .Tags }}
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+{{ end }}
+	log.Printf("[DEBUG] Creating {{ .HumanName }}: %s", input)
+	{{ if .CreateIDFromInput }}_{{ else }}output{{ end }}, err := conn.{{ .Create.Op }}(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating {{ .HumanName }}: %w", err)
+	}
+
+	d.SetId(aws.StringValue({{ if .CreateIDFromInput }}input{{ else }}output{{ end }}.{{ .Create.IDElem }}))
+{{ range .Waiters }}
+{{- if eq .Name "Created" }}
+	if _, err := wait{{ $.Resource }}Created(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for {{ $.HumanName }} (%s) create: %w", d.Id(), err)
+	}
+{{ end }}
+{{- end }}
+	return resource{{ .Resource }}Read(d, meta)
+}
+
+func resource{{ .Resource }}Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .Conn }}()
+{{- if .Tags }}
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+{{- end }}
+
+	{{ if .ReadsOutput }}output{{ else }}_{{ end }}, err := Find{{ .Resource }}ByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] {{ .HumanName }} (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading {{ .HumanName }} (%s): %w", d.Id(), err)
+	}
+
+{{ range .Attributes }}
+{{- if .InRead }}
+	{{ .Flatten "output" }}
+{{- end }}
+{{- end }}
+{{- if .Tags }}
+
+	tags, err := ListTags(conn, {{ .TagsIdentifier }})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for {{ .HumanName }} (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+{{- end }}
+
+	return nil
+}
+{{ if or .Update .Tags }}
+func resource{{ .Resource }}Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .Conn }}()
+{{ if .Update }}
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &{{ .AWSService }}.{{ .Update.Op }}Input{
+			{{ .Update.IDElem }}: {{ if .Update.IDList }}aws.StringSlice([]string{d.Id()}){{ else }}aws.String(d.Id()){{ end }},
+		}
+{{ range .Attributes }}
+{{- if .InUpdate }}
+		if d.HasChange("{{ .Name }}") {
+			input.{{ .Member }} = {{ .Expand (printf "d.Get(%q)" .Name) }}
+		}
+{{ end }}
+{{- end }}
+		log.Printf("[DEBUG] Updating {{ .HumanName }}: %s", input)
+		_, err := conn.{{ .Update.Op }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating {{ .HumanName }} (%s): %w", d.Id(), err)
+		}
+{{- range .Waiters }}
+{{- if eq .Name "Updated" }}
+
+		if _, err := wait{{ $.Resource }}Updated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for {{ $.HumanName }} (%s) update: %w", d.Id(), err)
+		}
+{{- end }}
+{{- end }}
+	}
+{{ end }}
+{{- if .Tags }}
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, {{ .TagsIdentifier }}, o, n); err != nil {
+			return fmt.Errorf("error updating {{ .HumanName }} (%s) tags: %w", d.Id(), err)
+		}
+	}
+{{ end }}
+	return resource{{ .Resource }}Read(d, meta)
+}
+{{ end }}
+func resource{{ .Resource }}Delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).{{ .Conn }}()
+
+	log.Printf("[DEBUG] Deleting {{ .HumanName }}: %s", d.Id())
+	_, err := conn.{{ .Delete.Op }}(&{{ .AWSService }}.{{ .Delete.Op }}Input{
+		{{ .Delete.IDElem }}: {{ if .Delete.IDList }}aws.StringSlice([]string{d.Id()}){{ else }}aws.String(d.Id()){{ end }},
+	})
+{{ if .NotFound }}
+	if tfawserr.ErrCodeEquals(err, {{ .NotFound }}) {
+		return nil
+	}
+{{ end }}
+	if err != nil {
+		return fmt.Errorf("error deleting {{ .HumanName }} (%s): %w", d.Id(), err)
+	}
+{{ range .Waiters }}
+{{- if eq .Name "Deleted" }}
+	if _, err := wait{{ $.Resource }}Deleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for {{ $.HumanName }} (%s) delete: %w", d.Id(), err)
+	}
+{{ end }}
+{{- end }}
+	return nil
+}
+`
+
+var testTmpl = `
+func TestAcc{{ .TestPrefix }}{{ .Resource }}_basic(t *testing.T) {
+	var v {{ .AWSService }}.{{ .ItemType }}
+{{- if .NameAttribute }}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+{{- end }}
+	resourceName := "{{ .TypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService({{ .AWSService }}.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, {{ .AWSService }}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheck{{ .Resource }}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ .Resource }}Config({{ if .NameAttribute }}rName{{ end }}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ .Resource }}Exists(resourceName, &v),
+{{- if .NameAttribute }}
+					resource.TestCheckResourceAttr(resourceName, "{{ .NameAttribute }}", rName),
+{{- end }}
+{{- if .Tags }}
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+{{- end }}
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc{{ .TestPrefix }}{{ .Resource }}_disappears(t *testing.T) {
+	var v {{ .AWSService }}.{{ .ItemType }}
+{{- if .NameAttribute }}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+{{- end }}
+	resourceName := "{{ .TypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService({{ .AWSService }}.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, {{ .AWSService }}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheck{{ .Resource }}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ .Resource }}Config({{ if .NameAttribute }}rName{{ end }}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ .Resource }}Exists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tf{{ .ServicePackage }}.Resource{{ .Resource }}(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+{{ if .Tags }}
+func TestAcc{{ .TestPrefix }}{{ .Resource }}_tags(t *testing.T) {
+	var v {{ .AWSService }}.{{ .ItemType }}
+{{- if .NameAttribute }}
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+{{- end }}
+	resourceName := "{{ .TypeName }}.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService({{ .AWSService }}.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, {{ .AWSService }}.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheck{{ .Resource }}Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAcc{{ .Resource }}Tags1Config({{ if .NameAttribute }}rName, {{ end }}"key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ .Resource }}Exists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAcc{{ .Resource }}Tags2Config({{ if .NameAttribute }}rName, {{ end }}"key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ .Resource }}Exists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAcc{{ .Resource }}Tags1Config({{ if .NameAttribute }}rName, {{ end }}"key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck{{ .Resource }}Exists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+{{ end }}
+func testAccCheck{{ .Resource }}Exists(n string, v *{{ .AWSService }}.{{ .ItemType }}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No {{ .HumanName }} ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .Conn }}()
+
+		output, err := tf{{ .ServicePackage }}.Find{{ .Resource }}ByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheck{{ .Resource }}Destroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .Conn }}()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "{{ .TypeName }}" {
+			continue
+		}
+
+		_, err := tf{{ .ServicePackage }}.Find{{ .Resource }}ByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("{{ .HumanName }} %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+{{- $attributes := .TestConfigAttributes }}
+
+func testAcc{{ .Resource }}Config({{ if .NameAttribute }}rName string{{ end }}) string {
+	return {{ if .NameAttribute }}fmt.Sprintf({{ end }}` + "`" + `
+resource "{{ .TypeName }}" "test" {
+{{- range $attributes }}
+  {{ . }}
+{{- end }}
+}
+` + "`" + `{{ if .NameAttribute }}, rName){{ end }}
+}
+{{ if .Tags }}
+func testAcc{{ .Resource }}Tags1Config({{ if .NameAttribute }}rName, {{ end }}tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{ .TypeName }}" "test" {
+{{- range $attributes }}
+  {{ . }}
+{{- end }}
+{{- if $attributes }}
+{{ end }}
+  tags = {
+    {{ .TagArg 1 }} = {{ .TagArg 2 }}
+  }
+}
+` + "`" + `, {{ if .NameAttribute }}rName, {{ end }}tagKey1, tagValue1)
+}
+
+func testAcc{{ .Resource }}Tags2Config({{ if .NameAttribute }}rName, {{ end }}tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(` + "`" + `
+resource "{{ .TypeName }}" "test" {
+{{- range $attributes }}
+  {{ . }}
+{{- end }}
+{{- if $attributes }}
+{{ end }}
+  tags = {
+    {{ .TagArg 1 }} = {{ .TagArg 2 }}
+    {{ .TagArg 3 }} = {{ .TagArg 4 }}
+  }
+}
+` + "`" + `, {{ if .NameAttribute }}rName, {{ end }}tagKey1, tagValue1, tagKey2, tagValue2)
+}
+{{- end }}
+`
@@ -13,6 +13,9 @@ import (
 	"regexp"
 	"strings"
 	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v2"
 )
 
 const filename = `tags_gen.go`
@@ -51,6 +54,8 @@ var (
 
 	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code")
 	parentNotFoundErrMsg  = flag.String("ParentNotFoundErrMsg", "", "Parent 'NotFound' Error Message")
+
+	specFile = flag.String("Spec", "", "path of a YAML file declaring the service's tag handling (other flags are ignored)")
 )
 
 func usage() {
@@ -99,8 +104,11 @@ type TemplateData struct {
 	// to include the package, set the corresponding field's value to true
 	FmtPkg          bool
 	HelperSchemaPkg bool
+	ResourcePkg     bool
 	StrConvPkg      bool
+	TfAWSErrPkg     bool
 	TfResourcePkg   bool
+	TimePkg         bool
 }
 
 func main() {
@@ -129,6 +137,21 @@ func main() {
 		tagPackage = "waf"
 	}
 
+	if *specFile != "" {
+		serviceData := TemplateData{
+			AWSService:     awsService,
+			ClientType:     clientType,
+			ServicePackage: servicePackage,
+			TagPackage:     tagPackage,
+		}
+
+		if err := generateFromSpec(*specFile, serviceData); err != nil {
+			log.Fatalf("encountered: %s", err)
+		}
+
+		return
+	}
+
 	templateData := TemplateData{
 		AWSService:     awsService,
 		ClientType:     clientType,
@@ -136,7 +159,9 @@ func main() {
 
 		FmtPkg:          *updateTags,
 		HelperSchemaPkg: awsService == "autoscaling",
+		ResourcePkg:     *parentNotFoundErrCode != "",
 		StrConvPkg:      awsService == "autoscaling",
+		TfAWSErrPkg:     *parentNotFoundErrCode != "",
 		TfResourcePkg:   *getTag,
 
 		ListTagsInFiltIDName:    *listTagsInFiltIDName,
@@ -199,7 +224,7 @@ func main() {
 	}
 }
 
-func writeTemplate(body string, templateName string, td TemplateData) {
+func writeTemplate(body string, templateName string, td interface{}) {
 	// If the file doesn't exist, create it, or append to the file
 	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
@@ -243,6 +268,9 @@ import (
 	{{- if .StrConvPkg }}
 	"strconv"
 	{{- end }}
+	{{- if .TimePkg }}
+	"time"
+	{{- end }}
 
 	"github.com/aws/aws-sdk-go/aws"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -255,8 +283,10 @@ import (
 	{{- if .HelperSchemaPkg }}
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	{{- end }}
-	{{- if .ParentNotFoundErrCode }}
+	{{- if .TfAWSErrPkg }}
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	{{- end }}
+	{{- if .ResourcePkg }}
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	{{- end }}
 	{{- if .TfResourcePkg }}
@@ -778,6 +808,831 @@ func UpdateTags(conn {{ .ClientType }}, identifier string{{ if .TagResTypeElem }
 }
 `
 
+var specGetTagBody = `
+// {{ .Name }}GetTag fetches an individual {{ .Service.ServicePackage }} service tag for a resource.
+// Returns whether the key value and any errors. A NotFoundError is used to signal that no value was found.
+// This function will optimise the handling over {{ .Name }}ListTags, if possible.
+{{- if not .Identifiers }}
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+{{- end }}
+func {{ .Name }}GetTag(conn {{ .Service.ClientType }}, {{ .Params }}, key string) (*string, error) {
+	{{- if .ListTags.FilterIDName }}
+	input := &{{ .Service.TagPackage }}.{{ .ListTags.Op }}Input{
+		Filters: []*{{ .Service.TagPackage }}.Filter{
+			{
+				Name:   aws.String("{{ .ListTags.FilterIDName }}"),
+				Values: []*string{aws.String(identifier)},
+			},
+			{
+				Name:   aws.String("key"),
+				Values: []*string{aws.String(key)},
+			},
+		},
+	}
+
+	output, err := conn.{{ .ListTags.Op }}(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	listTags := KeyValueTags(output.{{ .ListTags.TagsElem }})
+	{{- else }}
+	listTags, err := {{ .Name }}ListTags(conn, {{ .Args }})
+
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
+
+	if !listTags.KeyExists(key) {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	return listTags.KeyValue(key), nil
+}
+`
+
+var specListTagsBody = `
+// {{ .Name }}ListTags lists {{ .Service.ServicePackage }} service tags.
+{{- if not .Identifiers }}
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+{{- end }}
+func {{ .Name }}ListTags(conn {{ .Service.ClientType }}, {{ .Params }}) (tftags.KeyValueTags, error) {
+	input := &{{ .Service.TagPackage }}.{{ .ListTags.Op }}Input{
+		{{- if .ListTags.FilterIDName }}
+		Filters: []*{{ .Service.TagPackage }}.Filter{
+			{
+				Name:   aws.String("{{ .ListTags.FilterIDName }}"),
+				Values: []*string{aws.String(identifier)},
+			},
+		},
+		{{- else }}
+		{{ .InputIDFields .ListTags.OperationSpec }}
+		{{- end }}
+	}
+	{{ if .ListTags.Paginated }}
+	tags := tftags.New(nil)
+
+	err := conn.{{ .ListTags.Op }}Pages(input, func(page *{{ .Service.TagPackage }}.{{ .ListTags.Op }}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		tags = tags.Merge(KeyValueTags(page.{{ .ListTags.TagsElem }}))
+
+		return !lastPage
+	})
+	{{- else if .ListTags.RetryErrCodes }}
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals({{ .ListTagsRetryTimeout }}, func() (interface{}, error) {
+		return conn.{{ .ListTags.Op }}(input)
+	}, {{ .GoStrings .ListTags.RetryErrCodes }})
+	{{- else }}
+	output, err := conn.{{ .ListTags.Op }}(input)
+	{{- end }}
+
+	{{- if and ( .ListTags.NotFoundErrCode ) ( .ListTags.NotFoundErrMsg ) }}
+
+	if tfawserr.ErrMessageContains(err, "{{ .ListTags.NotFoundErrCode }}", "{{ .ListTags.NotFoundErrMsg }}") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	{{- else if .ListTags.NotFoundErrCode }}
+
+	if tfawserr.ErrCodeEquals(err, "{{ .ListTags.NotFoundErrCode }}") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	{{- end }}
+
+	{{- if .ListTags.EmptyErrCodes }}
+
+	if tfawserr.ErrCodeEquals(err, {{ .GoStrings .ListTags.EmptyErrCodes }}) {
+		return tftags.New(nil), nil
+	}
+	{{- end }}
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	{{- if .ListTags.Paginated }}
+
+	return tags, nil
+	{{- else if .ListTags.RetryErrCodes }}
+
+	return KeyValueTags(outputRaw.(*{{ .Service.TagPackage }}.{{ .ListTags.Op }}Output).{{ .ListTags.TagsElem }}), nil
+	{{- else }}
+
+	return KeyValueTags(output.{{ .ListTags.TagsElem }}), nil
+	{{- end }}
+}
+`
+
+var specUpdateTagsBody = `
+// {{ .Name }}UpdateTags updates {{ .Service.ServicePackage }} service tags.
+{{- if not .Identifiers }}
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+{{- end }}
+func {{ .Name }}UpdateTags(conn {{ .Service.ClientType }}, {{ .Params }}, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	{{- if .UpdateTags.Set }}
+	{{- if .UpdateTags.PreserveIgnoredTags }}
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := {{ .Name }}ListTags(conn, {{ .Args }})
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags ({{ .IDFormat }}): %w", {{ .IDArgs }}, err)
+	}
+
+	tags := newTags.Merge(allTags.Ignore(oldTags).Ignore(newTags))
+	{{- else }}
+
+	tags := newTags
+	{{- end }}
+
+	if len(tags) > 0 {
+		input := &{{ .Service.TagPackage }}.{{ .UpdateTags.Set.Op }}Input{
+			{{ .InputIDFields .UpdateTags.Set }}
+			{{ .UpdateTags.Set.TagsElem }}: {{ .InputTagsValue .UpdateTags.Set "tags" }},
+		}
+
+		_, err := conn.{{ .UpdateTags.Set.Op }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags ({{ .IDFormat }}): %w", {{ .IDArgs }}, err)
+		}
+	} else if len(oldTags) > 0 {
+		{{- if .UpdateTags.Delete }}
+		input := &{{ .Service.TagPackage }}.{{ .UpdateTags.Delete.Op }}Input{
+			{{ .InputIDFields .UpdateTags.Delete }}
+		}
+
+		_, err := conn.{{ .UpdateTags.Delete.Op }}(input)
+		{{- else }}
+		input := &{{ .Service.TagPackage }}.{{ .UpdateTags.Set.Op }}Input{
+			{{ .InputIDFields .UpdateTags.Set }}
+		}
+
+		_, err := conn.{{ .UpdateTags.Set.Op }}(input)
+		{{- end }}
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags ({{ .IDFormat }}): %w", {{ .IDArgs }}, err)
+		}
+	}
+	{{- else }}
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		{{- if .UpdateTags.BatchSize }}
+		for _, removedTags := range removedTags.Chunks({{ .UpdateTags.BatchSize }}) {
+		{{- end }}
+		input := &{{ .Service.TagPackage }}.{{ .UpdateTags.Untag.Op }}Input{
+			{{ .InputIDFields .UpdateTags.Untag.OperationSpec }}
+			{{ .UpdateTags.Untag.TagsElem }}: {{ .UntagTagsValue }},
+		}
+
+		_, err := conn.{{ .UpdateTags.Untag.Op }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource ({{ .IDFormat }}): %w", {{ .IDArgs }}, err)
+		}
+		{{- if .UpdateTags.BatchSize }}
+		}
+		{{- end }}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		{{- if .UpdateTags.BatchSize }}
+		for _, updatedTags := range updatedTags.Chunks({{ .UpdateTags.BatchSize }}) {
+		{{- end }}
+		input := &{{ .Service.TagPackage }}.{{ .UpdateTags.Tag.Op }}Input{
+			{{ .InputIDFields .UpdateTags.Tag }}
+			{{ .UpdateTags.Tag.TagsElem }}: {{ .InputTagsValue .UpdateTags.Tag "updatedTags" }},
+		}
+
+		_, err := conn.{{ .UpdateTags.Tag.Op }}(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource ({{ .IDFormat }}): %w", {{ .IDArgs }}, err)
+		}
+		{{- if .UpdateTags.BatchSize }}
+		}
+		{{- end }}
+	}
+	{{- end }}
+
+	return nil
+}
+`
+
+var specCreateTagsBody = `
+// {{ .Name }}CreateTags creates {{ .Service.ServicePackage }} service tags for new resources.
+{{- if not .Identifiers }}
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+{{- end }}
+func {{ .Name }}CreateTags(conn {{ .Service.ClientType }}, {{ .Params }}, tagsMap interface{}) error {
+	tags := tftags.New(tagsMap)
+	input := &{{ .Service.TagPackage }}.{{ .CreateTags.Op }}Input{
+		{{ .InputIDFields .CreateTags.OperationSpec }}
+		{{ .CreateTags.TagsElem }}: {{ .InputTagsValue .CreateTags.OperationSpec "tags" }},
+	}
+
+	{{- if .CreateTags.NotFoundErrCodeContains }}
+
+	_, err := tfresource.RetryWhenNotFound({{ .CreateTagsTimeout }}, func() (interface{}, error) {
+		output, err := conn.{{ .CreateTags.Op }}(input)
+
+		if tfawserr.ErrCodeContains(err, "{{ .CreateTags.NotFoundErrCodeContains }}") {
+			err = &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		return output, err
+	})
+	{{- else }}
+
+	_, err := conn.{{ .CreateTags.Op }}(input)
+	{{- end }}
+
+	if err != nil {
+		return fmt.Errorf("error tagging resource ({{ .IDFormat }}): %w", {{ .IDArgs }}, err)
+	}
+
+	return nil
+}
+`
+
+var specTagSpecificationsBody = `
+// {{ .Name }}TagSpecificationsFromKeyValueTags returns the tag specifications for the given tags and resource type.
+func {{ .Name }}TagSpecificationsFromKeyValueTags(tags tftags.KeyValueTags, resourceType string) []*{{ .Service.TagPackage }}.{{ .TagSpecifications.Type }} {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []*{{ .Service.TagPackage }}.{{ .TagSpecifications.Type }}{
+		{
+			{{ .TagSpecifications.ResourceTypeElem }}: aws.String(resourceType),
+			{{ .TagSpecifications.TagsElem }}:         Tags(tags.IgnoreAWS()),
+		},
+	}
+}
+`
+
+// Spec declares the tag handling of a service. It is read from the YAML file named by the -Spec flag
+// and covers API shapes the other flags cannot express, such as paginated list operations,
+// resources identified by several values and replacing a resource's whole tag set.
+type Spec struct {
+	// Resources declare tag functions for the resources of the service.
+	Resources []*ResourceSpec `yaml:"resources"`
+	// ServiceTags is "map" or "slice" and generates the Tags and KeyValueTags conversion functions.
+	ServiceTags string `yaml:"serviceTags"`
+	// TagType declares the AWS Go SDK service tag type.
+	TagType TagTypeSpec `yaml:"tagType"`
+}
+
+// TagTypeSpec declares the AWS Go SDK service tag type.
+type TagTypeSpec struct {
+	KeyElem   string `yaml:"keyElem"`
+	KeyType   string `yaml:"keyType"`
+	Name      string `yaml:"name"`
+	Name2     string `yaml:"name2"`
+	ValueElem string `yaml:"valueElem"`
+}
+
+// ResourceSpec declares the tag functions of a resource, whose names are prefixed with Name.
+type ResourceSpec struct {
+	CreateTags *CreateTagsSpec `yaml:"createTags"`
+	GetTag     bool            `yaml:"getTag"`
+	// Identifiers are the values identifying the resource, if not a single identifier whose
+	// input element differs by operation.
+	Identifiers       []*IdentifierSpec      `yaml:"identifiers"`
+	ListTags          *ListTagsSpec          `yaml:"listTags"`
+	Name              string                 `yaml:"name"`
+	ResourceTypeElem  string                 `yaml:"resourceTypeElem"`
+	TagSpecifications *TagSpecificationsSpec `yaml:"tagSpecifications"`
+	UpdateTags        *UpdateTagsSpec        `yaml:"updateTags"`
+}
+
+// IdentifierSpec declares one of the values identifying a resource, such as an S3 object's bucket.
+type IdentifierSpec struct {
+	// Elem is the input element of every operation the value is set in.
+	Elem string `yaml:"elem"`
+	// Name is the name of the function parameter.
+	Name string `yaml:"name"`
+}
+
+// OperationSpec declares an API operation on a resource's tags.
+type OperationSpec struct {
+	// CustomVal is a Go expression used for the tags element instead of converting the tags with Tags().
+	CustomVal string `yaml:"customVal"`
+	// IDElem is the input identifier element, used if the resource does not declare Identifiers.
+	IDElem  string `yaml:"idElem"`
+	IDSlice bool   `yaml:"idSlice"`
+	Op      string `yaml:"op"`
+	// TagsElem is the input tags element or, for listing tags, the output tags element.
+	TagsElem string `yaml:"tagsElem"`
+}
+
+// ListTagsSpec declares the operation listing a resource's tags.
+type ListTagsSpec struct {
+	OperationSpec `yaml:",inline"`
+
+	// EmptyErrCodes are the error codes returned if the resource has no tags.
+	EmptyErrCodes []string `yaml:"emptyErrCodes"`
+	// FilterIDName is the name of the identifier filter of operations, such as EC2 DescribeTags, that filter tags.
+	FilterIDName string `yaml:"filterIDName"`
+	// NotFoundErrCode and NotFoundErrMsg identify the error returned if the resource does not exist.
+	NotFoundErrCode string `yaml:"notFoundErrCode"`
+	NotFoundErrMsg  string `yaml:"notFoundErrMsg"`
+	// Paginated is whether all pages of the operation's output are listed.
+	Paginated bool `yaml:"paginated"`
+	// RetryErrCodes are the error codes retried, for up to RetryTimeout, until the resource is visible.
+	RetryErrCodes []string `yaml:"retryErrCodes"`
+	RetryTimeout  string   `yaml:"retryTimeout"`
+}
+
+// UpdateTagsSpec declares how a resource's tags are updated: either the changed tags
+// with the Tag and Untag operations, or the whole tag set with the Set operation.
+type UpdateTagsSpec struct {
+	BatchSize int `yaml:"batchSize"`
+	// Delete is the operation removing all of the resource's tags, if Set cannot.
+	Delete *OperationSpec `yaml:"delete"`
+	// PreserveIgnoredTags is whether the existing tags not managed by Terraform are included in the Set operation.
+	PreserveIgnoredTags bool           `yaml:"preserveIgnoredTags"`
+	Set                 *OperationSpec `yaml:"set"`
+	Tag                 *OperationSpec `yaml:"tag"`
+	Untag               *UntagSpec     `yaml:"untag"`
+}
+
+// UntagSpec declares the operation removing tags from a resource.
+type UntagSpec struct {
+	OperationSpec `yaml:",inline"`
+
+	// TagsType is how the removed tags are passed: "keys", the default, "tagKeys" or "tags".
+	TagsType string `yaml:"tagsType"`
+}
+
+// CreateTagsSpec declares the operation tagging new resources, which defaults to the update Tag operation.
+type CreateTagsSpec struct {
+	OperationSpec `yaml:",inline"`
+
+	// NotFoundErrCodeContains identifies the errors retried, for up to Timeout, until the new resource is visible.
+	NotFoundErrCodeContains string `yaml:"notFoundErrCodeContains"`
+	Timeout                 string `yaml:"timeout"`
+}
+
+// TagSpecificationsSpec declares the tag specifications some services, such as EC2, accept when creating resources.
+type TagSpecificationsSpec struct {
+	ResourceTypeElem string `yaml:"resourceTypeElem"`
+	TagsElem         string `yaml:"tagsElem"`
+	Type             string `yaml:"type"`
+}
+
+const (
+	untagTagsTypeKeys    = "keys"
+	untagTagsTypeTagKeys = "tagKeys"
+	untagTagsTypeTags    = "tags"
+)
+
+// loadSpec reads a Spec from a YAML file, setting defaults and validating it.
+func loadSpec(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading spec (%s): %w", path, err)
+	}
+
+	spec := &Spec{}
+
+	if err := yaml.UnmarshalStrict(b, spec); err != nil {
+		return nil, fmt.Errorf("error parsing spec (%s): %w", path, err)
+	}
+
+	if err := spec.init(); err != nil {
+		return nil, fmt.Errorf("invalid spec (%s): %w", path, err)
+	}
+
+	return spec, nil
+}
+
+func (s *Spec) init() error {
+	switch s.ServiceTags {
+	case "", "map", "slice":
+	default:
+		return fmt.Errorf("serviceTags must be map or slice, got %q", s.ServiceTags)
+	}
+
+	setDefault(&s.TagType.KeyElem, "Key")
+	setDefault(&s.TagType.Name, "Tag")
+	setDefault(&s.TagType.ValueElem, "Value")
+
+	names := make(map[string]bool)
+
+	for _, r := range s.Resources {
+		if names[r.Name] {
+			return fmt.Errorf("duplicate resource %q", r.Name)
+		}
+
+		names[r.Name] = true
+
+		if err := r.init(s); err != nil {
+			if r.Name == "" {
+				return err
+			}
+
+			return fmt.Errorf("resource %s: %w", r.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ResourceSpec) init(s *Spec) error {
+	for _, id := range r.Identifiers {
+		if id.Elem == "" || id.Name == "" {
+			return fmt.Errorf("identifiers must have an elem and a name")
+		}
+	}
+
+	if r.GetTag && r.ListTags == nil {
+		return fmt.Errorf("getTag requires listTags")
+	}
+
+	if l := r.ListTags; l != nil {
+		l.init("ListTagsForResource", "Tags")
+
+		if l.FilterIDName != "" && len(r.Identifiers) > 0 {
+			return fmt.Errorf("listTags filterIDName requires a single identifier")
+		}
+
+		if l.Paginated && len(l.RetryErrCodes) > 0 {
+			return fmt.Errorf("listTags cannot be both paginated and retried")
+		}
+
+		if (len(l.RetryErrCodes) > 0) != (l.RetryTimeout != "") {
+			return fmt.Errorf("listTags retryErrCodes and retryTimeout must be set together")
+		}
+
+		if err := validateDuration(l.RetryTimeout); err != nil {
+			return fmt.Errorf("listTags retryTimeout: %w", err)
+		}
+	}
+
+	if u := r.UpdateTags; u != nil {
+		if u.Set != nil {
+			if u.Tag != nil || u.Untag != nil {
+				return fmt.Errorf("updateTags must use either set or tag and untag")
+			}
+
+			if u.BatchSize > 0 {
+				return fmt.Errorf("updateTags batchSize cannot be used with set")
+			}
+
+			if u.PreserveIgnoredTags && r.ListTags == nil {
+				return fmt.Errorf("updateTags preserveIgnoredTags requires listTags")
+			}
+
+			u.Set.init("", "Tags")
+
+			if u.Set.Op == "" {
+				return fmt.Errorf("updateTags set requires an op")
+			}
+
+			if u.Delete != nil {
+				u.Delete.init("", "")
+
+				if u.Delete.Op == "" {
+					return fmt.Errorf("updateTags delete requires an op")
+				}
+			}
+		} else {
+			if u.Delete != nil || u.PreserveIgnoredTags {
+				return fmt.Errorf("updateTags delete and preserveIgnoredTags require set")
+			}
+
+			if u.Tag == nil {
+				u.Tag = &OperationSpec{}
+			}
+
+			if u.Untag == nil {
+				u.Untag = &UntagSpec{}
+			}
+
+			u.Tag.init("TagResource", "Tags")
+			u.Untag.init("UntagResource", "TagKeys")
+			setDefault(&u.Untag.TagsType, untagTagsTypeKeys)
+
+			switch u.Untag.TagsType {
+			case untagTagsTypeKeys, untagTagsTypeTags:
+			case untagTagsTypeTagKeys:
+				if s.TagType.KeyType == "" {
+					return fmt.Errorf("updateTags untag tagsType %s requires tagType keyType", untagTagsTypeTagKeys)
+				}
+			default:
+				return fmt.Errorf("updateTags untag tagsType must be %s, %s or %s, got %q", untagTagsTypeKeys, untagTagsTypeTagKeys, untagTagsTypeTags, u.Untag.TagsType)
+			}
+		}
+	}
+
+	if c := r.CreateTags; c != nil {
+		if r.UpdateTags != nil && r.UpdateTags.Tag != nil {
+			setDefault(&c.Op, r.UpdateTags.Tag.Op)
+			setDefault(&c.IDElem, r.UpdateTags.Tag.IDElem)
+			setDefault(&c.TagsElem, r.UpdateTags.Tag.TagsElem)
+			c.IDSlice = c.IDSlice || r.UpdateTags.Tag.IDSlice
+		}
+
+		c.init("TagResource", "Tags")
+
+		if (c.NotFoundErrCodeContains != "") != (c.Timeout != "") {
+			return fmt.Errorf("createTags notFoundErrCodeContains and timeout must be set together")
+		}
+
+		if err := validateDuration(c.Timeout); err != nil {
+			return fmt.Errorf("createTags timeout: %w", err)
+		}
+	}
+
+	if t := r.TagSpecifications; t != nil {
+		if s.ServiceTags != "slice" {
+			return fmt.Errorf("tagSpecifications requires slice serviceTags")
+		}
+
+		setDefault(&t.ResourceTypeElem, "ResourceType")
+		setDefault(&t.TagsElem, "Tags")
+		setDefault(&t.Type, "TagSpecification")
+	}
+
+	return nil
+}
+
+func (o *OperationSpec) init(op, tagsElem string) {
+	setDefault(&o.IDElem, "ResourceArn")
+	setDefault(&o.Op, op)
+	setDefault(&o.TagsElem, tagsElem)
+}
+
+func setDefault(s *string, v string) {
+	if *s == "" {
+		*s = v
+	}
+}
+
+func validateDuration(s string) error {
+	if s == "" {
+		return nil
+	}
+
+	_, err := time.ParseDuration(s)
+
+	return err
+}
+
+// durationExpr returns a Go expression for a duration such as "5m".
+func durationExpr(s string) string {
+	d, _ := time.ParseDuration(s)
+
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	default:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+	}
+}
+
+// ResourceTemplateData is the data of the templates generating a resource's tag functions.
+type ResourceTemplateData struct {
+	*ResourceSpec
+
+	Service TemplateData
+
+	// Args are the identifier arguments of calls to other tag functions of the resource.
+	Args string
+	// IDArgs and IDFormat format the resource's identifiers in error messages.
+	IDArgs   string
+	IDFormat string
+	// Params are the identifier parameters of the resource's tag functions.
+	Params string
+}
+
+func newResourceTemplateData(r *ResourceSpec, service TemplateData) ResourceTemplateData {
+	names := []string{"identifier"}
+
+	if len(r.Identifiers) > 0 {
+		names = nil
+
+		for _, id := range r.Identifiers {
+			names = append(names, id.Name)
+		}
+	}
+
+	d := ResourceTemplateData{
+		ResourceSpec: r,
+		Service:      service,
+
+		Args:     strings.Join(names, ", "),
+		IDArgs:   strings.Join(names, ", "),
+		IDFormat: strings.TrimSuffix(strings.Repeat("%s/", len(names)), "/"),
+		Params:   strings.Join(names, ", ") + " string",
+	}
+
+	if r.ResourceTypeElem != "" {
+		d.Args += ", resourceType"
+		d.Params += ", resourceType string"
+	}
+
+	return d
+}
+
+// InputIDFields returns the fields of an operation's input identifying the resource.
+func (d ResourceTemplateData) InputIDFields(op *OperationSpec) string {
+	var fields []string
+
+	switch {
+	case len(d.Identifiers) > 0:
+		for _, id := range d.Identifiers {
+			fields = append(fields, fmt.Sprintf("%s: aws.String(%s),", id.Elem, id.Name))
+		}
+	case op.IDSlice:
+		fields = append(fields, fmt.Sprintf("%s: aws.StringSlice([]string{identifier}),", op.IDElem))
+	default:
+		fields = append(fields, fmt.Sprintf("%s: aws.String(identifier),", op.IDElem))
+	}
+
+	if d.ResourceTypeElem != "" {
+		fields = append(fields, fmt.Sprintf("%s: aws.String(resourceType),", d.ResourceTypeElem))
+	}
+
+	return strings.Join(fields, "\n")
+}
+
+// InputTagsValue returns the value of an operation's input tags element for the named tags.
+func (d ResourceTemplateData) InputTagsValue(op *OperationSpec, tags string) string {
+	if op.CustomVal != "" {
+		return op.CustomVal
+	}
+
+	return fmt.Sprintf("Tags(%s.IgnoreAWS())", tags)
+}
+
+// UntagTagsValue returns the value of the untag operation's input tags element.
+func (d ResourceTemplateData) UntagTagsValue() string {
+	untag := d.UpdateTags.Untag
+
+	if untag.CustomVal != "" {
+		return untag.CustomVal
+	}
+
+	switch untag.TagsType {
+	case untagTagsTypeTagKeys:
+		return "TagKeys(removedTags.IgnoreAWS())"
+	case untagTagsTypeTags:
+		return "Tags(removedTags.IgnoreAWS())"
+	default:
+		return "aws.StringSlice(removedTags.IgnoreAWS().Keys())"
+	}
+}
+
+// CreateTagsTimeout returns a Go expression for the create tags timeout.
+func (d ResourceTemplateData) CreateTagsTimeout() string {
+	return durationExpr(d.CreateTags.Timeout)
+}
+
+// ListTagsRetryTimeout returns a Go expression for the list tags retry timeout.
+func (d ResourceTemplateData) ListTagsRetryTimeout() string {
+	return durationExpr(d.ListTags.RetryTimeout)
+}
+
+// GoStrings returns a comma separated list of Go string literals.
+func (d ResourceTemplateData) GoStrings(l []string) string {
+	var quoted []string
+
+	for _, v := range l {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// generateFromSpec generates the tag functions declared in a spec file.
+func generateFromSpec(path string, service TemplateData) error {
+	spec, err := loadSpec(path)
+
+	if err != nil {
+		return err
+	}
+
+	service.TagKeyType = spec.TagType.KeyType
+	service.TagType = spec.TagType.Name
+	service.TagType2 = spec.TagType.Name2
+	service.TagTypeKeyElem = spec.TagType.KeyElem
+	service.TagTypeValElem = spec.TagType.ValueElem
+
+	header := service
+	needsService := spec.ServiceTags == "slice"
+
+	for _, r := range spec.Resources {
+		if r.GetTag || r.ListTags != nil || r.UpdateTags != nil || r.CreateTags != nil || r.TagSpecifications != nil {
+			needsService = true
+		}
+
+		if r.GetTag {
+			header.TfResourcePkg = true
+		}
+
+		if l := r.ListTags; l != nil {
+			if len(l.EmptyErrCodes) > 0 || l.NotFoundErrCode != "" {
+				header.TfAWSErrPkg = true
+			}
+
+			if l.NotFoundErrCode != "" {
+				header.ResourcePkg = true
+			}
+
+			if l.RetryTimeout != "" {
+				header.TfResourcePkg = true
+				header.TimePkg = true
+			}
+		}
+
+		if r.UpdateTags != nil {
+			header.FmtPkg = true
+		}
+
+		if c := r.CreateTags; c != nil {
+			header.FmtPkg = true
+
+			if c.NotFoundErrCodeContains != "" {
+				header.ResourcePkg = true
+				header.TfAWSErrPkg = true
+				header.TfResourcePkg = true
+				header.TimePkg = true
+			}
+		}
+	}
+
+	if !needsService {
+		header.AWSService = ""
+	}
+
+	writeTemplate(headerBody, "header", header)
+
+	for _, r := range spec.Resources {
+		d := newResourceTemplateData(r, service)
+
+		if r.GetTag {
+			writeTemplate(specGetTagBody, "gettag", d)
+		}
+
+		if r.ListTags != nil {
+			writeTemplate(specListTagsBody, "listtags", d)
+		}
+	}
+
+	switch spec.ServiceTags {
+	case "map":
+		writeTemplate(servicetagsmapBody, "servicetagsmap", service)
+	case "slice":
+		writeTemplate(servicetagssliceBody, "servicetagsslice", service)
+	}
+
+	for _, r := range spec.Resources {
+		d := newResourceTemplateData(r, service)
+
+		if r.UpdateTags != nil {
+			writeTemplate(specUpdateTagsBody, "updatetags", d)
+		}
+
+		if r.CreateTags != nil {
+			writeTemplate(specCreateTagsBody, "createtags", d)
+		}
+
+		if r.TagSpecifications != nil {
+			writeTemplate(specTagSpecificationsBody, "tagspecifications", d)
+		}
+	}
+
+	return nil
+}
+
 func awsServiceName(s string) (string, error) {
 	s = strings.ToLower(s)
 
@@ -1,13 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
@@ -193,6 +197,31 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarCABundle, ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"ec2_metadata_service_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpoint, ""),
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  descriptions["ec2_metadata_service_endpoint"],
+			},
+
+			"ec2_metadata_service_endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarEC2MetadataServiceEndpointMode, ""),
+				ValidateFunc: validation.StringInSlice(conns.EC2MetadataServiceEndpointMode_Values(), false),
+				Description:  descriptions["ec2_metadata_service_endpoint_mode"],
+			},
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -225,6 +254,16 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"rate_limit": rateLimitSchema(),
+
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(conns.EnvVarRetryMode, conns.RetryModeStandard),
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+				Description:  descriptions["retry_mode"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -241,6 +280,32 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"allowed_organization_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: descriptions["allowed_organization_ids"],
+			},
+
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Set:           schema.HashString,
+				Description:   descriptions["allowed_regions"],
+			},
+
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Set:           schema.HashString,
+				Description:   descriptions["forbidden_regions"],
+			},
+
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -248,11 +313,19 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"tags": {
-							Type:        schema.TypeMap,
+						"set_once_keys": {
+							Type:        schema.TypeSet,
 							Optional:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
-							Description: "Resource tags to default across all resources",
+							Set:         schema.HashString,
+							Description: "Resource tag keys whose default values are only set when resources are created.",
+						},
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateProviderDefaultTags,
+							Description:  "Resource tags to default across all resources",
 						},
 					},
 				},
@@ -264,6 +337,13 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"no_proxy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["no_proxy"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -273,6 +353,11 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to ignore resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"case_insensitive": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Match resource tag keys, key prefixes, values and patterns regardless of case.",
+						},
 						"keys": {
 							Type:        schema.TypeSet,
 							Optional:    true,
@@ -280,6 +365,16 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag keys to ignore across all resources.",
 						},
+						"key_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
 						"key_prefixes": {
 							Type:        schema.TypeSet,
 							Optional:    true,
@@ -287,10 +382,101 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag values, with any key, to ignore across all resources.",
+						},
+						"value_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag values, with any key, to ignore across all resources.",
+						},
 					},
 				},
 			},
 
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that resource tags must follow across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(tftags.PolicyKeyCase_Values(), false),
+							Description:  "Case that resource tag keys must be in.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource tag keys required across all resources.",
+						},
+						"value_patterns": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Regular expressions that resource tag values must match, by tag key.",
+						},
+						"use_organizations_tag_policy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Also check resource tags against the AWS Organizations tag policy in effect for the account.",
+						},
+						"warn_only": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Log a warning, instead of failing the plan, for resource tags not following the rules.",
+						},
+					},
+				},
+			},
+
+			"naming": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to generate resource names across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum length of resource names, in addition to each service's own limit.",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Prefix of generated resource names when no resource name prefix is configured.",
+						},
+						"suffix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Suffix of generated resource names.",
+						},
+					},
+				},
+			},
+
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["read_only"],
+			},
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -339,6 +525,20 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"use_dualstack_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarUseDualStackEndpoint, false),
+				Description: descriptions["use_dualstack_endpoint"],
+			},
+
+			"use_fips_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.EnvVarUseFIPSEndpoint, false),
+				Description: descriptions["use_fips_endpoint"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -658,6 +858,7 @@ func Provider() *schema.Provider {
 			"aws_redshift_service_account":   redshift.DataSourceServiceAccount(),
 
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
+			"aws_resourcegroupstaggingapi_tag_drift": resourcegroupstaggingapi.DataSourceTagDrift(),
 
 			"aws_route53_delegation_set": route53.DataSourceDelegationSet(),
 			"aws_route53_zone":           route53.DataSourceZone(),
@@ -1520,6 +1721,8 @@ func Provider() *schema.Provider {
 
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
+			"aws_resourcegroupstaggingapi_resource_tags": resourcegroupstaggingapi.ResourceResourceTags(),
+
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
 			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
@@ -1769,17 +1972,45 @@ func Provider() *schema.Provider {
 		},
 	}
 
-	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+	for typeName, r := range provider.ResourcesMap {
+		resolveResourceDefaultTags(typeName, r)
+	}
+
+	if conns.APICallTracingEnabled() {
+		for typeName, r := range provider.DataSourcesMap {
+			traceResourceAPICalls(typeName, r)
+		}
+
+		for typeName, r := range provider.ResourcesMap {
+			traceResourceAPICalls(typeName, r)
+		}
+	}
+
+	provider.ConfigureFunc = configureFunc(provider, nil)
+
+	return provider
+}
+
+// ProviderWithHTTPTransport returns a *schema.Provider whose AWS API requests are sent
+// through the transport returned by `wrap`, e.g. to record or replay them in acceptance tests.
+func ProviderWithHTTPTransport(wrap func(http.RoundTripper) http.RoundTripper) *schema.Provider {
+	provider := Provider()
+
+	provider.ConfigureFunc = configureFunc(provider, wrap)
+
+	return provider
+}
+
+func configureFunc(provider *schema.Provider, httpTransport func(http.RoundTripper) http.RoundTripper) schema.ConfigureFunc {
+	return func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
 			// Terraform 0.12 introduced this field to the protocol
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigure(d, terraformVersion)
+		return providerConfigure(d, terraformVersion, httpTransport)
 	}
-
-	return provider
 }
 
 var descriptions map[string]string
@@ -1808,9 +2039,39 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"allowed_organization_ids": "List of allowed AWS Organization IDs. The caller's account must be a member of one of them.",
+
+		"allowed_regions": "List of allowed AWS Regions to prevent you from mistakenly using an incorrect one. " +
+			"Conflicts with `forbidden_regions`.",
+
+		"forbidden_regions": "List of forbidden AWS Regions to prevent you from mistakenly using an incorrect one. " +
+			"Conflicts with `allowed_regions`.",
+
+		"read_only": "Reject any API request that may modify resources, such as those creating, updating, deleting or tagging them. " +
+			"Data sources and refreshing resources are not affected.",
+
+		"rate_limit": "Client-side limits on the rate of API requests to specific services.",
+
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+			"In both modes, failed requests are retried up to `max_retries` times with exponential backoff. " +
+			"In `adaptive` mode, requests to a service are also slowed down after it throttles a request. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"no_proxy": "Hosts, domains or CIDR ranges that should not be accessed through the HTTP proxy. " +
+			"Can also be configured using the `NO_PROXY` environment variable.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates. " +
+			"Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"ec2_metadata_service_endpoint": "Address of the EC2 metadata service endpoint to use. " +
+			"Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
+
+		"ec2_metadata_service_endpoint_mode": "Protocol to use with EC2 metadata service endpoint. " +
+			"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
@@ -1835,94 +2096,78 @@ func init() {
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"use_dualstack_endpoint": "Resolve an endpoint with DualStack capability. " +
+			"Can also be configured using the `AWS_USE_DUALSTACK_ENDPOINT` environment variable.",
+
+		"use_fips_endpoint": "Resolve an endpoint with FIPS capability. " +
+			"Can also be configured using the `AWS_USE_FIPS_ENDPOINT` environment variable.",
 	}
 }
 
-func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, terraformVersion string, httpTransport func(http.RoundTripper) http.RoundTripper) (interface{}, error) {
 	config := conns.Config{
-		AccessKey:               d.Get("access_key").(string),
-		SecretKey:               d.Get("secret_key").(string),
-		Profile:                 d.Get("profile").(string),
-		Token:                   d.Get("token").(string),
-		Region:                  d.Get("region").(string),
-		CredsFilename:           d.Get("shared_credentials_file").(string),
-		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
-		Endpoints:               make(map[string]string),
-		MaxRetries:              d.Get("max_retries").(int),
-		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
-		Insecure:                d.Get("insecure").(bool),
-		HTTPProxy:               d.Get("http_proxy").(string),
-		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
-		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
-		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
-		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
-		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
-		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
-		TerraformVersion:        terraformVersion,
-	}
-
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
-
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
+		AccessKey:                      d.Get("access_key").(string),
+		SecretKey:                      d.Get("secret_key").(string),
+		Profile:                        d.Get("profile").(string),
+		Token:                          d.Get("token").(string),
+		Region:                         d.Get("region").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
+		RetryMode:                      d.Get("retry_mode").(string),
+		CredsFilename:                  d.Get("shared_credentials_file").(string),
+		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
+		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		Endpoints:                      make(map[string]string),
+		MaxRetries:                     d.Get("max_retries").(int),
+		IgnoreTagsConfig:               expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		Insecure:                       d.Get("insecure").(bool),
+		HTTPProxy:                      d.Get("http_proxy").(string),
+		HTTPTransport:                  httpTransport,
+		NamingConfig:                   expandProviderNaming(d.Get("naming").([]interface{})),
+		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
+		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
+		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipMetadataApiCheck:           d.Get("skip_metadata_api_check").(bool),
+		S3ForcePathStyle:               d.Get("s3_force_path_style").(bool),
+		TerraformVersion:               terraformVersion,
+		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
+		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+	}
 
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
+	defaultTagsConfig, err := expandProviderDefaultTags(d.Get("default_tags").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign default tags: %w", err)
+	}
 
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
+	config.DefaultTagsConfig = defaultTagsConfig
 
-				if !ok {
-					continue
-				}
+	if l, ok := d.Get("assume_role").([]interface{}); ok {
+		for _, tfMapRaw := range l {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
 
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+			if !ok {
+				continue
 			}
-		}
-
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
-
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
-		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
+			assumeRole := expandAssumeRole(tfMap)
 
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTags[k] = v
+			// A block without a role ARN does not assume a role.
+			if assumeRole.RoleARN == "" {
+				continue
 			}
-		}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+			log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
 
-				if !ok {
-					continue
-				}
-
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
 		}
+	}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		config.AssumeRoleWithWebIdentity = expandAssumeRoleWithWebIdentity(l[0].(map[string]interface{}))
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1943,6 +2188,43 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("rate_limit"); ok {
+		config.RateLimits = make(map[string]conns.ServiceRateLimit)
+
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			serviceKey, err := conns.ServiceForHCLKey(tfMap["service"].(string))
+			if err != nil {
+				return nil, fmt.Errorf("failed to assign rate limit (%s): %w", tfMap["service"].(string), err)
+			}
+
+			config.RateLimits[serviceKey] = conns.ServiceRateLimit{
+				Burst:             tfMap["burst"].(int),
+				RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok {
+		tagPolicyConfig, err := expandProviderTagPolicy(v.([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign tag policy: %w", err)
+		}
+
+		config.TagPolicyConfig = tagPolicyConfig
+
+		if tfMap, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			config.UseOrganizationsTagPolicy = tfMap["use_organizations_tag_policy"].(bool)
+		}
+	}
+
+	if v, ok := d.GetOk("no_proxy"); ok {
+		for _, hostRaw := range v.([]interface{}) {
+			config.NoProxy = append(config.NoProxy, hostRaw.(string))
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1955,14 +2237,32 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("allowed_organization_ids"); ok {
+		for _, organizationIDRaw := range v.(*schema.Set).List() {
+			config.AllowedOrganizationIds = append(config.AllowedOrganizationIds, organizationIDRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("allowed_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			config.AllowedRegions = append(config.AllowedRegions, regionRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("forbidden_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			config.ForbiddenRegions = append(config.ForbiddenRegions, regionRaw.(string))
+		}
+	}
+
 	return config.Client()
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume prior to making API calls. Roles are assumed in order, each using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -2027,6 +2327,62 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "Amazon Resource Names (ARNs) of IAM Policies describing further restricting permissions for the IAM Role being assumed.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "An identifier for the assumed role session.",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(2, 64),
+						validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
+					),
+				},
+				"web_identity_token": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Description:   "The OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token_file"},
+					ValidateFunc:  validation.StringLenBetween(4, 20000),
+				},
+				"web_identity_token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "File containing the OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ConflictsWith: []string{"assume_role_with_web_identity.0.web_identity_token"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -2048,18 +2404,270 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
-func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
+// traceResourceAPICalls attributes AWS API calls made by the resource's CRUD functions
+// to the resource in API call traces, logging a summary of the calls as each function returns.
+func traceResourceAPICalls(typeName string, r *schema.Resource) {
+	traced := func(operation string, d *schema.ResourceData, meta interface{}) (interface{}, func()) {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			return client.WithTracedResource(typeName, operation, d.Id)
+		}
+
+		return meta, func() {}
+	}
+
+	wrap := func(operation string, f schema.CreateFunc) schema.CreateFunc {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			meta, done := traced(operation, d, meta)
+			defer done()
+
+			return f(d, meta)
+		}
+	}
+
+	wrapContext := func(operation string, f schema.CreateContextFunc) schema.CreateContextFunc {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			meta, done := traced(operation, d, meta)
+			defer done()
+
+			return f(ctx, d, meta)
+		}
+	}
+
+	r.Create = wrap("Create", r.Create)
+	r.Read = schema.ReadFunc(wrap("Read", schema.CreateFunc(r.Read)))
+	r.Update = schema.UpdateFunc(wrap("Update", schema.CreateFunc(r.Update)))
+	r.Delete = schema.DeleteFunc(wrap("Delete", schema.CreateFunc(r.Delete)))
+	r.CreateContext = wrapContext("Create", r.CreateContext)
+	r.CreateWithoutTimeout = wrapContext("Create", r.CreateWithoutTimeout)
+	r.ReadContext = schema.ReadContextFunc(wrapContext("Read", schema.CreateContextFunc(r.ReadContext)))
+	r.ReadWithoutTimeout = schema.ReadContextFunc(wrapContext("Read", schema.CreateContextFunc(r.ReadWithoutTimeout)))
+	r.UpdateContext = schema.UpdateContextFunc(wrapContext("Update", schema.CreateContextFunc(r.UpdateContext)))
+	r.UpdateWithoutTimeout = schema.UpdateContextFunc(wrapContext("Update", schema.CreateContextFunc(r.UpdateWithoutTimeout)))
+	r.DeleteContext = schema.DeleteContextFunc(wrapContext("Delete", schema.CreateContextFunc(r.DeleteContext)))
+	r.DeleteWithoutTimeout = schema.DeleteContextFunc(wrapContext("Delete", schema.CreateContextFunc(r.DeleteWithoutTimeout)))
+}
+
+// resolveResourceDefaultTags records the resource type in the context of the resource's CustomizeDiff,
+// for default tag value templates resolved in "tags_all" by verify.SetTagsDiff, and passes the resource's
+// CRUD functions a client whose default tags are for the resource, so that resources merging the default
+// tags into their tags on create use the planned "tags_all" values of templated and set-once tags.
+func resolveResourceDefaultTags(typeName string, r *schema.Resource) {
+	if _, ok := r.Schema["tags_all"]; !ok {
+		return
+	}
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return f(tftags.ContextWithResourceType(ctx, typeName), diff, meta)
+		}
+	}
+
+	wrap := func(f schema.CreateFunc) schema.CreateFunc {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceDefaultTagsMeta(d, meta))
+		}
+	}
+
+	wrapContext := func(f schema.CreateContextFunc) schema.CreateContextFunc {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceDefaultTagsMeta(d, meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = schema.ReadFunc(wrap(schema.CreateFunc(r.Read)))
+	r.Update = schema.UpdateFunc(wrap(schema.CreateFunc(r.Update)))
+	r.CreateContext = wrapContext(r.CreateContext)
+	r.CreateWithoutTimeout = wrapContext(r.CreateWithoutTimeout)
+	r.ReadContext = schema.ReadContextFunc(wrapContext(schema.CreateContextFunc(r.ReadContext)))
+	r.ReadWithoutTimeout = schema.ReadContextFunc(wrapContext(schema.CreateContextFunc(r.ReadWithoutTimeout)))
+	r.UpdateContext = schema.UpdateContextFunc(wrapContext(schema.CreateContextFunc(r.UpdateContext)))
+	r.UpdateWithoutTimeout = schema.UpdateContextFunc(wrapContext(schema.CreateContextFunc(r.UpdateWithoutTimeout)))
+}
+
+// resourceDefaultTagsMeta returns a copy of the provider client whose default tags configuration is for
+// the resource's "tags" and planned or prior "tags_all", if the default tags have templated or set-once values.
+func resourceDefaultTagsMeta(d *schema.ResourceData, meta interface{}) interface{} {
+	client, ok := meta.(*conns.AWSClient)
+
+	if !ok || len(client.DefaultTagsConfig.DynamicKeys()) == 0 {
+		return meta
+	}
+
+	resourceTags, _ := d.Get("tags").(map[string]interface{})
+	allTags, _ := d.Get("tags_all").(map[string]interface{})
+
+	return client.WithDefaultTagsConfig(client.DefaultTagsConfig.ForResource(tftags.New(resourceTags), tftags.New(allTags)))
+}
+
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: descriptions["rate_limit"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of requests to the service that may be sent at once.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0.1),
+					Description:  "Maximum sustained rate of requests to the service.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(conns.HCLKeys(), false),
+					Description:  "Service whose requests are rate limited, using the same names as the endpoints block.",
+				},
+			},
+		},
+	}
+}
+
+func expandAssumeRole(tfMap map[string]interface{}) conns.AssumeRole {
+	assumeRole := conns.AssumeRole{}
+
+	if v, ok := tfMap["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := tfMap["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			if v, ok := vRaw.(string); ok {
+				assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if tagMapRaw, ok := tfMap["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if v, ok := tfMap["transitive_tag_keys"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			if v, ok := vRaw.(string); ok {
+				assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, v)
+			}
+		}
+	}
+
+	return assumeRole
+}
+
+func expandAssumeRoleWithWebIdentity(tfMap map[string]interface{}) *conns.AssumeRoleWithWebIdentity {
+	assumeRole := &conns.AssumeRoleWithWebIdentity{}
+
+	if v, ok := tfMap["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := tfMap["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+		for _, vRaw := range v.List() {
+			if v, ok := vRaw.(string); ok {
+				assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if v, ok := tfMap["web_identity_token"].(string); ok && v != "" {
+		assumeRole.WebIdentityToken = v
+	}
+
+	if v, ok := tfMap["web_identity_token_file"].(string); ok && v != "" {
+		assumeRole.WebIdentityTokenFile = v
+	}
+
+	return assumeRole
+}
+
+func expandProviderDefaultTags(l []interface{}) (*tftags.DefaultConfig, error) {
 	if len(l) == 0 || l[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	defaultConfig := &tftags.DefaultConfig{}
 	m := l[0].(map[string]interface{})
 
+	if v, ok := m["set_once_keys"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.SetOnceKeys = tftags.New(v.List())
+	}
+
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
-	return defaultConfig
+
+	if err := defaultConfig.ValidateSetOnceKeys(); err != nil {
+		return nil, err
+	}
+
+	return defaultConfig, nil
+}
+
+func validateProviderDefaultTags(v interface{}, k string) (ws []string, errors []error) {
+	for key, value := range v.(map[string]interface{}) {
+		if err := tftags.ValidateDefaultTemplate(value.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("%s: tag (%s): %w", k, key, err))
+		}
+	}
+
+	return
 }
 
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
@@ -2078,5 +2686,101 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["case_insensitive"].(bool); ok {
+		ignoreConfig.CaseInsensitive = v
+	}
+
+	if v, ok := m["key_patterns"].(*schema.Set); ok {
+		ignoreConfig.KeyPatterns = expandProviderIgnoreTagsPatterns(v.List(), ignoreConfig.CaseInsensitive)
+	}
+
+	if v, ok := m["values"].(*schema.Set); ok {
+		for _, valueRaw := range v.List() {
+			ignoreConfig.Values = append(ignoreConfig.Values, valueRaw.(string))
+		}
+	}
+
+	if v, ok := m["value_patterns"].(*schema.Set); ok {
+		ignoreConfig.ValuePatterns = expandProviderIgnoreTagsPatterns(v.List(), ignoreConfig.CaseInsensitive)
+	}
+
 	return ignoreConfig
 }
+
+// expandProviderIgnoreTagsPatterns compiles regular expressions already validated by the schema.
+func expandProviderIgnoreTagsPatterns(l []interface{}, caseInsensitive bool) []*regexp.Regexp {
+	var patterns []*regexp.Regexp
+
+	for _, patternRaw := range l {
+		pattern := patternRaw.(string)
+
+		if caseInsensitive {
+			pattern = "(?i)" + pattern
+		}
+
+		patterns = append(patterns, regexp.MustCompile(pattern))
+	}
+
+	return patterns
+}
+
+func expandProviderTagPolicy(l []interface{}) (*tftags.PolicyConfig, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["key_case"].(string); ok {
+		policyConfig.KeyCase = v
+	}
+
+	if v, ok := m["required_keys"].(*schema.Set); ok {
+		for _, keyRaw := range v.List() {
+			policyConfig.RequiredKeys = append(policyConfig.RequiredKeys, keyRaw.(string))
+		}
+	}
+
+	if v, ok := m["value_patterns"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.ValuePatterns = make(map[string]*regexp.Regexp, len(v))
+
+		for key, patternRaw := range v {
+			re, err := regexp.Compile(patternRaw.(string))
+			if err != nil {
+				return nil, fmt.Errorf("invalid value pattern for tag key (%s): %w", key, err)
+			}
+
+			policyConfig.ValuePatterns[key] = re
+		}
+	}
+
+	if v, ok := m["warn_only"].(bool); ok {
+		policyConfig.WarnOnly = v
+	}
+
+	return policyConfig, nil
+}
+
+func expandProviderNaming(l []interface{}) *create.NamingConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	namingConfig := &create.NamingConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["max_length"].(int); ok {
+		namingConfig.MaxLength = v
+	}
+
+	if v, ok := m["prefix"].(string); ok {
+		namingConfig.Prefix = v
+	}
+
+	if v, ok := m["suffix"].(string); ok {
+		namingConfig.Suffix = v
+	}
+
+	return namingConfig
+}
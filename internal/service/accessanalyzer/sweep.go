@@ -26,7 +26,7 @@ func sweepAnalyzers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AccessAnalyzerConn
+	conn := client.(*conns.AWSClient).AccessAnalyzerConn()
 	input := &accessanalyzer.ListAnalyzersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -40,7 +40,7 @@ func sweepAnalyzers(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(analyzer.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_accessanalyzer_analyzer")))
 		}
 
 		return !lastPage
@@ -67,7 +67,7 @@ func ResourceAlternateContact() *schema.Resource {
 }
 
 func resourceAlternateContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AccountConn
+	conn := meta.(*conns.AWSClient).AccountConn()
 
 	contactType := d.Get("alternate_contact_type").(string)
 	input := &account.PutAlternateContactInput{
@@ -97,7 +97,7 @@ func resourceAlternateContactCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAlternateContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AccountConn
+	conn := meta.(*conns.AWSClient).AccountConn()
 
 	accountID, contactType, err := AlternateContactParseResourceID(d.Id())
 
@@ -128,7 +128,7 @@ func resourceAlternateContactRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAlternateContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AccountConn
+	conn := meta.(*conns.AWSClient).AccountConn()
 
 	accountID, contactType, err := AlternateContactParseResourceID(d.Id())
 
@@ -159,7 +159,7 @@ func resourceAlternateContactUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAlternateContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).AccountConn
+	conn := meta.(*conns.AWSClient).AccountConn()
 
 	accountID, contactType, err := AlternateContactParseResourceID(d.Id())
 
@@ -142,7 +142,7 @@ func TestAccAccountAlternateContact_accountID(t *testing.T) {
 
 func testAccountAlternateContactDestroy(s *terraform.State) error {
 	ctx := context.TODO()
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_account_alternate_contact" {
@@ -190,7 +190,7 @@ func testAccCheckAccountAlternateContactExists(n string) resource.TestCheckFunc
 		}
 
 		ctx := context.TODO()
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn()
 
 		_, err = tfaccount.FindAlternateContactByAccountIDAndContactType(ctx, conn, accountID, contactType)
 
@@ -235,7 +235,7 @@ resource "aws_account_alternate_contact" "test" {
 
 func testAccPreCheck(t *testing.T) {
 	ctx := context.TODO()
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn()
 
 	_, err := tfaccount.FindAlternateContactByAccountIDAndContactType(ctx, conn, "", account.AlternateContactTypeOperations)
 
@@ -94,7 +94,7 @@ func testAccCheckAccountExists(n string, res *apigateway.Account) resource.TestC
 			return fmt.Errorf("No API Gateway Account ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		req := &apigateway.GetAccountInput{}
 		describe, err := conn.GetAccount(req)
@@ -57,7 +57,7 @@ func sweepApplications(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppConfigConn
+	conn := client.(*conns.AWSClient).AppConfigConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -80,7 +80,7 @@ func sweepApplications(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appconfig_application")))
 		}
 
 		return !lastPage
@@ -109,7 +109,7 @@ func sweepConfigurationProfiles(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppConfigConn
+	conn := client.(*conns.AWSClient).AppConfigConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -148,7 +148,7 @@ func sweepConfigurationProfiles(region string) error {
 					d := r.Data(nil)
 					d.SetId(id)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appconfig_configuration_profile")))
 				}
 
 				return !lastPage
@@ -185,7 +185,7 @@ func sweepDeploymentStrategies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppConfigConn
+	conn := client.(*conns.AWSClient).AppConfigConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -214,7 +214,7 @@ func sweepDeploymentStrategies(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appconfig_deployment_strategy")))
 		}
 
 		return !lastPage
@@ -243,7 +243,7 @@ func sweepEnvironments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppConfigConn
+	conn := client.(*conns.AWSClient).AppConfigConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -282,7 +282,7 @@ func sweepEnvironments(region string) error {
 					d := r.Data(nil)
 					d.SetId(id)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appconfig_environment")))
 				}
 
 				return !lastPage
@@ -319,7 +319,7 @@ func sweepHostedConfigurationVersions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppConfigConn
+	conn := client.(*conns.AWSClient).AppConfigConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -375,7 +375,7 @@ func sweepHostedConfigurationVersions(region string) error {
 							d := r.Data(nil)
 							d.SetId(id)
 
-							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appconfig_hosted_configuration_version")))
 						}
 
 						return !lastPage
@@ -41,7 +41,7 @@ func sweepDirectoryConfigs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeDirectoryConfigsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -55,7 +55,7 @@ func sweepDirectoryConfigs(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.DirectoryName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appstream_directory_config")))
 		}
 
 		return !lastPage
@@ -84,7 +84,7 @@ func sweepFleets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeFleetsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -98,7 +98,7 @@ func sweepFleets(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appstream_fleet")))
 		}
 
 		return !lastPage
@@ -127,7 +127,7 @@ func sweepImageBuilders(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeImageBuildersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -141,7 +141,7 @@ func sweepImageBuilders(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appstream_image_builder")))
 		}
 
 		return !lastPage
@@ -170,7 +170,7 @@ func sweepStacks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	input := &appstream.DescribeStacksInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -184,7 +184,7 @@ func sweepStacks(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_appstream_stack")))
 		}
 
 		return !lastPage
@@ -145,7 +145,7 @@ func ResourceResolver() *schema.Resource {
 }
 
 func resourceResolverCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.CreateResolverInput{
 		ApiId:     aws.String(d.Get("api_id").(string)),
@@ -203,7 +203,7 @@ func resourceResolverCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceResolverRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, typeName, fieldName, err := DecodeResolverID(d.Id())
 
@@ -256,7 +256,7 @@ func resourceResolverRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceResolverUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.UpdateResolverInput{
 		ApiId:     aws.String(d.Get("api_id").(string)),
@@ -312,7 +312,7 @@ func resourceResolverUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceResolverDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, typeName, fieldName, err := DecodeResolverID(d.Id())
 
@@ -102,7 +102,7 @@ func TestAccAutoScalingAttachment_albTargetGroup(t *testing.T) {
 }
 
 func testAccCheckAutocalingAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_attachment" {
@@ -134,7 +134,7 @@ func testAccCheckAutocalingELBAttachmentExists(asgname string, loadBalancerCount
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
@@ -160,7 +160,7 @@ func testAccCheckAutocalingAlbAttachmentExists(asgname string, targetGroupCount
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
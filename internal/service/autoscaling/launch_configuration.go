@@ -30,6 +30,9 @@ func ResourceLaunchConfiguration() *schema.Resource {
 		Create: resourceLaunchConfigurationCreate,
 		Read:   resourceLaunchConfigurationRead,
 		Delete: resourceLaunchConfigurationDelete,
+
+		CustomizeDiff: verify.NameLengthDiff("name", "name_prefix", 255),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -358,10 +361,10 @@ func ResourceLaunchConfiguration() *schema.Resource {
 }
 
 func resourceLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
-	ec2conn := meta.(*conns.AWSClient).EC2Conn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
+	ec2conn := meta.(*conns.AWSClient).EC2Conn()
 
-	lcName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	lcName := meta.(*conns.AWSClient).NamingConfig.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	createLaunchConfigurationOpts := autoscaling.CreateLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(lcName),
@@ -567,8 +570,8 @@ func resourceLaunchConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
-	ec2conn := meta.(*conns.AWSClient).EC2Conn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
+	ec2conn := meta.(*conns.AWSClient).EC2Conn()
 
 	describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 		LaunchConfigurationNames: []*string{aws.String(d.Id())},
@@ -597,7 +600,7 @@ func resourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("image_id", lc.ImageId)
 	d.Set("instance_type", lc.InstanceType)
 	d.Set("name", lc.LaunchConfigurationName)
-	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(lc.LaunchConfigurationName)))
+	d.Set("name_prefix", meta.(*conns.AWSClient).NamingConfig.NamePrefixFromName(aws.StringValue(lc.LaunchConfigurationName)))
 	d.Set("arn", lc.LaunchConfigurationARN)
 
 	d.Set("iam_instance_profile", lc.IamInstanceProfile)
@@ -634,7 +637,7 @@ func resourceLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn
+	autoscalingconn := meta.(*conns.AWSClient).AutoScalingConn()
 	input := &autoscaling.DeleteLaunchConfigurationInput{
 		LaunchConfigurationName: aws.String(d.Id()),
 	}
@@ -180,7 +180,7 @@ func ResourceSelection() *schema.Resource {
 }
 
 func resourceSelectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	selection := &backup.Selection{
 		Conditions:    expandBackupConditions(d.Get("condition").(*schema.Set).List()),
@@ -237,7 +237,7 @@ func resourceSelectionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	input := &backup.GetBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -334,7 +334,7 @@ func resourceSelectionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSelectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BackupConn
+	conn := meta.(*conns.AWSClient).BackupConn()
 
 	input := &backup.DeleteBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
@@ -49,7 +49,7 @@ func sweepVaultLockConfiguration(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -69,7 +69,7 @@ func sweepVaultLockConfiguration(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_backup_vault_lock_configuration")))
 		}
 
 		return !lastPage
@@ -98,7 +98,7 @@ func sweepVaultNotifications(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -118,7 +118,7 @@ func sweepVaultNotifications(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_backup_vault_notifications")))
 		}
 
 		return !lastPage
@@ -145,7 +145,7 @@ func sweepVaultPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -160,7 +160,7 @@ func sweepVaultPolicies(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(vault.BackupVaultName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_backup_vault_policy")))
 		}
 
 		return !lastPage
@@ -188,7 +188,7 @@ func sweepVaults(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -249,7 +249,7 @@ func sweepVaults(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_backup_vault")))
 		}
 
 		return !lastPage
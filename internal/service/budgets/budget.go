@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -26,6 +25,8 @@ func ResourceBudget() *schema.Resource {
 		Update: resourceBudgetUpdate,
 		Delete: resourceBudgetDelete,
 
+		CustomizeDiff: verify.NameLengthDiff("name", "name_prefix", 100),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -225,14 +226,14 @@ func ResourceBudget() *schema.Resource {
 }
 
 func resourceBudgetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	budget, err := expandBudgetsBudgetUnmarshal(d)
 	if err != nil {
 		return fmt.Errorf("failed unmarshalling budget: %v", err)
 	}
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := meta.(*conns.AWSClient).NamingConfig.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 	budget.BudgetName = aws.String(name)
 
 	accountID := d.Get("account_id").(string)
@@ -264,7 +265,7 @@ func resourceBudgetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
@@ -312,7 +313,7 @@ func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("name", budget.BudgetName)
-	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(budget.BudgetName)))
+	d.Set("name_prefix", meta.(*conns.AWSClient).NamingConfig.NamePrefixFromName(aws.StringValue(budget.BudgetName)))
 
 	if budget.TimePeriod != nil {
 		d.Set("time_period_end", TimePeriodTimestampToString(budget.TimePeriod.End))
@@ -384,7 +385,7 @@ func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, _, err := BudgetParseResourceID(d.Id())
 
@@ -415,7 +416,7 @@ func resourceBudgetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
@@ -441,7 +442,7 @@ func resourceBudgetDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBudgetNotificationsCreate(notifications []*budgets.Notification, subscribers [][]*budgets.Subscriber, budgetName string, accountID string, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	for i, notification := range notifications {
 		subscribers := subscribers[i]
@@ -464,7 +465,7 @@ func resourceBudgetNotificationsCreate(notifications []*budgets.Notification, su
 }
 
 func resourceBudgetNotificationsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BudgetsConn
+	conn := meta.(*conns.AWSClient).BudgetsConn()
 
 	accountID, budgetName, err := BudgetParseResourceID(d.Id())
 
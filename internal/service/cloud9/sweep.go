@@ -26,7 +26,7 @@ func sweepEnvironmentEC2s(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Cloud9Conn
+	conn := client.(*conns.AWSClient).Cloud9Conn()
 	input := &cloud9.ListEnvironmentsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -40,7 +40,7 @@ func sweepEnvironmentEC2s(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_cloud9_environment_ec2")))
 		}
 
 		return !lastPage
@@ -32,7 +32,7 @@ func sweepCloudhsmv2Clusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudHSMV2Conn
+	conn := client.(*conns.AWSClient).CloudHSMV2Conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -49,7 +49,7 @@ func sweepCloudhsmv2Clusters(region string) error {
 			r := ResourceCluster()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(cluster.ClusterId))
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_cloudhsm_v2_cluster")))
 		}
 
 		return !lastPage
@@ -78,7 +78,7 @@ func sweepCloudhsmv2HSMs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudHSMV2Conn
+	conn := client.(*conns.AWSClient).CloudHSMV2Conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -97,7 +97,7 @@ func sweepCloudhsmv2HSMs(region string) error {
 				d := r.Data(nil)
 				d.SetId(aws.StringValue(hsm.HsmId))
 				d.Set("cluster_id", cluster.ClusterId)
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_cloudhsm_v2_hsm")))
 			}
 		}
 
@@ -11,10 +11,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -35,7 +35,10 @@ func ResourceMetricStream() *schema.Resource {
 			Delete: schema.DefaultTimeout(MetricStreamDeleteTimeout),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.NameLengthDiff("name", "name_prefix", 255),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -120,11 +123,11 @@ func ResourceMetricStream() *schema.Resource {
 }
 
 func resourceMetricStreamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	name := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
+	name := meta.(*conns.AWSClient).NamingConfig.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 
 	params := cloudwatch.PutMetricStreamInput{
 		Name:         aws.String(name),
@@ -182,7 +185,7 @@ func resourceMetricStreamCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -207,7 +210,7 @@ func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("firehose_arn", output.FirehoseArn)
 	d.Set("last_update_date", output.CreationDate.Format(time.RFC3339))
 	d.Set("name", output.Name)
-	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("name_prefix", meta.(*conns.AWSClient).NamingConfig.NamePrefixFromName(aws.StringValue(output.Name)))
 	d.Set("output_format", output.OutputFormat)
 	d.Set("role_arn", output.RoleArn)
 	d.Set("state", output.State)
@@ -252,7 +255,7 @@ func resourceMetricStreamRead(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceMetricStreamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting CloudWatch Metric Stream %s", d.Id())
-	conn := meta.(*conns.AWSClient).CloudWatchConn
+	conn := meta.(*conns.AWSClient).CloudWatchConn()
 	params := cloudwatch.DeleteMetricStreamInput{
 		Name: aws.String(d.Id()),
 	}
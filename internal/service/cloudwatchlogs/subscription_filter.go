@@ -64,7 +64,7 @@ func ResourceSubscriptionFilter() *schema.Resource {
 }
 
 func resourceSubscriptionFilterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 	params := getSubscriptionFilterInput(d)
 	log.Printf("[DEBUG] Creating SubscriptionFilter %#v", params)
 
@@ -97,7 +97,7 @@ func resourceSubscriptionFilterCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceSubscriptionFilterUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	params := getSubscriptionFilterInput(d)
 
@@ -155,7 +155,7 @@ func getSubscriptionFilterInput(d *schema.ResourceData) cloudwatchlogs.PutSubscr
 }
 
 func resourceSubscriptionFilterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 
 	log_group_name := d.Get("log_group_name").(string)
 	name := d.Get("name").(string) // "name" is a required field in the schema
@@ -193,7 +193,7 @@ func resourceSubscriptionFilterRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceSubscriptionFilterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudWatchLogsConn
+	conn := meta.(*conns.AWSClient).CloudWatchLogsConn()
 	log.Printf("[INFO] Deleting CloudWatch Log Group Subscription: %s", d.Id())
 	log_group_name := d.Get("log_group_name").(string)
 	name := d.Get("name").(string)
@@ -158,7 +158,7 @@ func testAccCheckCodeCommitApprovalRuleTemplateExists(name string) resource.Test
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn()
 
 		_, err := conn.GetApprovalRuleTemplate(&codecommit.GetApprovalRuleTemplateInput{
 			ApprovalRuleTemplateName: aws.String(rs.Primary.ID),
@@ -173,7 +173,7 @@ func testAccCheckCodeCommitApprovalRuleTemplateExists(name string) resource.Test
 }
 
 func testAccCheckCodeCommitApprovalRuleTemplateDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeCommitConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codecommit_approval_rule_template" {
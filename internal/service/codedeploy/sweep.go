@@ -29,7 +29,7 @@ func sweepApps(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeDeployConn
+	conn := client.(*conns.AWSClient).CodeDeployConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -47,7 +47,7 @@ func sweepApps(region string) error {
 			d.SetId(fmt.Sprintf("%s:%s", "xxxx", appName))
 			d.Set("name", appName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_codedeploy_app")))
 		}
 
 		return !lastPage
@@ -565,7 +565,7 @@ func testAccCheckExists(n string, pipeline *codepipeline.PipelineDeclaration) re
 			return fmt.Errorf("No CodePipeline ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn()
 
 		out, err := conn.GetPipeline(&codepipeline.GetPipelineInput{
 			Name: aws.String(rs.Primary.ID),
@@ -581,7 +581,7 @@ func testAccCheckExists(n string, pipeline *codepipeline.PipelineDeclaration) re
 }
 
 func testAccCheckDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codepipeline" {
@@ -614,7 +614,7 @@ func testAccPreCheckSupported(t *testing.T, regions ...string) {
 		if err != nil {
 			t.Fatalf("error getting AWS client for region %s", region)
 		}
-		conn := client.(*conns.AWSClient).CodePipelineConn
+		conn := client.(*conns.AWSClient).CodePipelineConn()
 
 		input := &codepipeline.ListPipelinesInput{}
 		_, err = conn.ListPipelines(input)
@@ -159,7 +159,7 @@ func testAccCheckConnectionExists(n string, v *codestarconnections.Connection) r
 			return errors.New("No CodeStar connection ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 		resp, err := conn.GetConnection(&codestarconnections.GetConnectionInput{
 			ConnectionArn: aws.String(rs.Primary.ID),
@@ -175,7 +175,7 @@ func testAccCheckConnectionExists(n string, v *codestarconnections.Connection) r
 }
 
 func testAccCheckConnectionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
@@ -72,7 +72,7 @@ func ResourceResourceServer() *schema.Resource {
 }
 
 func resourceResourceServerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	identifier := d.Get("identifier").(string)
 	userPoolID := d.Get("user_pool_id").(string)
@@ -102,7 +102,7 @@ func resourceResourceServerCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceResourceServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	userPoolID, identifier, err := DecodeResourceServerID(d.Id())
 	if err != nil {
@@ -155,7 +155,7 @@ func resourceResourceServerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceResourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	userPoolID, identifier, err := DecodeResourceServerID(d.Id())
 	if err != nil {
@@ -180,7 +180,7 @@ func resourceResourceServerUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceResourceServerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CognitoIDPConn
+	conn := meta.(*conns.AWSClient).CognitoIDPConn()
 
 	userPoolID, identifier, err := DecodeResourceServerID(d.Id())
 	if err != nil {
@@ -72,7 +72,7 @@ package configservice_test
 // }
 
 // func testAccCheckAggregateAuthorizationDestroy(s *terraform.State) error {
-// 	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn
+// 	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
 
 // 	for _, rs := range s.RootModule().Resources {
 // 		if rs.Type != "aws_config_aggregate_authorization" {
@@ -75,7 +75,7 @@ func ResourceBotAssociation() *schema.Resource {
 }
 
 func resourceBotAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId := d.Get("instance_id").(string)
 
@@ -123,7 +123,7 @@ func resourceBotAssociationCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceId, name, region, err := BotV1AssociationParseResourceID(d.Id())
 
@@ -156,7 +156,7 @@ func resourceBotAssociationRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceBotAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).ConnectConn
+	conn := meta.(*conns.AWSClient).ConnectConn()
 
 	instanceID, name, region, err := BotV1AssociationParseResourceID(d.Id())
 
@@ -29,7 +29,7 @@ func sweepInstance(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ConnectConn
+	conn := client.(*conns.AWSClient).ConnectConn()
 	ctx := context.Background()
 	var errs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -54,7 +54,7 @@ func sweepInstance(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_connect_instance")))
 		}
 
 		return !lastPage
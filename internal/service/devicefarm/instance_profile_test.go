@@ -155,7 +155,7 @@ func testAccCheckDeviceFarmInstanceProfileExists(n string, v *devicefarm.Instanc
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 		resp, err := tfdevicefarm.FindInstanceProfileByArn(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -171,7 +171,7 @@ func testAccCheckDeviceFarmInstanceProfileExists(n string, v *devicefarm.Instanc
 }
 
 func testAccCheckDeviceFarmInstanceProfileDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_instance_profile" {
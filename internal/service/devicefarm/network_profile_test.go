@@ -191,7 +191,7 @@ func testAccCheckDeviceFarmNetworkProfileExists(n string, v *devicefarm.NetworkP
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 		resp, err := tfdevicefarm.FindNetworkProfileByArn(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -207,7 +207,7 @@ func testAccCheckDeviceFarmNetworkProfileExists(n string, v *devicefarm.NetworkP
 }
 
 func testAccCheckDeviceFarmNetworkProfileDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeviceFarmConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_devicefarm_network_profile" {
@@ -29,7 +29,7 @@ func sweepProjects(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).DeviceFarmConn
+	conn := client.(*conns.AWSClient).DeviceFarmConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -54,7 +54,7 @@ func sweepProjects(region string) error {
 				continue
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_devicefarm_project")))
 		}
 
 		return !lastPage
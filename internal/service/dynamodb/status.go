@@ -6,8 +6,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func statusDynamoDBKinesisStreamingDestination(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) resource.StateRefreshFunc {
@@ -30,7 +30,7 @@ func statusDynamoDBTable(conn *dynamodb.DynamoDB, tableName string) resource.Sta
 	return func() (interface{}, string, error) {
 		table, err := FindDynamoDBTableByName(conn, tableName)
 
-		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -38,10 +38,6 @@ func statusDynamoDBTable(conn *dynamodb.DynamoDB, tableName string) resource.Sta
 			return nil, "", err
 		}
 
-		if table == nil {
-			return nil, "", nil
-		}
-
 		return table, aws.StringValue(table.TableStatus), nil
 	}
 }
@@ -104,7 +100,7 @@ func statusDynamoDBGSI(conn *dynamodb.DynamoDB, tableName, indexName string) res
 	return func() (interface{}, string, error) {
 		gsi, err := FindDynamoDBGSIByTableNameIndexName(conn, tableName, indexName)
 
-		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -112,10 +108,6 @@ func statusDynamoDBGSI(conn *dynamodb.DynamoDB, tableName, indexName string) res
 			return nil, "", err
 		}
 
-		if gsi == nil {
-			return nil, "", nil
-		}
-
 		return gsi, aws.StringValue(gsi.IndexStatus), nil
 	}
 }
@@ -124,7 +116,7 @@ func statusDynamoDBPITR(conn *dynamodb.DynamoDB, tableName string) resource.Stat
 	return func() (interface{}, string, error) {
 		pitr, err := FindDynamoDBPITRDescriptionByTableName(conn, tableName)
 
-		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -132,10 +124,6 @@ func statusDynamoDBPITR(conn *dynamodb.DynamoDB, tableName string) resource.Stat
 			return nil, "", err
 		}
 
-		if pitr == nil {
-			return nil, "", nil
-		}
-
 		return pitr, aws.StringValue(pitr.PointInTimeRecoveryStatus), nil
 	}
 }
@@ -144,7 +132,7 @@ func statusDynamoDBTTL(conn *dynamodb.DynamoDB, tableName string) resource.State
 	return func() (interface{}, string, error) {
 		ttl, err := FindDynamoDBTTLRDescriptionByTableName(conn, tableName)
 
-		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -152,10 +140,6 @@ func statusDynamoDBTTL(conn *dynamodb.DynamoDB, tableName string) resource.State
 			return nil, "", err
 		}
 
-		if ttl == nil {
-			return nil, "", nil
-		}
-
 		return ttl, aws.StringValue(ttl.TimeToLiveStatus), nil
 	}
 }
@@ -164,7 +148,7 @@ func statusDynamoDBTableSES(conn *dynamodb.DynamoDB, tableName string) resource.
 	return func() (interface{}, string, error) {
 		table, err := FindDynamoDBTableByName(conn, tableName)
 
-		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		if tfresource.NotFound(err) {
 			return nil, "", nil
 		}
 
@@ -172,10 +156,6 @@ func statusDynamoDBTableSES(conn *dynamodb.DynamoDB, tableName string) resource.
 			return nil, "", err
 		}
 
-		if table == nil {
-			return nil, "", nil
-		}
-
 		// Disabling SSE returns null SSEDescription
 		if table.SSEDescription == nil {
 			return table, dynamodb.SSEStatusDisabled, nil
@@ -170,7 +170,7 @@ func testAccCheckAMICopyExists(resourceName string, image *ec2.Image) resource.T
 			return fmt.Errorf("No ID set for %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		input := &ec2.DescribeImagesInput{
 			ImageIds: []*string{aws.String(rs.Primary.ID)},
@@ -191,7 +191,7 @@ func testAccCheckAMICopyExists(resourceName string, image *ec2.Image) resource.T
 }
 
 func testAccCheckAMICopyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ami_copy" {
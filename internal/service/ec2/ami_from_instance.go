@@ -225,7 +225,7 @@ func ResourceAMIFromInstance() *schema.Resource {
 }
 
 func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -234,7 +234,7 @@ func resourceAMIFromInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		InstanceId:        aws.String(d.Get("source_instance_id").(string)),
 		Name:              aws.String(d.Get("name").(string)),
 		NoReboot:          aws.Bool(d.Get("snapshot_without_reboot").(bool)),
-		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeImage),
+		TagSpecifications: TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeImage),
 	}
 
 	res, err := client.CreateImage(req)
@@ -73,7 +73,7 @@ func DataSourceAvailabilityZone() *schema.Resource {
 }
 
 func dataSourceAvailabilityZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeAvailabilityZonesInput{}
 
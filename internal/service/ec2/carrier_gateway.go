@@ -50,12 +50,12 @@ func ResourceCarrierGateway() *schema.Resource {
 }
 
 func resourceCarrierGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &ec2.CreateCarrierGatewayInput{
-		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, "carrier-gateway"),
+		TagSpecifications: TagSpecificationsFromKeyValueTags(tags, "carrier-gateway"),
 		VpcId:             aws.String(d.Get("vpc_id").(string)),
 	}
 
@@ -78,7 +78,7 @@ func resourceCarrierGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -126,7 +126,7 @@ func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCarrierGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -140,7 +140,7 @@ func resourceCarrierGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCarrierGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting EC2 Carrier Gateway (%s)", d.Id())
 	_, err := conn.DeleteCarrierGateway(&ec2.DeleteCarrierGatewayInput{
@@ -55,7 +55,7 @@ func ResourceClientVPNAuthorizationRule() *schema.Resource {
 }
 
 func resourceClientVPNAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	endpointID := d.Get("client_vpn_endpoint_id").(string)
 	targetNetworkCidr := d.Get("target_network_cidr").(string)
@@ -98,7 +98,7 @@ func resourceClientVPNAuthorizationRuleCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceClientVPNAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	result, err := FindClientVPNAuthorizationRule(conn,
 		d.Get("client_vpn_endpoint_id").(string),
@@ -132,7 +132,7 @@ func resourceClientVPNAuthorizationRuleRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceClientVPNAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.RevokeClientVpnIngressInput{
 		ClientVpnEndpointId: aws.String(d.Get("client_vpn_endpoint_id").(string)),
@@ -38,7 +38,7 @@ func DataSourceEBSSnapshotIDs() *schema.Resource {
 }
 
 func dataSourceEBSSnapshotIDsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	restorableUsers, restorableUsersOk := d.GetOk("restorable_by_user_ids")
 	filters, filtersOk := d.GetOk("filter")
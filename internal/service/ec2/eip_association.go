@@ -69,7 +69,7 @@ func ResourceEIPAssociation() *schema.Resource {
 }
 
 func resourceEIPAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	request := &ec2.AssociateAddressInput{}
 
@@ -151,7 +151,7 @@ func resourceEIPAssociationCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	request, err := DescribeAddressesByID(d.Id(), meta.(*conns.AWSClient).SupportedPlatforms)
 	if err != nil {
@@ -202,7 +202,7 @@ func resourceEIPAssociationRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEIPAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	var opts *ec2.DisassociateAddressInput
 	// We assume EC2 Classic if ID is a valid IPv4 address
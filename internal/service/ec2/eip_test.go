@@ -679,7 +679,7 @@ func TestAccEC2EIP_BYOIPAddress_customWithPublicIPv4Pool(t *testing.T) {
 }
 
 func testAccCheckEIPDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_eip" {
@@ -755,10 +755,10 @@ func testAccCheckEIPExists(n string, ec2classic bool, res *ec2.Address) resource
 			return fmt.Errorf("No EIP ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		if ec2classic {
-			conn = acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn
+			conn = acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn()
 		}
 
 		input := &ec2.DescribeAddressesInput{}
@@ -652,7 +652,7 @@ func ResourceInstance() *schema.Resource {
 				_, ok := diff.GetOk("launch_template")
 
 				if diff.Id() != "" && diff.HasChange("launch_template.0.version") && ok {
-					conn := meta.(*conns.AWSClient).EC2Conn
+					conn := meta.(*conns.AWSClient).EC2Conn()
 
 					stateVersion := diff.Get("launch_template.0.version")
 
@@ -721,7 +721,7 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 }
 
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -730,8 +730,8 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error collecting instance settings: %w", err)
 	}
 
-	tagSpecifications := ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
-	tagSpecifications = append(tagSpecifications, ec2TagSpecificationsFromMap(d.Get("volume_tags").(map[string]interface{}), ec2.ResourceTypeVolume)...)
+	tagSpecifications := TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeInstance)
+	tagSpecifications = append(tagSpecifications, TagSpecificationsFromKeyValueTags(tftags.New(d.Get("volume_tags").(map[string]interface{})), ec2.ResourceTypeVolume)...)
 
 	// Build the creation struct
 	runOpts := &ec2.RunInstancesInput{
@@ -892,7 +892,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -1101,7 +1101,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
-		if err := d.Set("volume_tags", KeyValueTags(volumeTags).IgnoreAWS().Map()); err != nil {
+		if err := d.Set("volume_tags", volumeTags.IgnoreAWS().Map()); err != nil {
 			return fmt.Errorf("error setting volume_tags: %s", err)
 		}
 	}
@@ -1201,7 +1201,7 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") && !d.IsNewResource() {
 		o, n := d.GetChange("tags_all")
@@ -1729,7 +1729,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	err := resourceInstanceDisableAPITermination(conn, d.Id(), d.Get("disable_api_termination").(bool))
 
@@ -2409,7 +2409,7 @@ func readBlockDeviceMappingsFromConfig(d *schema.ResourceData, conn *ec2.EC2) ([
 	return blockDevices, nil
 }
 
-func readVolumeTags(conn *ec2.EC2, instanceId string) ([]*ec2.Tag, error) {
+func readVolumeTags(conn *ec2.EC2, instanceId string) (tftags.KeyValueTags, error) {
 	volumeIds, err := getInstanceVolumeIDs(conn, instanceId)
 	if err != nil {
 		return nil, err
@@ -2424,7 +2424,7 @@ func readVolumeTags(conn *ec2.EC2, instanceId string) ([]*ec2.Tag, error) {
 		return nil, fmt.Errorf("error getting tags for volumes (%s): %s", volumeIds, err)
 	}
 
-	return ec2TagsFromTagDescriptions(resp.Tags), nil
+	return KeyValueTags(resp.Tags), nil
 }
 
 // Determine whether we're referring to security groups with
@@ -2575,7 +2575,7 @@ type awsInstanceOpts struct {
 }
 
 func buildInstanceOpts(d *schema.ResourceData, meta interface{}) (*awsInstanceOpts, error) {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	opts := &awsInstanceOpts{
 		DisableAPITermination: aws.Bool(d.Get("disable_api_termination").(bool)),
@@ -3178,29 +3178,29 @@ func getInstanceLaunchTemplate(conn *ec2.EC2, d *schema.ResourceData) ([]map[str
 func getInstanceLaunchTemplateID(conn *ec2.EC2, instanceId string) (string, error) {
 	idTag := "aws:ec2launchtemplate:id"
 
-	launchTemplateId, err := getInstanceTagValue(conn, instanceId, idTag)
+	launchTemplateId, err := GetTag(conn, instanceId, idTag)
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
 	if err != nil {
 		return "", fmt.Errorf("error reading Instance Launch Template Id Tag: %s", err)
 	}
-	if launchTemplateId == nil {
-		return "", nil
-	}
 
-	return *launchTemplateId, nil
+	return aws.StringValue(launchTemplateId), nil
 }
 
 func getInstanceLaunchTemplateVersion(conn *ec2.EC2, instanceId string) (string, error) {
 	versionTag := "aws:ec2launchtemplate:version"
 
-	launchTemplateVersion, err := getInstanceTagValue(conn, instanceId, versionTag)
+	launchTemplateVersion, err := GetTag(conn, instanceId, versionTag)
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
 	if err != nil {
 		return "", fmt.Errorf("error reading Instance Launch Template Version Tag: %s", err)
 	}
-	if launchTemplateVersion == nil {
-		return "", nil
-	}
 
-	return *launchTemplateVersion, nil
+	return aws.StringValue(launchTemplateVersion), nil
 }
 
 // getLaunchTemplateSpecification takes conn and template id
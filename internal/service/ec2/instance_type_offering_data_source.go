@@ -39,7 +39,7 @@ func DataSourceInstanceTypeOffering() *schema.Resource {
 }
 
 func dataSourceInstanceTypeOfferingRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeInstanceTypeOfferingsInput{}
 
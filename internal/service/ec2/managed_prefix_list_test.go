@@ -303,7 +303,7 @@ func TestAccEC2ManagedPrefixList_tags(t *testing.T) {
 }
 
 func testAccCheckManagedPrefixListDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_managed_prefix_list" {
@@ -337,7 +337,7 @@ func testAccManagedPrefixListExists(resourceName string) resource.TestCheckFunc
 			return fmt.Errorf("No EC2 Managed Prefix List ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		_, err := tfec2.FindManagedPrefixListByID(conn, rs.Primary.ID)
 
@@ -350,7 +350,7 @@ func testAccManagedPrefixListExists(resourceName string) resource.TestCheckFunc
 }
 
 func testAccPreCheckEc2ManagedPrefixList(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeManagedPrefixListsInput{}
 
@@ -152,7 +152,7 @@ func ResourceSecurityGroupRule() *schema.Resource {
 }
 
 func resourceSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	sg_id := d.Get("security_group_id").(string)
 
 	conns.GlobalMutexKV.Lock(sg_id)
@@ -279,7 +279,7 @@ information and instructions for recovery. Error: %w`, sg_id, autherr)
 }
 
 func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	sg_id := d.Get("security_group_id").(string)
 	sg, err := FindSecurityGroupByID(conn, sg_id)
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -341,7 +341,7 @@ func resourceSecurityGroupRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("description") {
 		if err := resourceSecurityGroupRuleDescriptionUpdate(conn, d); err != nil {
@@ -353,7 +353,7 @@ func resourceSecurityGroupRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceSecurityGroupRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	sg_id := d.Get("security_group_id").(string)
 
 	conns.GlobalMutexKV.Lock(sg_id)
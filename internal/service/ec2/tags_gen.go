@@ -3,9 +3,12 @@ package ec2
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -57,13 +60,23 @@ func ListTags(conn *ec2.EC2, identifier string) (tftags.KeyValueTags, error) {
 		},
 	}
 
-	output, err := conn.DescribeTags(input)
+	tags := tftags.New(nil)
+
+	err := conn.DescribeTagsPages(input, func(page *ec2.DescribeTagsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		tags = tags.Merge(KeyValueTags(page.Tags))
+
+		return !lastPage
+	})
 
 	if err != nil {
 		return tftags.New(nil), err
 	}
 
-	return KeyValueTags(output.Tags), nil
+	return tags, nil
 }
 
 // []*SERVICE.Tag handling
@@ -147,3 +160,47 @@ func UpdateTags(conn *ec2.EC2, identifier string, oldTagsMap interface{}, newTag
 
 	return nil
 }
+
+// CreateTags creates ec2 service tags for new resources.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func CreateTags(conn *ec2.EC2, identifier string, tagsMap interface{}) error {
+	tags := tftags.New(tagsMap)
+	input := &ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{identifier}),
+		Tags:      Tags(tags.IgnoreAWS()),
+	}
+
+	_, err := tfresource.RetryWhenNotFound(5*time.Minute, func() (interface{}, error) {
+		output, err := conn.CreateTags(input)
+
+		if tfawserr.ErrCodeContains(err, ".NotFound") {
+			err = &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		return output, err
+	})
+
+	if err != nil {
+		return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+	}
+
+	return nil
+}
+
+// TagSpecificationsFromKeyValueTags returns the tag specifications for the given tags and resource type.
+func TagSpecificationsFromKeyValueTags(tags tftags.KeyValueTags, resourceType string) []*ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(resourceType),
+			Tags:         Tags(tags.IgnoreAWS()),
+		},
+	}
+}
@@ -170,7 +170,7 @@ func testAccCheckTrafficMirrorTargetExists(name string, target *ec2.TrafficMirro
 			return fmt.Errorf("No ID set for %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		out, err := conn.DescribeTrafficMirrorTargets(&ec2.DescribeTrafficMirrorTargetsInput{
 			TrafficMirrorTargetIds: []*string{
 				aws.String(rs.Primary.ID),
@@ -333,7 +333,7 @@ resource "aws_ec2_traffic_mirror_target" "test" {
 }
 
 func testAccPreCheckTrafficMirrorTarget(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	_, err := conn.DescribeTrafficMirrorTargets(&ec2.DescribeTrafficMirrorTargetsInput{})
 
@@ -347,7 +347,7 @@ func testAccPreCheckTrafficMirrorTarget(t *testing.T) {
 }
 
 func testAccCheckTrafficMirrorTargetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_traffic_mirror_target" {
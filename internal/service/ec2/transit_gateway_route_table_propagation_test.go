@@ -62,7 +62,7 @@ func testAccCheckTransitGatewayRouteTablePropagationExists(resourceName string,
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		propagation, err := tfec2.FindTransitGatewayRouteTablePropagation(conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
 
@@ -85,7 +85,7 @@ func testAccCheckTransitGatewayRouteTablePropagationExists(resourceName string,
 }
 
 func testAccCheckTransitGatewayRouteTablePropagationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table_propagation" {
@@ -151,7 +151,7 @@ func testAccCheckTransitGatewayRouteTableExists(resourceName string, transitGate
 			return fmt.Errorf("No EC2 Transit Gateway Route Table ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		routeTable, err := tfec2.DescribeTransitGatewayRouteTable(conn, rs.Primary.ID)
 
@@ -174,7 +174,7 @@ func testAccCheckTransitGatewayRouteTableExists(resourceName string, transitGate
 }
 
 func testAccCheckTransitGatewayRouteTableDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ec2_transit_gateway_route_table" {
@@ -165,7 +165,7 @@ func TestAccVPCIpam_ByoipIPv6(t *testing.T) {
 }
 
 func testAccCheckVPCIPv6CIDRBlockAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_ipv6_cidr_block_association" {
@@ -213,7 +213,7 @@ func testAccCheckVPCIPv6CIDRBlockAssociationExists(n string, association *ec2.Vp
 			return fmt.Errorf("No VPC ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		DescribeVpcOpts := &ec2.DescribeVpcsInput{
 			VpcIds: []*string{aws.String(rs.Primary.Attributes["vpc_id"])},
 		}
@@ -39,7 +39,7 @@ func ResourceVPCDHCPOptionsAssociation() *schema.Resource {
 }
 
 func resourceVPCDHCPOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	// Provide the vpc_id as the id to import
 	vpcRaw, _, err := VPCStateRefreshFunc(conn, d.Id())()
 	if err != nil {
@@ -60,7 +60,7 @@ func resourceVPCDHCPOptionsAssociationImport(d *schema.ResourceData, meta interf
 }
 
 func resourceVPCDHCPOptionsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	vpcId := d.Get("vpc_id").(string)
 	optsID := d.Get("dhcp_options_id").(string)
@@ -83,7 +83,7 @@ func resourceVPCDHCPOptionsAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceVPCDHCPOptionsAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	var vpc *ec2.Vpc
 
@@ -143,7 +143,7 @@ const VPCDefaultOptionsID = "default"
 // AWS does not provide an API to disassociate a DHCP Options set from a VPC.
 // So, we do this by setting the VPC to the default DHCP Options Set.
 func resourceVPCDHCPOptionsAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Disassociating DHCP Options Set %s from VPC %s...", d.Get("dhcp_options_id"), d.Get("vpc_id"))
 
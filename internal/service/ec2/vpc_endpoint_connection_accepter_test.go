@@ -46,7 +46,7 @@ func TestAccEC2VPCEndpointConnectionAccepter_crossAccount(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointConnectionAccepterDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_connection_accepter" {
@@ -55,7 +55,7 @@ const (
 )
 
 func resourceVPCIpamOrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	adminAccountID := d.Get("delegated_admin_account_id").(string)
 
@@ -78,7 +78,7 @@ func resourceVPCIpamOrganizationAdminAccountCreate(d *schema.ResourceData, meta
 }
 
 func resourceVPCIpamOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
-	org_conn := meta.(*conns.AWSClient).OrganizationsConn
+	org_conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	input := &organizations.ListDelegatedAdministratorsInput{
 		ServicePrincipal: aws.String(Ipam_service_principal),
@@ -108,7 +108,7 @@ func resourceVPCIpamOrganizationAdminAccountRead(d *schema.ResourceData, meta in
 }
 
 func resourceVPCIpamOrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DisableIpamOrganizationAdminAccountInput{
 		DelegatedAdminAccountId: aws.String(d.Id()),
@@ -123,7 +123,7 @@ const (
 )
 
 func ResourceVPCIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -131,7 +131,7 @@ func ResourceVPCIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
 		AddressFamily:     aws.String(d.Get("address_family").(string)),
 		ClientToken:       aws.String(resource.UniqueId()),
 		IpamScopeId:       aws.String(d.Get("ipam_scope_id").(string)),
-		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, "ipam-pool"),
+		TagSpecifications: TagSpecificationsFromKeyValueTags(tags, "ipam-pool"),
 	}
 
 	if v := d.Get("publicly_advertisable"); v != "" && d.Get("address_family") == ec2.AddressFamilyIpv6 {
@@ -190,7 +190,7 @@ func ResourceVPCIpamPoolCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -236,7 +236,7 @@ func ResourceVPCIpamPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -298,7 +298,7 @@ func ResourceVPCIpamPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting IPAM Pool: %s", d.Id())
 	_, err := conn.DeleteIpamPool(&ec2.DeleteIpamPoolInput{
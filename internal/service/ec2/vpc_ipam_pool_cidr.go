@@ -73,7 +73,7 @@ const (
 )
 
 func resourceVPCIpamPoolCidrCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	pool_id := d.Get("ipam_pool_id").(string)
 
 	input := &ec2.ProvisionIpamPoolCidrInput{
@@ -106,7 +106,7 @@ func resourceVPCIpamPoolCidrCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceVPCIpamPoolCidrRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	cidr, pool_id, err := FindIpamPoolCidr(conn, d.Id())
 
 	if err != nil {
@@ -133,7 +133,7 @@ func resourceVPCIpamPoolCidrRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceVPCIpamPoolCidrDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	cidr, pool_id, err := DecodeIpamPoolCidrID(d.Id())
 
 	input := &ec2.DeprovisionIpamPoolCidrInput{
@@ -91,7 +91,7 @@ const (
 )
 
 func resourceVPCIpamPoolCidrAllocationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	pool_id := d.Get("ipam_pool_id").(string)
 
 	input := &ec2.AllocateIpamPoolCidrInput{
@@ -126,7 +126,7 @@ func resourceVPCIpamPoolCidrAllocationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceVPCIpamPoolCidrAllocationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidr_allocation, pool_id, err := FindIpamPoolCidrAllocation(conn, d.Id())
 
@@ -159,7 +159,7 @@ func resourceVPCIpamPoolCidrAllocationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceVPCIpamPoolCidrAllocationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.ReleaseIpamPoolAllocationInput{
 		IpamPoolAllocationId: aws.String(d.Get("ipam_pool_allocation_id").(string)),
@@ -74,14 +74,14 @@ const (
 )
 
 func ResourceVPCIpamScopeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &ec2.CreateIpamScopeInput{
 		ClientToken:       aws.String(resource.UniqueId()),
 		IpamId:            aws.String(d.Get("ipam_id").(string)),
-		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, "ipam-scope"),
+		TagSpecifications: TagSpecificationsFromKeyValueTags(tags, "ipam-scope"),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -104,7 +104,7 @@ func ResourceVPCIpamScopeCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func ResourceVPCIpamScopeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -144,7 +144,7 @@ func ResourceVPCIpamScopeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ResourceVPCIpamScopeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -173,7 +173,7 @@ func ResourceVPCIpamScopeUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func ResourceVPCIpamScopeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[DEBUG] Deleting IPAM Scope: %s", d.Id())
 	_, err := conn.DeleteIpamScope(&ec2.DeleteIpamScopeInput{
@@ -41,7 +41,7 @@ func sweepAccessPoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EFSConn
+	conn := client.(*conns.AWSClient).EFSConn()
 	var sweeperErrs *multierror.Error
 
 	var errors error
@@ -102,7 +102,7 @@ func sweepFileSystems(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EFSConn
+	conn := client.(*conns.AWSClient).EFSConn()
 	var sweeperErrs *multierror.Error
 
 	input := &efs.DescribeFileSystemsInput{}
@@ -137,7 +137,7 @@ func sweepMountTargets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EFSConn
+	conn := client.(*conns.AWSClient).EFSConn()
 
 	var errors error
 	input := &efs.DescribeFileSystemsInput{}
@@ -54,7 +54,7 @@ func sweepAddon(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	ctx := context.TODO()
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -79,7 +79,7 @@ func sweepAddon(region string) error {
 					d := r.Data(nil)
 					d.SetId(AddonCreateResourceID(aws.StringValue(cluster), aws.StringValue(addon)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_eks_addon")))
 				}
 
 				return !lastPage
@@ -120,7 +120,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -134,7 +134,7 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(cluster))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_eks_cluster")))
 		}
 
 		return !lastPage
@@ -163,7 +163,7 @@ func sweepFargateProfiles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -188,7 +188,7 @@ func sweepFargateProfiles(region string) error {
 					d := r.Data(nil)
 					d.SetId(FargateProfileCreateResourceID(aws.StringValue(cluster), aws.StringValue(profile)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_eks_fargate_profile")))
 				}
 
 				return !lastPage
@@ -230,7 +230,7 @@ func sweepIdentityProvidersConfig(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	ctx := context.TODO()
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -255,7 +255,7 @@ func sweepIdentityProvidersConfig(region string) error {
 					d := r.Data(nil)
 					d.SetId(IdentityProviderConfigCreateResourceID(aws.StringValue(cluster), aws.StringValue(identityProviderConfig.Name)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_eks_identity_provider_config")))
 				}
 
 				return !lastPage
@@ -296,7 +296,7 @@ func sweepNodeGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EKSConn
+	conn := client.(*conns.AWSClient).EKSConn()
 	input := &eks.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -321,7 +321,7 @@ func sweepNodeGroups(region string) error {
 					d := r.Data(nil)
 					d.SetId(NodeGroupCreateResourceID(aws.StringValue(cluster), aws.StringValue(nodeGroup)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_eks_node_group")))
 				}
 
 				return !lastPage
@@ -16,6 +16,12 @@ const (
 	addonDeletedTimeout = 40 * time.Minute
 )
 
+// clusterBackoff spaces out the refreshes of clusters, which take many minutes to create and delete.
+var clusterBackoff = tfresource.Backoff{
+	Initial: 10 * time.Second,
+	Max:     1 * time.Minute,
+}
+
 func waitAddonCreated(ctx context.Context, conn *eks.EKS, clusterName, addonName string) (*eks.Addon, error) {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.AddonStatusCreating, eks.AddonStatusDegraded},
@@ -87,7 +93,7 @@ func waitClusterCreated(conn *eks.EKS, name string, timeout time.Duration) (*eks
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, clusterBackoff)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -104,7 +110,7 @@ func waitClusterDeleted(conn *eks.EKS, name string, timeout time.Duration) (*eks
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, clusterBackoff)
 
 	if output, ok := outputRaw.(*eks.Cluster); ok {
 		return output, err
@@ -57,7 +57,7 @@ func testAccCheckEKSClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSConn()
 
 		_, err := tfeks.FindClusterByName(conn, rs.Primary.ID)
 
@@ -29,7 +29,7 @@ func sweepDomains(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ElasticsearchConn
+	conn := client.(*conns.AWSClient).ElasticsearchConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -84,7 +84,7 @@ func sweepDomains(region string) error {
 		d.SetId(name)
 		d.Set("domain_name", name)
 
-		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_elasticsearch_domain")))
 	}
 
 	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
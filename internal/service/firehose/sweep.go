@@ -26,7 +26,7 @@ func sweepDeliveryStreams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).FirehoseConn
+	conn := client.(*conns.AWSClient).FirehoseConn()
 	input := &firehose.ListDeliveryStreamsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -48,7 +48,7 @@ func sweepDeliveryStreams(region string) error {
 			d.SetId("???")
 			d.Set("name", sn)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_kinesis_firehose_delivery_stream")))
 		}
 
 		if !aws.BoolValue(page.HasMoreDeliveryStreams) {
@@ -611,7 +611,7 @@ func testAccCheckFsxOpenzfsFileSystemExists(resourceName string, fs *fsx.FileSys
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 		filesystem, err := tffsx.FindFileSystemByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -649,7 +649,7 @@ func testAccCheckFsxOpenzfsFileSystemRecreated(i, j *fsx.FileSystem) resource.Te
 }
 
 func testAccCheckFsxOpenzfsFileSystemDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_openzfs_file_system" {
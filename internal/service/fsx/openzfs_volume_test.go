@@ -435,7 +435,7 @@ func testAccCheckFsxOpenzfsVolumeExists(resourceName string, volume *fsx.Volume)
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 		volume1, err := tffsx.FindVolumeByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -453,7 +453,7 @@ func testAccCheckFsxOpenzfsVolumeExists(resourceName string, volume *fsx.Volume)
 }
 
 func testAccCheckFsxOpenzfsVolumeDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FSxConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fsx_openzfs_volume" {
@@ -49,7 +49,7 @@ func sweepAliases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	err = listAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
 		if len(resp.Aliases) == 0 {
@@ -87,7 +87,7 @@ func sweepBuilds(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
 	if err != nil {
@@ -124,7 +124,7 @@ func sweepFleets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	return listFleets(conn, nil, region, func(fleetIds []*string) error {
 		if len(fleetIds) == 0 {
@@ -176,7 +176,7 @@ func sweepGameSessionQueue(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GameLiftConn
+	conn := client.(*conns.AWSClient).GameLiftConn()
 
 	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{})
 
@@ -56,7 +56,7 @@ func ResourcePolicyAttachment() *schema.Resource {
 }
 
 func resourcePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
@@ -86,7 +86,7 @@ func resourcePolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	arn := d.Get("policy_arn").(string)
 	name := d.Get("name").(string)
 
@@ -141,7 +141,7 @@ func resourcePolicyAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 func resourcePolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	name := d.Get("name").(string)
 	var userErr, roleErr, groupErr error
 
@@ -161,7 +161,7 @@ func resourcePolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourcePolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	name := d.Get("name").(string)
 	arn := d.Get("policy_arn").(string)
 	users := flex.ExpandStringSet(d.Get("users").(*schema.Set))
@@ -45,7 +45,7 @@ func DataSourceSessionContext() *schema.Resource {
 }
 
 func dataSourceSessionContextRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	arn := d.Get("arn").(string)
 
@@ -52,7 +52,7 @@ func sweepComponents(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -132,7 +132,7 @@ func sweepDistributionConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -184,7 +184,7 @@ func sweepImagePipelines(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -236,7 +236,7 @@ func sweepImageRecipes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -292,7 +292,7 @@ func sweepImages(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -335,7 +335,7 @@ func sweepImages(region string) error {
 					d := r.Data(nil)
 					d.SetId(imageBuildVersionArn)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_imagebuilder_image")))
 				}
 
 				return !lastPage
@@ -370,7 +370,7 @@ func sweepInfrastructureConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ImageBuilderConn
+	conn := client.(*conns.AWSClient).ImageBuilderConn()
 
 	var sweeperErrs *multierror.Error
 
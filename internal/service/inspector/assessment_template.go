@@ -60,7 +60,7 @@ func ResourceAssessmentTemplate() *schema.Resource {
 }
 
 func resourceAssessmentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -80,7 +80,7 @@ func resourceAssessmentTemplateCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(aws.StringValue(resp.AssessmentTemplateArn))
 
 	if len(tags) > 0 {
-		if err := updateTags(conn, d.Id(), nil, tags); err != nil {
+		if err := UpdateTags(conn, d.Id(), nil, tags); err != nil {
 			return fmt.Errorf("error adding Inspector assessment template (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -89,7 +89,7 @@ func resourceAssessmentTemplateCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -139,12 +139,12 @@ func resourceAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAssessmentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := updateTags(conn, d.Id(), o, n); err != nil {
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating Inspector assessment template (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -153,7 +153,7 @@ func resourceAssessmentTemplateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAssessmentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 
 	_, err := conn.DeleteAssessmentTemplate(&inspector.DeleteAssessmentTemplateInput{
 		AssessmentTemplateArn: aws.String(d.Id()),
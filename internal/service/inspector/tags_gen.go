@@ -2,6 +2,8 @@
 package inspector
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -52,3 +54,38 @@ func KeyValueTags(tags []*inspector.Tag) tftags.KeyValueTags {
 
 	return tftags.New(m)
 }
+
+// UpdateTags updates inspector service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func UpdateTags(conn *inspector.Inspector, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	tags := newTags
+
+	if len(tags) > 0 {
+		input := &inspector.SetTagsForResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        Tags(tags.IgnoreAWS()),
+		}
+
+		_, err := conn.SetTagsForResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s): %w", identifier, err)
+		}
+	} else if len(oldTags) > 0 {
+		input := &inspector.SetTagsForResourceInput{
+			ResourceArn: aws.String(identifier),
+		}
+
+		_, err := conn.SetTagsForResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
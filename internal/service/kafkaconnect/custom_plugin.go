@@ -98,7 +98,7 @@ func ResourceCustomPlugin() *schema.Resource {
 }
 
 func resourceCustomPluginCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConnectConn
+	conn := meta.(*conns.AWSClient).KafkaConnectConn()
 
 	name := d.Get("name").(string)
 	input := &kafkaconnect.CreateCustomPluginInput{
@@ -130,7 +130,7 @@ func resourceCustomPluginCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCustomPluginRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KafkaConnectConn
+	conn := meta.(*conns.AWSClient).KafkaConnectConn()
 
 	plugin, err := FindCustomPluginByARN(conn, d.Id())
 
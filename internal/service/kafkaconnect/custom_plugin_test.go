@@ -143,7 +143,7 @@ func testAccCheckCustomPluginExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No MSK Custom Plugin ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn()
 
 		_, err := tfkafkaconnect.FindCustomPluginByARN(conn, rs.Primary.ID)
 
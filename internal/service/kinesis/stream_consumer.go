@@ -50,7 +50,7 @@ func ResourceStreamConsumer() *schema.Resource {
 }
 
 func resourceStreamConsumerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	name := d.Get("name").(string)
 	input := &kinesis.RegisterStreamConsumerInput{
@@ -75,7 +75,7 @@ func resourceStreamConsumerCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	consumer, err := FindStreamConsumerByARN(conn, d.Id())
 
@@ -98,7 +98,7 @@ func resourceStreamConsumerRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceStreamConsumerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	log.Printf("[DEBUG] Deregistering Kinesis Stream Consumer: (%s)", d.Id())
 	_, err := conn.DeregisterStreamConsumer(&kinesis.DeregisterStreamConsumerInput{
@@ -120,31 +120,6 @@ func resourceStreamConsumerDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func FindStreamConsumerByARN(conn *kinesis.Kinesis, arn string) (*kinesis.ConsumerDescription, error) {
-	input := &kinesis.DescribeStreamConsumerInput{
-		ConsumerARN: aws.String(arn),
-	}
-
-	output, err := conn.DescribeStreamConsumer(input)
-
-	if tfawserr.ErrCodeEquals(err, kinesis.ErrCodeResourceNotFoundException) {
-		return nil, &resource.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || output.ConsumerDescription == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	return output.ConsumerDescription, nil
-}
-
 func statusStreamConsumer(conn *kinesis.Kinesis, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindStreamConsumerByARN(conn, arn)
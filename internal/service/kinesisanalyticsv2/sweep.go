@@ -28,7 +28,7 @@ func sweepApplication(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).KinesisAnalyticsV2Conn
+	conn := client.(*conns.AWSClient).KinesisAnalyticsV2Conn()
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -41,7 +41,7 @@ func TestAccKMSSecretsDataSource_basic(t *testing.T) {
 
 func testAccSecretsEncryptDataSource(key *kms.KeyMetadata, plaintext string, encryptedPayload *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
 
 		input := &kms.EncryptInput{
 			KeyId:     key.Arn,
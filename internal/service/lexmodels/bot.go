@@ -217,7 +217,7 @@ var validateLexBotVersion = validation.All(
 )
 
 func resourceBotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	name := d.Get("name").(string)
 	input := &lexmodelbuildingservice.PutBotInput{
@@ -248,7 +248,7 @@ func resourceBotCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var output *lexmodelbuildingservice.PutBotOutput
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenErrorCategory(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		var err error
 
 		if output != nil {
@@ -257,7 +257,7 @@ func resourceBotCreate(d *schema.ResourceData, meta interface{}) error {
 		output, err = conn.PutBot(input)
 
 		return output, err
-	}, lexmodelbuildingservice.ErrCodeConflictException)
+	}, tfresource.ErrorCategoryConflict)
 
 	if err != nil {
 		return fmt.Errorf("error creating Lex Bot (%s): %w", name, err)
@@ -273,7 +273,7 @@ func resourceBotCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	output, err := FindBotVersionByName(conn, d.Id(), BotVersionLatest)
 
@@ -343,7 +343,7 @@ func resourceBotRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	input := &lexmodelbuildingservice.PutBotInput{
 		Checksum:                     aws.String(d.Get("checksum").(string)),
@@ -372,9 +372,9 @@ func resourceBotUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.VoiceId = aws.String(v.(string))
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenErrorCategory(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
 		return conn.PutBot(input)
-	}, lexmodelbuildingservice.ErrCodeConflictException)
+	}, tfresource.ErrorCategoryConflict)
 
 	if err != nil {
 		return fmt.Errorf("error updating Lex Bot (%s): %w", d.Id(), err)
@@ -388,16 +388,16 @@ func resourceBotUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceBotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	input := &lexmodelbuildingservice.DeleteBotInput{
 		Name: aws.String(d.Id()),
 	}
 
 	log.Printf("[DEBUG] Deleting Lex Bot: (%s)", d.Id())
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenErrorCategory(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
 		return conn.DeleteBot(input)
-	}, lexmodelbuildingservice.ErrCodeConflictException)
+	}, tfresource.ErrorCategoryConflict)
 
 	if tfawserr.ErrCodeEquals(err, lexmodelbuildingservice.ErrCodeNotFoundException) {
 		return nil
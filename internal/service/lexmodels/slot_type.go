@@ -134,7 +134,7 @@ func hasSlotTypeConfigChanges(d verify.ResourceDiffer) bool {
 }
 
 func resourceSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	name := d.Get("name").(string)
 	input := &lexmodelbuildingservice.PutSlotTypeInput{
@@ -149,7 +149,7 @@ func resourceSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var output *lexmodelbuildingservice.PutSlotTypeOutput
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenErrorCategory(d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		var err error
 
 		if output != nil {
@@ -158,7 +158,7 @@ func resourceSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
 		output, err = conn.PutSlotType(input)
 
 		return output, err
-	}, lexmodelbuildingservice.ErrCodeConflictException)
+	}, tfresource.ErrorCategoryConflict)
 
 	if err != nil {
 		return fmt.Errorf("error creating Lex Slot Type (%s): %w", name, err)
@@ -170,7 +170,7 @@ func resourceSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	output, err := FindSlotTypeVersionByName(conn, d.Id(), SlotTypeVersionLatest)
 
@@ -207,7 +207,7 @@ func resourceSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	input := &lexmodelbuildingservice.PutSlotTypeInput{
 		Checksum:               aws.String(d.Get("checksum").(string)),
@@ -221,9 +221,9 @@ func resourceSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.EnumerationValues = expandLexEnumerationValues(v.(*schema.Set).List())
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenErrorCategory(d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
 		return conn.PutSlotType(input)
-	}, lexmodelbuildingservice.ErrCodeConflictException)
+	}, tfresource.ErrorCategoryConflict)
 
 	if err != nil {
 		return fmt.Errorf("error updating Lex Slot Type (%s): %w", d.Id(), err)
@@ -233,16 +233,16 @@ func resourceSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelsConn
+	conn := meta.(*conns.AWSClient).LexModelsConn()
 
 	input := &lexmodelbuildingservice.DeleteSlotTypeInput{
 		Name: aws.String(d.Id()),
 	}
 
 	log.Printf("[DEBUG] Deleting Lex Slot Type: (%s)", d.Id())
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenErrorCategory(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
 		return conn.DeleteSlotType(input)
-	}, lexmodelbuildingservice.ErrCodeConflictException)
+	}, tfresource.ErrorCategoryConflict)
 
 	if tfawserr.ErrCodeEquals(err, lexmodelbuildingservice.ErrCodeNotFoundException) {
 		return nil
@@ -47,7 +47,7 @@ func sweepBotAliases(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -76,7 +76,7 @@ func sweepBotAliases(region string) error {
 					d.Set("bot_name", bot.Name)
 					d.Set("name", botAlias.Name)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_lex_bot_alias")))
 				}
 
 				return !lastPage
@@ -91,7 +91,7 @@ func sweepBotAliases(region string) error {
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_lex_bot_alias")))
 		}
 
 		return !lastPage
@@ -120,7 +120,7 @@ func sweepBots(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -137,7 +137,7 @@ func sweepBots(region string) error {
 
 			d.SetId(aws.StringValue(bot.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_lex_bot")))
 		}
 
 		return !lastPage
@@ -166,7 +166,7 @@ func sweepIntents(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -183,7 +183,7 @@ func sweepIntents(region string) error {
 
 			d.SetId(aws.StringValue(intent.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_lex_intent")))
 		}
 
 		return !lastPage
@@ -212,7 +212,7 @@ func sweepSlotTypes(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).LexModelsConn
+	conn := client.(*conns.AWSClient).LexModelsConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -229,7 +229,7 @@ func sweepSlotTypes(region string) error {
 
 			d.SetId(aws.StringValue(slotType.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_lex_slot_type")))
 		}
 
 		return !lastPage
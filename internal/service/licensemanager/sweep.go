@@ -26,7 +26,7 @@ func sweepLicenseConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).LicenseManagerConn
+	conn := client.(*conns.AWSClient).LicenseManagerConn()
 
 	resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{})
 
@@ -5,7 +5,6 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -248,39 +247,29 @@ func resourceQueueDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func GetAccountClient(awsClient *conns.AWSClient) (*mediaconvert.MediaConvert, error) {
-	const mutexKey = `mediaconvertaccountconn`
-	conns.GlobalMutexKV.Lock(mutexKey)
-	defer conns.GlobalMutexKV.Unlock(mutexKey)
-
-	if awsClient.MediaConvertAccountConn != nil {
-		return awsClient.MediaConvertAccountConn, nil
-	}
-
-	input := &mediaconvert.DescribeEndpointsInput{
-		Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
-	}
-
-	output, err := awsClient.MediaConvertConn.DescribeEndpoints(input)
+	return awsClient.MediaConvertAccountConn(func(awsClient *conns.AWSClient) (*mediaconvert.MediaConvert, error) {
+		input := &mediaconvert.DescribeEndpointsInput{
+			Mode: aws.String(mediaconvert.DescribeEndpointsModeDefault),
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("error describing MediaConvert Endpoints: %w", err)
-	}
+		output, err := awsClient.MediaConvertConn().DescribeEndpoints(input)
 
-	if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || output.Endpoints[0].Url == nil {
-		return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response or URL")
-	}
-
-	endpointURL := aws.StringValue(output.Endpoints[0].Url)
+		if err != nil {
+			return nil, fmt.Errorf("error describing MediaConvert Endpoints: %w", err)
+		}
 
-	sess, err := session.NewSession(&awsClient.MediaConvertConn.Config)
+		if output == nil || len(output.Endpoints) == 0 || output.Endpoints[0] == nil || output.Endpoints[0].Url == nil {
+			return nil, fmt.Errorf("error describing MediaConvert Endpoints: empty response or URL")
+		}
 
-	if err != nil {
-		return nil, fmt.Errorf("error creating AWS MediaConvert session: %w", err)
-	}
+		endpointURL := aws.StringValue(output.Endpoints[0].Url)
 
-	conn := mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(endpointURL)}))
+		sess, err := awsClient.NewSessionForRegion(&awsClient.MediaConvertConn().Config, awsClient.Region)
 
-	awsClient.MediaConvertAccountConn = conn
+		if err != nil {
+			return nil, fmt.Errorf("error creating AWS MediaConvert session: %w", err)
+		}
 
-	return conn, nil
+		return mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(endpointURL)})), nil
+	})
 }
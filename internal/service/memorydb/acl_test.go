@@ -288,7 +288,7 @@ func TestAccMemoryDBACL_update_userNames(t *testing.T) {
 }
 
 func testAccCheckACLDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_memorydb_acl" {
@@ -322,7 +322,7 @@ func testAccCheckACLExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No MemoryDB ACL ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn()
 
 		_, err := tfmemorydb.FindACLByName(context.Background(), conn, rs.Primary.Attributes["name"])
 
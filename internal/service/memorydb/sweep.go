@@ -67,7 +67,7 @@ func sweepACLs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).MemoryDBConn
+	conn := client.(*conns.AWSClient).MemoryDBConn()
 	input := &memorydb.DescribeACLsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -87,7 +87,7 @@ func sweepACLs(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_memorydb_acl")))
 		}
 
 		return !lastPage
@@ -116,7 +116,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).MemoryDBConn
+	conn := client.(*conns.AWSClient).MemoryDBConn()
 	input := &memorydb.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -130,7 +130,7 @@ func sweepClusters(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_memorydb_cluster")))
 		}
 
 		return !lastPage
@@ -159,7 +159,7 @@ func sweepParameterGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).MemoryDBConn
+	conn := client.(*conns.AWSClient).MemoryDBConn()
 	input := &memorydb.DescribeParameterGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -179,7 +179,7 @@ func sweepParameterGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_memorydb_parameter_group")))
 		}
 
 		return !lastPage
@@ -208,7 +208,7 @@ func sweepSnapshots(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).MemoryDBConn
+	conn := client.(*conns.AWSClient).MemoryDBConn()
 	input := &memorydb.DescribeSnapshotsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -222,7 +222,7 @@ func sweepSnapshots(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(v.Name))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_memorydb_snapshot")))
 		}
 
 		return !lastPage
@@ -251,7 +251,7 @@ func sweepSubnetGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).MemoryDBConn
+	conn := client.(*conns.AWSClient).MemoryDBConn()
 	input := &memorydb.DescribeSubnetGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -271,7 +271,7 @@ func sweepSubnetGroups(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_memorydb_subnet_group")))
 		}
 
 		return !lastPage
@@ -300,7 +300,7 @@ func sweepUsers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).MemoryDBConn
+	conn := client.(*conns.AWSClient).MemoryDBConn()
 	input := &memorydb.DescribeUsersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -320,7 +320,7 @@ func sweepUsers(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_memorydb_user")))
 		}
 
 		return !lastPage
@@ -29,7 +29,7 @@ func sweepBrokers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).MQConn
+	conn := client.(*conns.AWSClient).MQConn()
 
 	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
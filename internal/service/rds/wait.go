@@ -1,10 +1,12 @@
 package rds
 
 import (
+	"context"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -160,14 +162,16 @@ func waitDBInstanceDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rd
 			InstanceStatusStorageFull,
 			InstanceStatusStorageOptimization,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstance(conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:  []string{},
+		Refresh: statusDBInstance(conn, id),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := tfresource.WaitForStateContext(context.Background(), stateConf, tfresource.Backoff{
+		Initial: 10 * time.Second,
+		Max:     1 * time.Minute,
+	})
 
 	if output, ok := outputRaw.(*rds.DBInstance); ok {
 		return output, err
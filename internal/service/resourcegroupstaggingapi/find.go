@@ -0,0 +1,29 @@
+package resourcegroupstaggingapi
+
+import (
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+)
+
+func FindResourceTagMappings(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, input *resourcegroupstaggingapi.GetResourcesInput) ([]*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	var output []*resourcegroupstaggingapi.ResourceTagMapping
+
+	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
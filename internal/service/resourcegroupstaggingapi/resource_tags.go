@@ -0,0 +1,413 @@
+package resourcegroupstaggingapi
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum amount of time for tags to be returned by the Resource Groups Tagging API after resources are tagged.
+	resourceTagsPropagationTimeout = 2 * time.Minute
+
+	// Maximum number of resource ARNs in a GetResources request.
+	getResourcesMaxResourceARNs = 100
+	// Maximum number of resource ARNs in a TagResources or UntagResources request.
+	tagResourcesMaxResourceARNs = 20
+	// Maximum number of tags in a TagResources or UntagResources request.
+	tagResourcesMaxTags = 50
+)
+
+func ResourceResourceTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceTagsCreate,
+		Read:   resourceResourceTagsRead,
+		Update: resourceResourceTagsUpdate,
+		Delete: resourceResourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceResourceTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceResourceTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	resourceARNs := expandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	d.SetId(ResourceTagsCreateResourceID(resourceARNs))
+
+	if err := tagResources(conn, resourceARNs, tags); err != nil {
+		return fmt.Errorf("error creating Resource Groups Tagging API Resource Tags (%s): %w", d.Id(), err)
+	}
+
+	return resourceResourceTagsRead(d, meta)
+}
+
+func resourceResourceTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	resourceARNs := expandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	// The Resource Groups Tagging API is eventually consistent, so newly tagged resources may not be returned yet.
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(resourceTagsPropagationTimeout, func() (interface{}, error) {
+		taggings, err := findResourceTagMappingsByARNs(conn, resourceARNs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if d.IsNewResource() {
+			if missing := missingResourceARNs(resourceARNs, taggings); len(missing) > 0 {
+				return nil, &resource.NotFoundError{
+					Message: fmt.Sprintf("resources not returned: %s", strings.Join(missing, ", ")),
+				}
+			}
+
+			if len(tags.Removed(commonTags(taggings))) > 0 {
+				return nil, &resource.NotFoundError{
+					Message: "tags not returned on all resources",
+				}
+			}
+		}
+
+		return taggings, nil
+	}, d.IsNewResource())
+
+	if d.IsNewResource() && tfresource.NotFound(err) {
+		// The configured resource ARNs and tags are kept until the next refresh.
+		log.Printf("[WARN] Resource Groups Tagging API Resource Tags (%s) not yet returned: %s", d.Id(), err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Resource Groups Tagging API Resource Tags (%s): %w", d.Id(), err)
+	}
+
+	taggings := outputRaw.([]*resourcegroupstaggingapi.ResourceTagMapping)
+
+	if len(taggings) == 0 {
+		log.Printf("[WARN] Resource Groups Tagging API Resource Tags (%s) not found on any resources, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Resources that no longer exist are removed so that they are tagged again if recreated.
+	var taggedResourceARNs []string
+
+	for _, v := range taggings {
+		taggedResourceARNs = append(taggedResourceARNs, aws.StringValue(v.ResourceARN))
+	}
+
+	if err := d.Set("resource_arns", taggedResourceARNs); err != nil {
+		return fmt.Errorf("error setting resource_arns: %w", err)
+	}
+
+	// Only the managed tags are read. A tag that is missing from, or has a different value on,
+	// any of the resources is left out so that the resources are tagged again.
+	tags = commonTags(taggings).Only(tags)
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourceTagsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	resourceARNs, err := ResourceTagsParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	taggings, err := findResourceTagMappingsByARNs(conn, resourceARNs)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Resource Groups Tagging API Resource Tags (%s): %w", d.Id(), err)
+	}
+
+	// The tags on all the resources are imported.
+	d.SetId(ResourceTagsCreateResourceID(resourceARNs))
+	d.Set("resource_arns", resourceARNs)
+	d.Set("tags", commonTags(taggings).Map())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceResourceTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	o, n := d.GetChange("resource_arns")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	removedResourceARNs := expandStringValueSet(os.Difference(ns))
+	retainedResourceARNs := expandStringValueSet(os.Intersection(ns))
+	addedResourceARNs := expandStringValueSet(ns.Difference(os))
+
+	o, n = d.GetChange("tags")
+	oldTags, newTags := tftags.New(o), tftags.New(n)
+
+	var errs *multierror.Error
+
+	if err := untagResources(conn, removedResourceARNs, oldTags.Keys()); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if err := untagResources(conn, retainedResourceARNs, oldTags.Removed(newTags).Keys()); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if err := tagResources(conn, retainedResourceARNs, oldTags.Updated(newTags)); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if err := tagResources(conn, addedResourceARNs, newTags); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return fmt.Errorf("error updating Resource Groups Tagging API Resource Tags (%s): %w", d.Id(), err)
+	}
+
+	// The Resource Groups Tagging API is eventually consistent, so tags are only read on refresh.
+	return nil
+}
+
+func resourceResourceTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	resourceARNs := expandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(d.Get("tags").(map[string]interface{}))
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tagging API Resource Tags: %s", d.Id())
+	if err := untagResources(conn, resourceARNs, tags.Keys()); err != nil {
+		return fmt.Errorf("error deleting Resource Groups Tagging API Resource Tags (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const resourceTagsResourceIDSeparator = ","
+
+// ResourceTagsCreateResourceID returns the ID of the resource tags, a hash of the sorted ARNs of the resources
+// tagged when it was created or imported. The ID does not change when resources are added or removed.
+func ResourceTagsCreateResourceID(resourceARNs []string) string {
+	parts := make([]string, len(resourceARNs))
+	copy(parts, resourceARNs)
+	sort.Strings(parts)
+
+	return strconv.Itoa(create.StringHashcode(strings.Join(parts, resourceTagsResourceIDSeparator)))
+}
+
+// ResourceTagsParseResourceID returns the resource ARNs from the import ID of the resource tags,
+// a comma-separated list of the resource ARNs.
+func ResourceTagsParseResourceID(id string) ([]string, error) {
+	parts := strings.Split(id, resourceTagsResourceIDSeparator)
+
+	for _, part := range parts {
+		if !arn.IsARN(part) {
+			return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected resource-arn%[2]sresource-arn...", id, resourceTagsResourceIDSeparator)
+		}
+	}
+
+	return parts, nil
+}
+
+// commonTags returns the tags, other than AWS tags, with the same value on all the resources.
+// A tag that is missing from, or has a different value on, any of the resources is not returned.
+func commonTags(taggings []*resourcegroupstaggingapi.ResourceTagMapping) tftags.KeyValueTags {
+	var tags tftags.KeyValueTags
+
+	for i, v := range taggings {
+		resourceTags := KeyValueTags(v.Tags).IgnoreAWS()
+
+		if i == 0 {
+			tags = resourceTags
+			continue
+		}
+
+		for key, value := range tags {
+			if v, ok := resourceTags[key]; !ok || v == nil || value == nil || aws.StringValue(v.Value) != aws.StringValue(value.Value) {
+				delete(tags, key)
+			}
+		}
+	}
+
+	return tags
+}
+
+func findResourceTagMappingsByARNs(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs []string) ([]*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	var output []*resourcegroupstaggingapi.ResourceTagMapping
+
+	for _, chunk := range chunkResourceARNs(resourceARNs, getResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: aws.StringSlice(chunk),
+		}
+
+		taggings, err := FindResourceTagMappings(conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, taggings...)
+	}
+
+	return output, nil
+}
+
+// missingResourceARNs returns the resource ARNs that have no tag mapping, such as those of resources that
+// do not exist, have no tags or are not yet returned by the eventually consistent Resource Groups Tagging API.
+func missingResourceARNs(resourceARNs []string, taggings []*resourcegroupstaggingapi.ResourceTagMapping) []string {
+	found := make(map[string]bool, len(taggings))
+
+	for _, v := range taggings {
+		found[aws.StringValue(v.ResourceARN)] = true
+	}
+
+	var missing []string
+
+	for _, v := range resourceARNs {
+		if !found[v] {
+			missing = append(missing, v)
+		}
+	}
+
+	return missing
+}
+
+// tagResources adds or updates the tags of the resources, returning an error for each resource that failed to be tagged.
+func tagResources(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs []string, tags tftags.KeyValueTags) error {
+	tags = tags.IgnoreAWS()
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, resourceARNsChunk := range chunkResourceARNs(resourceARNs, tagResourcesMaxResourceARNs) {
+		for _, tagsChunk := range tags.Chunks(tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: aws.StringSlice(resourceARNsChunk),
+				Tags:            aws.StringMap(tagsChunk.Map()),
+			}
+
+			output, err := conn.TagResources(input)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error tagging resources (%s): %w", strings.Join(resourceARNsChunk, ", "), err))
+				continue
+			}
+
+			errs = multierror.Append(errs, failedResourcesErrors("tagging", output.FailedResourcesMap)...)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// untagResources removes the tag keys from the resources, returning an error for each resource that failed to be untagged.
+func untagResources(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, resourceARNs []string, keys []string) error {
+	tags := tftags.New(keys).IgnoreAWS()
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, resourceARNsChunk := range chunkResourceARNs(resourceARNs, tagResourcesMaxResourceARNs) {
+		for _, tagsChunk := range tags.Chunks(tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: aws.StringSlice(resourceARNsChunk),
+				TagKeys:         aws.StringSlice(tagsChunk.Keys()),
+			}
+
+			output, err := conn.UntagResources(input)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error untagging resources (%s): %w", strings.Join(resourceARNsChunk, ", "), err))
+				continue
+			}
+
+			errs = multierror.Append(errs, failedResourcesErrors("untagging", output.FailedResourcesMap)...)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func failedResourcesErrors(operation string, failedResources map[string]*resourcegroupstaggingapi.FailureInfo) []error {
+	var errs []error
+
+	for resourceARN, v := range failedResources {
+		if v == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("error %s resource (%s): %s: %s", operation, resourceARN, aws.StringValue(v.ErrorCode), aws.StringValue(v.ErrorMessage)))
+	}
+
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+
+	return errs
+}
+
+func expandStringValueSet(configured *schema.Set) []string {
+	return aws.StringValueSlice(flex.ExpandStringSet(configured))
+}
+
+func chunkResourceARNs(resourceARNs []string, size int) [][]string {
+	var chunks [][]string
+
+	for i := 0; i < len(resourceARNs); i += size {
+		end := i + size
+
+		if end > len(resourceARNs) {
+			end = len(resourceARNs)
+		}
+
+		chunks = append(chunks, resourceARNs[i:end])
+	}
+
+	return chunks
+}
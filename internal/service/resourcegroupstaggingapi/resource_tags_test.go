@@ -0,0 +1,328 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+)
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig(rName, 1, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccResourceTagsImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceTagsConfig(rName, 2, "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+				),
+			},
+			// Once resources are added, the imported ID, a hash of all the resource ARNs, differs from the ID in state.
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccResourceTagsImportStateIdFunc(resourceName),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if got, expected := len(states), 1; got != expected {
+						return fmt.Errorf("got %d imported resources, expected %d", got, expected)
+					}
+
+					for k, expected := range map[string]string{"resource_arns.#": "2", "tags.%": "2", "tags.key1": "value1updated"} {
+						if got := states[0].Attributes[k]; got != expected {
+							return fmt.Errorf("got imported %s %q, expected %q", k, got, expected)
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_drift(t *testing.T) {
+	resourceName := "aws_resourcegroupstaggingapi_resource_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(resourceName),
+					testAccCheckResourceTagsUntagFirst(resourceName, "key1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceTagsConfig(rName, 2, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceTagsExist(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceTagsParseResourceID(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		ID          string
+		Expected    []string
+		ExpectError bool
+	}{
+		{
+			Name:        "empty",
+			ID:          "",
+			ExpectError: true,
+		},
+		{
+			Name:        "hash",
+			ID:          "1234567890",
+			ExpectError: true,
+		},
+		{
+			Name:     "one ARN",
+			ID:       "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678",
+			Expected: []string{"arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"},
+		},
+		{
+			Name:     "multiple ARNs",
+			ID:       "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678,arn:aws:ec2:us-west-2:123456789012:vpc/vpc-87654321",
+			Expected: []string{"arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-87654321"},
+		},
+		{
+			Name:        "trailing separator",
+			ID:          "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678,",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tfresourcegroupstaggingapi.ResourceTagsParseResourceID(testCase.ID)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceTagsCreateResourceID(t *testing.T) {
+	arn1 := "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"
+	arn2 := "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-87654321"
+
+	id := tfresourcegroupstaggingapi.ResourceTagsCreateResourceID([]string{arn1, arn2})
+
+	if got := tfresourcegroupstaggingapi.ResourceTagsCreateResourceID([]string{arn2, arn1}); got != id {
+		t.Errorf("got ID %s for ARNs in a different order, expected %s", got, id)
+	}
+
+	if got := tfresourcegroupstaggingapi.ResourceTagsCreateResourceID([]string{arn1}); got == id {
+		t.Errorf("got ID %s for different ARNs, expected a different ID", got)
+	}
+
+	if _, err := tfresourcegroupstaggingapi.ResourceTagsParseResourceID(id); err == nil {
+		t.Errorf("expected ID %s not to parse as an import ID", id)
+	}
+}
+
+func testAccCheckResourceTagsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_resourcegroupstaggingapi_resource_tags" {
+			continue
+		}
+
+		taggings, err := testAccFindResourceTagMappings(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range taggings {
+			for _, tag := range v.Tags {
+				if rs.Primary.Attributes["tags."+aws.StringValue(tag.Key)] != "" {
+					return fmt.Errorf("Resource Groups Tagging API Resource (%s) tag (%s) still exists", aws.StringValue(v.ResourceARN), aws.StringValue(tag.Key))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckResourceTagsExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Resource Groups Tagging API Resource Tags ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+		taggings, err := testAccFindResourceTagMappings(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if got, expected := len(taggings), rs.Primary.Attributes["resource_arns.#"]; fmt.Sprint(got) != expected {
+			return fmt.Errorf("got %d tagged resources, expected %s", got, expected)
+		}
+
+		for _, v := range taggings {
+			tags := tfresourcegroupstaggingapi.KeyValueTags(v.Tags).Map()
+
+			for key, value := range tags {
+				if expected, ok := rs.Primary.Attributes["tags."+key]; ok && value != expected {
+					return fmt.Errorf("Resource Groups Tagging API Resource (%s) tag (%s) is %q, expected %q", aws.StringValue(v.ResourceARN), key, value, expected)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckResourceTagsUntagFirst removes a tag from the first of the tagged resources outside Terraform.
+func testAccCheckResourceTagsUntagFirst(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+
+		resourceARNs := testAccResourceTagsResourceARNs(rs)
+
+		_, err := conn.UntagResources(&resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: aws.StringSlice(resourceARNs[:1]),
+			TagKeys:         aws.StringSlice([]string{key}),
+		})
+
+		return err
+	}
+}
+
+// testAccResourceTagsResourceARNs returns the sorted resource ARNs from the state of the resource tags.
+func testAccResourceTagsResourceARNs(rs *terraform.ResourceState) []string {
+	var resourceARNs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "resource_arns.") && k != "resource_arns.#" {
+			resourceARNs = append(resourceARNs, v)
+		}
+	}
+
+	sort.Strings(resourceARNs)
+
+	return resourceARNs
+}
+
+func testAccResourceTagsImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return strings.Join(testAccResourceTagsResourceARNs(rs), ","), nil
+	}
+}
+
+func testAccFindResourceTagMappings(conn *resourcegroupstaggingapi.ResourceGroupsTaggingAPI, rs *terraform.ResourceState) ([]*resourcegroupstaggingapi.ResourceTagMapping, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		TagFilters: []*resourcegroupstaggingapi.TagFilter{
+			{
+				Key:    aws.String("Name"),
+				Values: aws.StringSlice([]string{rs.Primary.Attributes["tags.Name"]}),
+			},
+		},
+	}
+
+	return tfresourcegroupstaggingapi.FindResourceTagMappings(conn, input)
+}
+
+func testAccResourceTagsConfig(rName string, count int, value1 string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = %[2]d
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "aws_resourcegroupstaggingapi_resource_tags" "test" {
+  resource_arns = aws_vpc.test[*].arn
+
+  tags = {
+    Name = %[1]q
+    key1 = %[3]q
+  }
+}
+`, rName, count, value1)
+}
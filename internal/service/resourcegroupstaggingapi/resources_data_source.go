@@ -96,7 +96,7 @@ func DataSourceResources() *schema.Resource {
 }
 
 func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
 
 	input := &resourcegroupstaggingapi.GetResourcesInput{}
 
@@ -120,16 +120,8 @@ func dataSourceResourcesRead(d *schema.ResourceData, meta interface{}) error {
 		input.ResourceTypeFilters = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
-	var taggings []*resourcegroupstaggingapi.ResourceTagMapping
+	taggings, err := FindResourceTagMappings(conn, input)
 
-	err := conn.GetResourcesPages(input, func(page *resourcegroupstaggingapi.GetResourcesOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		taggings = append(taggings, page.ResourceTagMappingList...)
-		return !lastPage
-	})
 	if err != nil {
 		return fmt.Errorf("error getting Resource Groups Tags API Resources: %w", err)
 	}
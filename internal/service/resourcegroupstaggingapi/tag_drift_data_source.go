@@ -0,0 +1,144 @@
+package resourcegroupstaggingapi
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceTagDrift() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTagDriftRead,
+
+		Schema: map[string]*schema.Schema{
+			"missing_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_arn_list": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"resource_arn_list", "tag_filter"},
+			},
+			"resource_type_filters": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				MaxItems:      100,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_arn_list"},
+			},
+			"tag_filter": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     50,
+				ExactlyOneOf: []string{"resource_arn_list", "tag_filter"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliant": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"drifted_default_tags": tftags.TagsSchemaComputed(),
+						"missing_default_tags": tftags.TagsSchemaComputed(),
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTagDriftRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var resourceARNs []string
+	var taggings []*resourcegroupstaggingapi.ResourceTagMapping
+	var err error
+
+	if v, ok := d.GetOk("resource_arn_list"); ok && v.(*schema.Set).Len() > 0 {
+		resourceARNs = expandStringValueSet(v.(*schema.Set))
+		taggings, err = findResourceTagMappingsByARNs(conn, resourceARNs)
+	} else {
+		input := &resourcegroupstaggingapi.GetResourcesInput{}
+
+		if v, ok := d.GetOk("tag_filter"); ok {
+			input.TagFilters = expandTagFilters(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("resource_type_filters"); ok && v.(*schema.Set).Len() > 0 {
+			input.ResourceTypeFilters = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		taggings, err = FindResourceTagMappings(conn, input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Resource Groups Tags API Resources: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Partition)
+
+	if err := d.Set("missing_arns", missingResourceARNs(resourceARNs, taggings)); err != nil {
+		return fmt.Errorf("error setting missing_arns: %w", err)
+	}
+
+	if err := d.Set("resources", flattenTagDrifts(taggings, defaultTagsConfig, ignoreTagsConfig)); err != nil {
+		return fmt.Errorf("error setting resources: %w", err)
+	}
+
+	return nil
+}
+
+func flattenTagDrifts(list []*resourcegroupstaggingapi.ResourceTagMapping, defaultTagsConfig *tftags.DefaultConfig, ignoreTagsConfig *tftags.IgnoreConfig) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	defaultTags := defaultTagsConfig.GetTags().IgnoreConfig(ignoreTagsConfig)
+
+	for _, v := range list {
+		tags := KeyValueTags(v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+		missingTags := defaultTags.Removed(tags)
+		// Templated and set-once default tags match any value they may have been set to.
+		matchingTags := tags.Ignore(tags.RemoveDefaultConfig(defaultTagsConfig))
+		driftedTags := tags.Updated(defaultTags).Ignore(missingTags).Ignore(matchingTags)
+
+		result = append(result, map[string]interface{}{
+			"compliant":            len(missingTags) == 0 && len(driftedTags) == 0,
+			"drifted_default_tags": driftedTags.Map(),
+			"missing_default_tags": missingTags.Map(),
+			"resource_arn":         aws.StringValue(v.ResourceARN),
+			"tags":                 tags.Map(),
+		})
+	}
+
+	return result
+}
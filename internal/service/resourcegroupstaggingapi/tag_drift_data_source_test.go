@@ -0,0 +1,112 @@
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccResourceGroupsTaggingAPITagDriftDataSource_resourceARNList(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_drift.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("providerkey1", "providervalue1", "Key", "providervalue2"),
+					testAccTagDriftResourceARNListDataSourceConfig(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "missing_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.resource_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.compliant", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.drifted_default_tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.drifted_default_tags.Key", "providervalue2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.missing_default_tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.Key", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.providerkey1", "providervalue1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITagDriftDataSource_tagFilter(t *testing.T) {
+	var providers []*schema.Provider
+	dataSourceName := "data.aws_resourcegroupstaggingapi_tag_drift.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, resourcegroupstaggingapi.EndpointsID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccTagDriftTagFilterDataSourceConfig(rName),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "missing_arns.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.resource_arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.compliant", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.drifted_default_tags.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.missing_default_tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTagDriftResourceARNListDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_drift" "test" {
+  resource_arn_list = [
+    aws_vpc.test.arn,
+    replace(aws_vpc.test.arn, aws_vpc.test.id, "vpc-00000000000000000"),
+  ]
+}
+`, rName)
+}
+
+func testAccTagDriftTagFilterDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_tag_drift" "test" {
+  tag_filter {
+    key    = "Key"
+    values = [aws_vpc.test.tags["Key"]]
+  }
+}
+`, rName)
+}
@@ -55,7 +55,7 @@ func sweepHealthchecks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -77,7 +77,7 @@ func sweepHealthchecks(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_route53_health_check")))
 		}
 
 		return !lastPage
@@ -106,7 +106,7 @@ func sweepKeySigningKeys(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -154,7 +154,7 @@ func sweepKeySigningKeys(region string) error {
 				d.Set("name", dns.Name)
 				d.Set("status", dns.Status)
 
-				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_route53_key_signing_key")))
 			}
 
 		}
@@ -183,7 +183,7 @@ func sweepQueryLogs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListQueryLoggingConfigsPages(&route53.ListQueryLoggingConfigsInput{}, func(page *route53.ListQueryLoggingConfigsOutput, lastPage bool) bool {
@@ -228,7 +228,7 @@ func sweepZones(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -260,7 +260,7 @@ func sweepZones(region string) error {
 			d.Set("force_destroy", true)
 			d.Set("name", detail.Name)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_route53_zone")))
 		}
 
 		return !lastPage
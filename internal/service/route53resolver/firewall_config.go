@@ -45,7 +45,7 @@ func ResourceFirewallConfig() *schema.Resource {
 }
 
 func resourceFirewallConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	input := &route53resolver.UpdateFirewallConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -67,7 +67,7 @@ func resourceFirewallConfigCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceFirewallConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	config, err := FindFirewallConfigByID(conn, d.Id())
 
@@ -89,7 +89,7 @@ func resourceFirewallConfigRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceFirewallConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	input := &route53resolver.UpdateFirewallConfigInput{
 		ResourceId: aws.String(d.Get("resource_id").(string)),
@@ -109,7 +109,7 @@ func resourceFirewallConfigUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceFirewallConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	log.Printf("[DEBUG] Deleting Route 53 Resolver DNS Firewall config")
 	_, err := conn.UpdateFirewallConfig(&route53resolver.UpdateFirewallConfigInput{
@@ -357,7 +357,7 @@ func testAccCheckS3BucketIntelligentTieringConfigurationExists(n string, v *s3.I
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 		output, err := tfs3.FindBucketIntelligentTieringConfiguration(conn, bucketName, configurationName)
 
@@ -372,7 +372,7 @@ func testAccCheckS3BucketIntelligentTieringConfigurationExists(n string, v *s3.I
 }
 
 func testAccCheckBucketIntelligentTieringConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_intelligent_tiering_configuration" {
@@ -2,11 +2,57 @@
 package s3
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// BucketListTags lists s3 service tags.
+func BucketListTags(conn *s3.S3, bucket string) (tftags.KeyValueTags, error) {
+	input := &s3.GetBucketTaggingInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketTagging(input)
+
+	if tfawserr.ErrCodeEquals(err, "NoSuchTagSet") {
+		return tftags.New(nil), nil
+	}
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(output.TagSet), nil
+}
+
+// ObjectListTags lists s3 service tags.
+func ObjectListTags(conn *s3.S3, bucket, key string) (tftags.KeyValueTags, error) {
+	input := &s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(1*time.Minute, func() (interface{}, error) {
+		return conn.GetObjectTagging(input)
+	}, "NoSuchKey")
+
+	if tfawserr.ErrCodeEquals(err, "NoSuchTagSet") {
+		return tftags.New(nil), nil
+	}
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return KeyValueTags(outputRaw.(*s3.GetObjectTaggingOutput).TagSet), nil
+}
+
 // []*SERVICE.Tag handling
 
 // Tags returns s3 service tags.
@@ -35,3 +81,85 @@ func KeyValueTags(tags []*s3.Tag) tftags.KeyValueTags {
 
 	return tftags.New(m)
 }
+
+// BucketUpdateTags updates s3 service tags.
+func BucketUpdateTags(conn *s3.S3, bucket string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := BucketListTags(conn, bucket)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s): %w", bucket, err)
+	}
+
+	tags := newTags.Merge(allTags.Ignore(oldTags).Ignore(newTags))
+
+	if len(tags) > 0 {
+		input := &s3.PutBucketTaggingInput{
+			Bucket:  aws.String(bucket),
+			Tagging: &s3.Tagging{TagSet: Tags(tags)},
+		}
+
+		_, err := conn.PutBucketTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s): %w", bucket, err)
+		}
+	} else if len(oldTags) > 0 {
+		input := &s3.DeleteBucketTaggingInput{
+			Bucket: aws.String(bucket),
+		}
+
+		_, err := conn.DeleteBucketTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s): %w", bucket, err)
+		}
+	}
+
+	return nil
+}
+
+// ObjectUpdateTags updates s3 service tags.
+func ObjectUpdateTags(conn *s3.S3, bucket, key string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	// We need to also consider any existing ignored tags.
+	allTags, err := ObjectListTags(conn, bucket, key)
+
+	if err != nil {
+		return fmt.Errorf("error listing resource tags (%s/%s): %w", bucket, key, err)
+	}
+
+	tags := newTags.Merge(allTags.Ignore(oldTags).Ignore(newTags))
+
+	if len(tags) > 0 {
+		input := &s3.PutObjectTaggingInput{
+			Bucket:  aws.String(bucket),
+			Key:     aws.String(key),
+			Tagging: &s3.Tagging{TagSet: Tags(tags.IgnoreAWS())},
+		}
+
+		_, err := conn.PutObjectTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error setting resource tags (%s/%s): %w", bucket, key, err)
+		}
+	} else if len(oldTags) > 0 {
+		input := &s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+
+		_, err := conn.DeleteObjectTagging(input)
+
+		if err != nil {
+			return fmt.Errorf("error deleting resource tags (%s/%s): %w", bucket, key, err)
+		}
+	}
+
+	return nil
+}
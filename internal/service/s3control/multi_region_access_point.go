@@ -255,7 +255,7 @@ func resourceMultiRegionAccessPointDelete(d *schema.ResourceData, meta interface
 }
 
 func S3ControlConnForMRAP(client *conns.AWSClient) (*s3control.S3Control, error) {
-	originalConn := client.S3ControlConn
+	originalConn := client.S3ControlConn()
 	// All Multi-Region Access Point actions are routed to the US West (Oregon) Region.
 	region := endpoints.UsWest2RegionID
 
@@ -263,7 +263,7 @@ func S3ControlConnForMRAP(client *conns.AWSClient) (*s3control.S3Control, error)
 		return originalConn, nil
 	}
 
-	sess, err := conns.NewSessionForRegion(&originalConn.Config, region, client.TerraformVersion)
+	sess, err := client.NewSessionForRegion(&originalConn.Config, region)
 
 	if err != nil {
 		return nil, fmt.Errorf("error creating AWS session: %w", err)
@@ -41,7 +41,7 @@ func sweepAccessPoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountID),
@@ -65,7 +65,7 @@ func sweepAccessPoints(region string) error {
 			}
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_s3_access_point")))
 		}
 
 		return !lastPage
@@ -106,7 +106,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
 		return nil
 	}
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &s3control.ListMultiRegionAccessPointsInput{
 		AccountId: aws.String(accountID),
@@ -123,7 +123,7 @@ func sweepMultiRegionAccessPoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(MultiRegionAccessPointCreateResourceID(accountID, aws.StringValue(accessPoint.Name)))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_s3control_multi_region_access_point")))
 		}
 
 		return !lastPage
@@ -152,7 +152,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &s3control.ListAccessPointsForObjectLambdaInput{
 		AccountId: aws.String(accountID),
@@ -169,7 +169,7 @@ func sweepObjectLambdaAccessPoints(region string) error {
 			d := r.Data(nil)
 			d.SetId(ObjectLambdaAccessPointCreateResourceID(accountID, aws.StringValue(accessPoint.Name)))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_s3control_object_lambda_access_point")))
 		}
 
 		return !lastPage
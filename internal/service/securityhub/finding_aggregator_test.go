@@ -88,7 +88,7 @@ func testAccCheckFindingAggregatorExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No Security Hub finding aggregator ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
 
 		_, err := conn.GetFindingAggregator(&securityhub.GetFindingAggregatorInput{
 			FindingAggregatorArn: &rs.Primary.ID,
@@ -103,7 +103,7 @@ func testAccCheckFindingAggregatorExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckFindingAggregatorDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityHubConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_finding_aggregator" {
@@ -58,7 +58,7 @@ func DataSourceConstraint() *schema.Resource {
 }
 
 func dataSourceConstraintRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	output, err := WaitConstraintReady(conn, d.Get("accept_language").(string), d.Get("id").(string))
 
@@ -83,7 +83,7 @@ func ResourcePortfolioShare() *schema.Resource {
 }
 
 func resourcePortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.CreatePortfolioShareInput{
 		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
@@ -164,7 +164,7 @@ func resourcePortfolioShareCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourcePortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	portfolioID, shareType, principalID, err := PortfolioShareParseResourceID(d.Id())
 
@@ -204,7 +204,7 @@ func resourcePortfolioShareRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePortfolioShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	if d.HasChanges("accept_language", "share_tag_options") {
 		input := &servicecatalog.UpdatePortfolioShareInput{
@@ -246,7 +246,7 @@ func resourcePortfolioShareUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourcePortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.DeletePortfolioShareInput{
 		PortfolioId: aws.String(d.Get("portfolio_id").(string)),
@@ -97,7 +97,7 @@ func ResourceProvisioningArtifact() *schema.Resource {
 }
 
 func resourceProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	parameters := make(map[string]interface{})
 	parameters["description"] = d.Get("description")
@@ -155,7 +155,7 @@ func resourceProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
 
@@ -203,7 +203,7 @@ func resourceProvisioningArtifactRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	if d.HasChanges("accept_language", "active", "description", "guidance", "name", "product_id") {
 		artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
@@ -261,7 +261,7 @@ func resourceProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	artifactID, productID, err := ProvisioningArtifactParseID(d.Id())
 
@@ -80,7 +80,7 @@ func ResourceServiceAction() *schema.Resource {
 }
 
 func resourceServiceActionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.CreateServiceActionInput{
 		IdempotencyToken: aws.String(resource.UniqueId()),
@@ -132,7 +132,7 @@ func resourceServiceActionCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceServiceActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	output, err := WaitServiceActionReady(conn, d.Get("accept_language").(string), d.Id())
 
@@ -165,7 +165,7 @@ func resourceServiceActionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServiceActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.UpdateServiceActionInput{
 		Id: aws.String(d.Id()),
@@ -213,7 +213,7 @@ func resourceServiceActionUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceServiceActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.DeleteServiceActionInput{
 		Id: aws.String(d.Id()),
@@ -88,7 +88,7 @@ func sweepBudgetResourceAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -122,7 +122,7 @@ func sweepBudgetResourceAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(BudgetResourceAssociationID(aws.StringValue(budget.BudgetName), aws.StringValue(port.Id)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_budget_resource_association")))
 				}
 
 				return !lastPage
@@ -166,7 +166,7 @@ func sweepBudgetResourceAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(BudgetResourceAssociationID(aws.StringValue(budget.BudgetName), aws.StringValue(pvd.ProductViewSummary.ProductId)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_budget_resource_association")))
 				}
 
 				return !lastPage
@@ -199,7 +199,7 @@ func sweepConstraints(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -235,7 +235,7 @@ func sweepConstraints(region string) error {
 					d := r.Data(nil)
 					d.SetId(aws.StringValue(detail.ConstraintId))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_constraint")))
 				}
 
 				return !lastPage
@@ -268,7 +268,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -302,7 +302,7 @@ func sweepPrincipalPortfolioAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(PrincipalPortfolioAssociationID(AcceptLanguageEnglish, aws.StringValue(principal.PrincipalARN), aws.StringValue(detail.Id)))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_principal_portfolio_association")))
 				}
 
 				return !lastPage
@@ -340,7 +340,7 @@ func sweepProductPortfolioAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -394,7 +394,7 @@ func sweepProductPortfolioAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(ProductPortfolioAssociationCreateID(AcceptLanguageEnglish, aws.StringValue(detail.Id), productID))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_product_portfolio_association")))
 				}
 
 				return !lastPage
@@ -432,7 +432,7 @@ func sweepProducts(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -454,7 +454,7 @@ func sweepProducts(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_product")))
 		}
 
 		return !lastPage
@@ -483,7 +483,7 @@ func sweepProvisionedProducts(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -508,7 +508,7 @@ func sweepProvisionedProducts(region string) error {
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(detail.Id))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_provisioned_product")))
 		}
 
 		return !lastPage
@@ -537,7 +537,7 @@ func sweepProvisioningArtifacts(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -577,7 +577,7 @@ func sweepProvisioningArtifacts(region string) error {
 					d.SetId(aws.StringValue(pad.Id))
 					d.Set("product_id", productID)
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_provisioning_artifact")))
 				}
 
 				/*
@@ -618,7 +618,7 @@ func sweepServiceActions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -640,7 +640,7 @@ func sweepServiceActions(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_service_action")))
 		}
 
 		return !lastPage
@@ -669,7 +669,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -703,7 +703,7 @@ func sweepTagOptionResourceAssociations(region string) error {
 					d := r.Data(nil)
 					d.SetId(aws.StringValue(resource.Id))
 
-					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_tag_option_resource_association")))
 				}
 
 				return !lastPage
@@ -736,7 +736,7 @@ func sweepTagOptions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).ServiceCatalogConn
+	conn := client.(*conns.AWSClient).ServiceCatalogConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -758,7 +758,7 @@ func sweepTagOptions(region string) error {
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_servicecatalog_tag_option")))
 		}
 
 		return !lastPage
@@ -35,7 +35,7 @@ func ResourceActiveReceiptRuleSet() *schema.Resource {
 }
 
 func resourceActiveReceiptRuleSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	ruleSetName := d.Get("rule_set_name").(string)
 
@@ -54,7 +54,7 @@ func resourceActiveReceiptRuleSetUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceActiveReceiptRuleSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	describeOpts := &ses.DescribeActiveReceiptRuleSetInput{}
 
@@ -89,7 +89,7 @@ func resourceActiveReceiptRuleSetRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceActiveReceiptRuleSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SESConn
+	conn := meta.(*conns.AWSClient).SESConn()
 
 	deleteOpts := &ses.SetActiveReceiptRuleSetInput{
 		RuleSetName: nil,
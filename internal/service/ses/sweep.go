@@ -43,7 +43,7 @@ func sweepConfigurationSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SESConn
+	conn := client.(*conns.AWSClient).SESConn()
 	input := &ses.ListConfigurationSetsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -90,7 +90,7 @@ func sweepIdentities(region, identityType string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SESConn
+	conn := client.(*conns.AWSClient).SESConn()
 	input := &ses.ListIdentitiesInput{
 		IdentityType: aws.String(identityType),
 	}
@@ -134,7 +134,7 @@ func sweepReceiptRuleSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SESConn
+	conn := client.(*conns.AWSClient).SESConn()
 
 	// You cannot delete the receipt rule set that is currently active.
 	// Setting the name of the receipt rule set to make active to null disables all email receiving.
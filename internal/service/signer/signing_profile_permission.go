@@ -13,9 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceSigningProfilePermission() *schema.Resource {
@@ -24,6 +24,8 @@ func ResourceSigningProfilePermission() *schema.Resource {
 		Read:   resourceSigningProfilePermissionRead,
 		Delete: resourceSigningProfilePermissionDelete,
 
+		CustomizeDiff: verify.NameLengthDiff("statement_id", "statement_id_prefix", 64),
+
 		Importer: &schema.ResourceImporter{
 			State: resourceSigningProfilePermissionImport,
 		},
@@ -77,7 +79,7 @@ func ResourceSigningProfilePermission() *schema.Resource {
 }
 
 func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SignerConn
+	conn := meta.(*conns.AWSClient).SignerConn()
 
 	profileName := d.Get("profile_name").(string)
 
@@ -100,7 +102,7 @@ func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interfa
 		revisionId = aws.StringValue(getProfilePermissionsOutput.RevisionId)
 	}
 
-	statementId := create.Name(d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
+	statementId := meta.(*conns.AWSClient).NamingConfig.Name(d.Get("statement_id").(string), d.Get("statement_id_prefix").(string))
 
 	addProfilePermissionInput := &signer.AddProfilePermissionInput{
 		Action:      aws.String(d.Get("action").(string)),
@@ -165,7 +167,7 @@ func resourceSigningProfilePermissionCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceSigningProfilePermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SignerConn
+	conn := meta.(*conns.AWSClient).SignerConn()
 
 	listProfilePermissionsInput := &signer.ListProfilePermissionsInput{
 		ProfileName: aws.String(d.Get("profile_name").(string)),
@@ -239,7 +241,7 @@ func getProfilePermission(permissions []*signer.Permission, statementId string)
 }
 
 func resourceSigningProfilePermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SignerConn
+	conn := meta.(*conns.AWSClient).SignerConn()
 
 	profileName := d.Get("profile_name").(string)
 
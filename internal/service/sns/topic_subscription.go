@@ -131,7 +131,7 @@ func ResourceTopicSubscription() *schema.Resource {
 }
 
 func resourceTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes, err := subscriptionAttributeMap.ResourceDataToApiAttributesCreate(d)
 
@@ -186,7 +186,7 @@ func resourceTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(subscriptionCreateTimeout, func() (interface{}, error) {
 		return FindSubscriptionAttributesByARN(conn, d.Id())
@@ -214,7 +214,7 @@ func resourceTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	attributes, err := subscriptionAttributeMap.ResourceDataToApiAttributesUpdate(d)
 
@@ -232,7 +232,7 @@ func resourceTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTopicSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SNSConn
+	conn := meta.(*conns.AWSClient).SNSConn()
 
 	log.Printf("[DEBUG] Deleting SNS Topic Subscription: %s", d.Id())
 	_, err := conn.Unsubscribe(&sns.UnsubscribeInput{
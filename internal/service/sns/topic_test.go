@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
@@ -30,6 +31,94 @@ func testAccErrorCheckSkipSNS(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+// TestTopic_fakeAWS plans and applies a topic against an in-memory fake of SNS.
+func TestTopic_fakeAWS(t *testing.T) {
+	fakeaws.PreCheckTerraform(t)
+
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	resourceName := "aws_sns_topic.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testTopicFakeAWSCheckDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testTopicFakeAWSConfig("value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "arn", "arn:aws:sns:"+fakeaws.DefaultRegion+":"+fakeaws.DefaultAccountID+":test"), //lintignore:AWSAT005
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + testTopicFakeAWSConfig("value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+				),
+			},
+		},
+	})
+
+	requests := make(map[string]bool)
+	for _, v := range server.Requests() {
+		requests[v] = true
+	}
+
+	for _, v := range []string{"sns:CreateTopic", "sns:TagResource", "sns:DeleteTopic"} {
+		if !requests[v] {
+			t.Errorf("expected %s request, got %v", v, server.Requests())
+		}
+	}
+}
+
+func testTopicFakeAWSCheckDestroy(server *fakeaws.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := server.Config().Client()
+
+		if err != nil {
+			return err
+		}
+
+		conn := client.(*conns.AWSClient).SNSConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sns_topic" {
+				continue
+			}
+
+			_, err := tfsns.FindTopicAttributesByARN(conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SNS Topic %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testTopicFakeAWSConfig(value string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "test"
+
+  tags = {
+    key1 = %[1]q
+  }
+}
+`, value)
+}
+
 func TestAccSNSTopic_basic(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
@@ -536,7 +625,7 @@ func testAccCheckNSTopicHasPolicy(n string, expectedPolicyText string) resource.
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -578,7 +667,7 @@ func testAccCheckNSTopicHasDeliveryPolicy(n string, expectedPolicyText string) r
 			return fmt.Errorf("no Queue URL specified")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -608,7 +697,7 @@ func testAccCheckNSTopicHasDeliveryPolicy(n string, expectedPolicyText string) r
 }
 
 func testAccCheckTopicDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic" {
@@ -642,7 +731,7 @@ func testAccCheckTopicExists(n string, v *map[string]string) resource.TestCheckF
 			return fmt.Errorf("No SNS Topic ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		output, err := tfsns.FindTopicAttributesByARN(conn, rs.Primary.ID)
 
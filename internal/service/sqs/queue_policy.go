@@ -56,7 +56,7 @@ func ResourceQueuePolicy() *schema.Resource {
 }
 
 func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
 
@@ -93,7 +93,7 @@ func resourceQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	outputRaw, err := tfresource.RetryWhenNotFound(queuePolicyReadTimeout, func() (interface{}, error) {
 		return FindQueuePolicyByURL(conn, d.Id())
@@ -123,7 +123,7 @@ func resourceQueuePolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueuePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	log.Printf("[DEBUG] Deleting SQS Queue Policy: %s", d.Id())
 	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
@@ -50,7 +50,7 @@ func DataSourceDocument() *schema.Resource {
 }
 
 func dataDocumentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	name := d.Get("name").(string)
 
@@ -34,7 +34,7 @@ func DataSourceCallerIdentity() *schema.Resource {
 }
 
 func dataSourceCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).STSConn
+	client := meta.(*conns.AWSClient).STSConn()
 
 	log.Printf("[DEBUG] Reading Caller Identity")
 	res, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
@@ -26,7 +26,7 @@ func sweepServers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TransferConn
+	conn := client.(*conns.AWSClient).TransferConn()
 	input := &transfer.ListServersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -42,7 +42,7 @@ func sweepServers(region string) error {
 			d.Set("force_destroy", true) // In lieu of an aws_transfer_user sweeper.
 			d.Set("identity_provider_type", server.IdentityProviderType)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_transfer_server")))
 		}
 
 		return !lastPage
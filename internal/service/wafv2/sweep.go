@@ -54,7 +54,7 @@ func sweepIPSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -106,7 +106,7 @@ func sweepRegexPatternSets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -158,7 +158,7 @@ func sweepRuleGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -212,7 +212,7 @@ func sweepWebACLs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).WAFV2Conn
+	conn := client.(*conns.AWSClient).WAFV2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -249,7 +249,7 @@ func sweepWebACLs(region string) error {
 			d.Set("name", name)
 			d.Set("scope", input.Scope)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client, sweep.WithTypeName("aws_wafv2_web_acl")))
 		}
 
 		return !lastPage
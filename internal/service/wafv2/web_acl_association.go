@@ -55,7 +55,7 @@ func ResourceWebACLAssociation() *schema.Resource {
 }
 
 func resourceWebACLAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 	resourceArn := d.Get("resource_arn").(string)
 	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.AssociateWebACLInput{
@@ -87,7 +87,7 @@ func resourceWebACLAssociationCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 	resourceArn := d.Get("resource_arn").(string)
 	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.GetWebACLForResourceInput{
@@ -113,7 +113,7 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceWebACLAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	log.Printf("[INFO] Deleting WAFv2 Web ACL Association %s", d.Id())
 
@@ -0,0 +1,322 @@
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// EnvVarConcurrency is the maximum number of resources of a type that are deleted concurrently.
+	EnvVarConcurrency = "SWEEP_CONCURRENCY"
+
+	// EnvVarDryRun causes sweepers to list, but not delete, resources when set to a true value.
+	EnvVarDryRun = "SWEEP_DRY_RUN"
+
+	// EnvVarNameFilter is a comma-separated list of patterns, where `*` matches any characters,
+	// limiting sweeping to resources with a matching name or ID.
+	EnvVarNameFilter = "SWEEP_NAME_FILTER"
+
+	// EnvVarReportFile is the file that dry-run results and reports are appended to, as JSON Lines,
+	// instead of being written to standard output.
+	EnvVarReportFile = "SWEEP_REPORT_FILE"
+
+	// EnvVarTagFilter is a comma-separated list of `key=pattern` tag filters, where `*` matches any characters,
+	// limiting sweeping to resources with a matching tag.
+	EnvVarTagFilter = "SWEEP_TAG_FILTER"
+
+	DefaultConcurrency = 10
+)
+
+const (
+	ActionDelete = "delete"
+	ActionSkip   = "skip"
+)
+
+// Options configures how SweepOrchestrator deletes resources.
+type Options struct {
+	Concurrency int
+	DryRun      bool
+	NameFilters []*regexp.Regexp
+	ReportFile  string
+	TagFilters  map[string][]*regexp.Regexp
+}
+
+// OptionsFromEnv returns sweeping options from the SWEEP_* environment variables.
+func OptionsFromEnv() (*Options, error) {
+	opts := &Options{
+		Concurrency: DefaultConcurrency,
+		ReportFile:  os.Getenv(EnvVarReportFile),
+	}
+
+	if v := os.Getenv(EnvVarConcurrency); v != "" {
+		n, err := strconv.Atoi(v)
+
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("environment variable %s must be a positive integer, got %q", EnvVarConcurrency, v)
+		}
+
+		opts.Concurrency = n
+	}
+
+	if v := os.Getenv(EnvVarDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", EnvVarDryRun, err)
+		}
+
+		opts.DryRun = dryRun
+	}
+
+	for _, pattern := range splitList(os.Getenv(EnvVarNameFilter)) {
+		opts.NameFilters = append(opts.NameFilters, globRegexp(pattern))
+	}
+
+	for _, filter := range splitList(os.Getenv(EnvVarTagFilter)) {
+		parts := strings.SplitN(filter, "=", 2)
+
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("environment variable %s: invalid tag filter %q, expected key=pattern", EnvVarTagFilter, filter)
+		}
+
+		if opts.TagFilters == nil {
+			opts.TagFilters = make(map[string][]*regexp.Regexp)
+		}
+
+		opts.TagFilters[parts[0]] = append(opts.TagFilters[parts[0]], globRegexp(parts[1]))
+	}
+
+	return opts, nil
+}
+
+// Filtered returns whether deletion is limited to resources matching a name or tag filter.
+func (o *Options) Filtered() bool {
+	return len(o.NameFilters) > 0 || len(o.TagFilters) > 0
+}
+
+// Matches returns whether a resource with the specified names and tags may be swept.
+// Without filters, every resource matches.
+func (o *Options) Matches(names []string, tags map[string]string) bool {
+	if !o.Filtered() {
+		return true
+	}
+
+	for _, name := range names {
+		for _, re := range o.NameFilters {
+			if re.MatchString(name) {
+				return true
+			}
+		}
+	}
+
+	for key, value := range tags {
+		for _, re := range o.TagFilters[key] {
+			if re.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Record is a resource considered for sweeping, as listed in dry-run output.
+type Record struct {
+	Action string            `json:"action"`
+	ID     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	Region string            `json:"region,omitempty"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Type   string            `json:"type"`
+}
+
+// Report is the outcome of sweeping the resources of a type.
+// In dry-run mode, Deleted counts the resources that would be deleted.
+type Report struct {
+	Deleted int    `json:"deleted"`
+	DryRun  bool   `json:"dry_run"`
+	Failed  int    `json:"failed"`
+	Region  string `json:"region,omitempty"`
+	Skipped int    `json:"skipped"`
+	Type    string `json:"type"`
+}
+
+type sweepable struct {
+	record Record
+	delete func() error
+}
+
+// orchestrate deletes the resources, applying the filters, concurrency limit and dry-run mode from `opts`,
+// and reports the outcome for each resource type.
+func orchestrate(ctx context.Context, opts *Options, resources []*sweepable) error {
+	var g multierror.Group
+	var mu sync.Mutex
+	var records []Record
+	reports := make(map[string]*Report)
+	semaphores := make(map[string]chan struct{})
+
+	for _, r := range resources {
+		r := r
+		typeName := r.record.Type
+
+		report, ok := reports[typeName]
+		if !ok {
+			report = &Report{
+				DryRun: opts.DryRun,
+				Region: r.record.Region,
+				Type:   typeName,
+			}
+			reports[typeName] = report
+			semaphores[typeName] = make(chan struct{}, opts.Concurrency)
+		}
+
+		names := []string{r.record.ID}
+		if r.record.Name != "" {
+			names = append(names, r.record.Name)
+		}
+
+		if !opts.Matches(names, r.record.Tags) {
+			log.Printf("[DEBUG] Skipping %s (%s): does not match sweep filters", typeName, r.record.ID)
+			report.Skipped++
+
+			if opts.DryRun {
+				r.record.Action = ActionSkip
+				records = append(records, r.record)
+			}
+
+			continue
+		}
+
+		if opts.DryRun {
+			report.Deleted++
+			r.record.Action = ActionDelete
+			records = append(records, r.record)
+
+			continue
+		}
+
+		semaphore := semaphores[typeName]
+
+		g.Go(func() error {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				mu.Lock()
+				report.Failed++
+				mu.Unlock()
+
+				return ctx.Err()
+			}
+			defer func() { <-semaphore }()
+
+			err := r.delete()
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				report.Failed++
+
+				return fmt.Errorf("error sweeping %s (%s): %w", typeName, r.record.ID, err)
+			}
+
+			report.Deleted++
+
+			return nil
+		})
+	}
+
+	err := g.Wait().ErrorOrNil()
+
+	typeNames := make([]string, 0, len(reports))
+	for typeName := range reports {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	var output []interface{}
+
+	for _, record := range records {
+		output = append(output, record)
+	}
+
+	for _, typeName := range typeNames {
+		report := reports[typeName]
+
+		log.Printf("[INFO] Sweeper report for %s (dry run: %t): %d deleted, %d skipped, %d failed", typeName, report.DryRun, report.Deleted, report.Skipped, report.Failed)
+
+		output = append(output, report)
+	}
+
+	if writeErr := opts.write(output); writeErr != nil {
+		err = multierror.Append(err, writeErr)
+	}
+
+	return err
+}
+
+// write writes each value as a line of JSON to the report file or, for dry runs without a report file, to standard output.
+func (o *Options) write(values []interface{}) error {
+	var w io.Writer
+
+	switch {
+	case o.ReportFile != "":
+		f, err := os.OpenFile(o.ReportFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+
+		if err != nil {
+			return fmt.Errorf("error opening sweeper report file (%s): %w", o.ReportFile, err)
+		}
+
+		defer f.Close()
+
+		w = f
+	case o.DryRun:
+		w = os.Stdout
+	default:
+		return nil
+	}
+
+	encoder := json.NewEncoder(w)
+
+	for _, v := range values {
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("error writing sweeper report: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func splitList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+// globRegexp returns a regular expression matching the whole of a string against a pattern where `*` matches any characters.
+func globRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
@@ -0,0 +1,326 @@
+package sweep
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func setenv(t *testing.T, key, value string) {
+	t.Helper()
+
+	old, ok := os.LookupEnv(key)
+
+	os.Setenv(key, value)
+
+	t.Cleanup(func() {
+		if ok {
+			os.Setenv(key, old)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestOptionsFromEnv(t *testing.T) {
+	setenv(t, EnvVarConcurrency, "3")
+	setenv(t, EnvVarDryRun, "true")
+	setenv(t, EnvVarNameFilter, "tf-acc-test-*, tf_acc_test_*")
+	setenv(t, EnvVarTagFilter, "Name=tf-acc-test-*")
+
+	opts, err := OptionsFromEnv()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := opts.Concurrency, 3; got != expected {
+		t.Errorf("got concurrency %d, expected %d", got, expected)
+	}
+
+	if !opts.DryRun {
+		t.Error("expected dry run")
+	}
+
+	if got, expected := len(opts.NameFilters), 2; got != expected {
+		t.Errorf("got %d name filters, expected %d", got, expected)
+	}
+
+	if got, expected := len(opts.TagFilters["Name"]), 1; got != expected {
+		t.Errorf("got %d Name tag filters, expected %d", got, expected)
+	}
+}
+
+func TestOptionsFromEnvInvalid(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Key   string
+		Value string
+	}{
+		{
+			Name:  "concurrency not a number",
+			Key:   EnvVarConcurrency,
+			Value: "many",
+		},
+		{
+			Name:  "concurrency zero",
+			Key:   EnvVarConcurrency,
+			Value: "0",
+		},
+		{
+			Name:  "dry run not a bool",
+			Key:   EnvVarDryRun,
+			Value: "maybe",
+		},
+		{
+			Name:  "tag filter without pattern",
+			Key:   EnvVarTagFilter,
+			Value: "Name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			setenv(t, testCase.Key, testCase.Value)
+
+			if _, err := OptionsFromEnv(); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestOptionsMatches(t *testing.T) {
+	setenv(t, EnvVarNameFilter, "tf-acc-test-*")
+	setenv(t, EnvVarTagFilter, "Name=tf-acc-test-*,Owner=ci")
+
+	opts, err := OptionsFromEnv()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Name     string
+		Names    []string
+		Tags     map[string]string
+		Expected bool
+	}{
+		{
+			Name:     "name matches",
+			Names:    []string{"vpc-12345678", "tf-acc-test-1234"},
+			Expected: true,
+		},
+		{
+			Name:     "name prefix only",
+			Names:    []string{"prod-tf-acc-test-1234"},
+			Expected: false,
+		},
+		{
+			Name:     "tag matches",
+			Names:    []string{"vpc-12345678"},
+			Tags:     map[string]string{"Name": "tf-acc-test-1234"},
+			Expected: true,
+		},
+		{
+			Name:     "exact tag value",
+			Names:    []string{"vpc-12345678"},
+			Tags:     map[string]string{"Owner": "ci"},
+			Expected: true,
+		},
+		{
+			Name:     "no match",
+			Names:    []string{"vpc-12345678"},
+			Tags:     map[string]string{"Name": "production", "Owner": "ci-team"},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := opts.Matches(testCase.Names, testCase.Tags); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestOptionsMatchesUnfiltered(t *testing.T) {
+	opts := &Options{}
+
+	if !opts.Matches([]string{"anything"}, nil) {
+		t.Error("expected match without filters")
+	}
+}
+
+func readJSONLines(t *testing.T, filename string) []map[string]interface{} {
+	t.Helper()
+
+	f, err := os.Open(filename)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	defer f.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		var line map[string]interface{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func TestOrchestrateDryRun(t *testing.T) {
+	reportFile := filepath.Join(t.TempDir(), "report.jsonl")
+	opts := &Options{
+		Concurrency: 1,
+		DryRun:      true,
+		NameFilters: []*regexp.Regexp{globRegexp("tf-acc-test-*")},
+		ReportFile:  reportFile,
+	}
+
+	var deletes int32
+	deleteFunc := func() error {
+		atomic.AddInt32(&deletes, 1)
+
+		return nil
+	}
+
+	resources := []*sweepable{
+		{record: Record{Type: "ec2.VPC", ID: "vpc-1", Tags: map[string]string{"Name": "tf-acc-test-1"}}, delete: deleteFunc},
+		{record: Record{Type: "ec2.VPC", ID: "vpc-2"}, delete: deleteFunc},
+		{record: Record{Type: "sqs.Queue", ID: "https://queue/tf-acc-test-1", Name: "tf-acc-test-1"}, delete: deleteFunc},
+	}
+
+	if err := orchestrate(context.Background(), opts, resources); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if deletes != 0 {
+		t.Errorf("got %d deletes in dry run", deletes)
+	}
+
+	lines := readJSONLines(t, reportFile)
+
+	if got, expected := len(lines), 5; got != expected {
+		t.Fatalf("got %d report lines, expected %d: %v", got, expected, lines)
+	}
+
+	for i, expected := range []string{ActionSkip, ActionSkip, ActionDelete} {
+		if got := lines[i]["action"]; got != expected {
+			t.Errorf("line %d: got action %v, expected %s", i, got, expected)
+		}
+	}
+
+	if got := lines[3]; got["type"] != "ec2.VPC" || got["deleted"] != 0.0 || got["skipped"] != 2.0 || got["dry_run"] != true {
+		t.Errorf("unexpected report: %v", got)
+	}
+
+	if got := lines[4]; got["type"] != "sqs.Queue" || got["deleted"] != 1.0 || got["skipped"] != 0.0 {
+		t.Errorf("unexpected report: %v", got)
+	}
+}
+
+func TestOrchestrateConcurrency(t *testing.T) {
+	reportFile := filepath.Join(t.TempDir(), "report.jsonl")
+	opts := &Options{
+		Concurrency: 2,
+		ReportFile:  reportFile,
+	}
+
+	var mu sync.Mutex
+	running := make(map[string]int)
+	maxRunning := make(map[string]int)
+	release := make(chan struct{})
+
+	newSweepable := func(typeName, id string, err error) *sweepable {
+		return &sweepable{
+			record: Record{Type: typeName, ID: id},
+			delete: func() error {
+				mu.Lock()
+				running[typeName]++
+				if running[typeName] > maxRunning[typeName] {
+					maxRunning[typeName] = running[typeName]
+				}
+				mu.Unlock()
+
+				<-release
+
+				mu.Lock()
+				running[typeName]--
+				mu.Unlock()
+
+				return err
+			},
+		}
+	}
+
+	var resources []*sweepable
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		resources = append(resources, newSweepable("ec2.VPC", id, nil))
+	}
+	resources = append(resources, newSweepable("sqs.Queue", "q1", nil))
+	resources = append(resources, newSweepable("sqs.Queue", "q2", errors.New("boom")))
+
+	done := make(chan error)
+	go func() {
+		done <- orchestrate(context.Background(), opts, resources)
+	}()
+
+	// Wait for the first deletions to start, then check that no more of the same type start.
+	for {
+		mu.Lock()
+		n := running["ec2.VPC"]
+		mu.Unlock()
+
+		if n == 2 {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	close(release)
+
+	if err := <-done; err == nil {
+		t.Error("expected error")
+	}
+
+	if got := maxRunning["ec2.VPC"]; got > 2 {
+		t.Errorf("got %d concurrent deletions, expected at most 2", got)
+	}
+
+	lines := readJSONLines(t, reportFile)
+
+	if got, expected := len(lines), 2; got != expected {
+		t.Fatalf("got %d report lines, expected %d: %v", got, expected, lines)
+	}
+
+	if got := lines[0]; got["type"] != "ec2.VPC" || got["deleted"] != 5.0 || got["failed"] != 0.0 {
+		t.Errorf("unexpected report: %v", got)
+	}
+
+	if got := lines[1]; got["type"] != "sqs.Queue" || got["deleted"] != 1.0 || got["failed"] != 1.0 {
+		t.Errorf("unexpected report: %v", got)
+	}
+}
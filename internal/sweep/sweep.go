@@ -9,12 +9,10 @@ import (
 	"log"
 	"os"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,6 +32,10 @@ const defaultSweeperAssumeRoleDurationSeconds = 3600
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// sweeperDeleteClients are the clients that SweepOrchestrator deletes resources with, keyed by the read-only
+// client from SweeperClients that sweepers were given, when deletion is limited by a name or tag filter.
+var sweeperDeleteClients = make(map[interface{}]interface{})
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
@@ -53,30 +55,66 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		}
 	}
 
+	opts, err := OptionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	// Only SweepOrchestrator matches resources against the name and tag filters, so sweepers that delete resources
+	// directly must not delete anything in a dry run or when deletion is filtered.
+	readOnly := opts.DryRun || opts.Filtered()
+
+	client, err := sweeperClient(region, readOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if readOnly && !opts.DryRun {
+		log.Printf("[WARN] %s or %s is set: only sweepers that use SweepOrchestrator delete resources", EnvVarNameFilter, EnvVarTagFilter)
+
+		deleteClient, err := sweeperClient(region, false)
+		if err != nil {
+			return nil, err
+		}
+
+		sweeperDeleteClients[client] = deleteClient
+	}
+
+	SweeperClients[region] = client
+
+	return client, nil
+}
+
+func sweeperClient(region string, readOnly bool) (interface{}, error) {
 	conf := &conns.Config{
 		MaxRetries: 5,
 		Region:     region,
+		ReadOnly:   readOnly,
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
-		conf.AssumeRoleARN = role
+		assumeRole := conns.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRoleDurationSeconds = defaultSweeperAssumeRoleDurationSeconds
+		assumeRole.DurationSeconds = defaultSweeperAssumeRoleDurationSeconds
 		if v := os.Getenv(conns.EnvVarAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleDuration, err)
 			}
-			conf.AssumeRoleDurationSeconds = d
+			assumeRole.DurationSeconds = d
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleExternalID); v != "" {
-			conf.AssumeRoleExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleSessionName); v != "" {
-			conf.AssumeRoleSessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = []conns.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -85,8 +123,6 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		return nil, fmt.Errorf("error getting AWS client: %w", err)
 	}
 
-	SweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -94,51 +130,132 @@ type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
+	tags     map[string]string
+	typeName string
+}
+
+// SweepResourceOption configures a SweepResource.
+type SweepResourceOption func(*SweepResource)
+
+// WithTypeName sets the Terraform resource type, e.g. `aws_vpc`, that the resource is reported and
+// its deletion concurrency limited under.
+func WithTypeName(typeName string) SweepResourceOption {
+	return func(sr *SweepResource) {
+		sr.typeName = typeName
+	}
 }
 
-func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
-	return &SweepResource{
+// WithTags sets the resource's tags, as listed by the sweeper, that SWEEP_TAG_FILTER is matched against.
+func WithTags(tags map[string]string) SweepResourceOption {
+	return func(sr *SweepResource) {
+		sr.tags = tags
+	}
+}
+
+func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}, optFns ...SweepResourceOption) *SweepResource {
+	sr := &SweepResource{
 		d:        d,
 		meta:     meta,
 		resource: resource,
 	}
+
+	for _, optFn := range optFns {
+		optFn(sr)
+	}
+
+	return sr
 }
 
 func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the resources, retrying throttled deletions.
+// The SWEEP_* environment variables (see OptionsFromEnv) configure a dry run, name and tag filters and
+// the number of resources of each type deleted concurrently.
+// Resources of all types are deleted concurrently; resources that depend on others are deleted first only
+// by sweepers' resource.Sweeper Dependencies.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
-	var g multierror.Group
+	opts, err := OptionsFromEnv()
+
+	if err != nil {
+		return err
+	}
+
+	resources := make([]*sweepable, 0, len(sweepResources))
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		// Resources listed with a read-only client are deleted with its writable counterpart.
+		if meta, ok := sweeperDeleteClients[sweepResource.meta]; ok {
+			sweepResource.meta = meta
+		}
+
+		resources = append(resources, &sweepable{
+			record: sweepResource.record(),
+			delete: func() error {
+				err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+					err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
+					if err != nil {
+						if tfresource.Throttled(err) {
+							log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+							return resource.RetryableError(err)
+						}
+
+						return resource.NonRetryableError(err)
 					}
 
-					return resource.NonRetryableError(err)
+					return nil
+				})
+
+				if tfresource.TimedOut(err) {
+					err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 				}
 
-				return nil
-			})
+				return err
+			},
+		})
+	}
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
-			}
+	return orchestrate(ctx, opts, resources)
+}
 
-			return err
-		})
+// record returns the type, ID, name and tags of the resource to be swept.
+func (sr *SweepResource) record() Record {
+	record := Record{
+		ID:   sr.d.Id(),
+		Tags: sr.tags,
+		Type: sr.typeName,
 	}
 
-	return g.Wait().ErrorOrNil()
+	if record.Type == "" {
+		record.Type = "unknown"
+	}
+
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		record.Region = client.Region
+	}
+
+	if _, ok := sr.resource.Schema["name"]; ok {
+		if v, ok := sr.d.Get("name").(string); ok {
+			record.Name = v
+		}
+	}
+
+	return record
+}
+
+// skipSweepErrorServices are the services whose unsupported API call errors are skipped by SkipSweepError
+// for all sweepers, as the error does not identify the service that returned it.
+var skipSweepErrorServices = []string{
+	"api.ecr-public",
+	"apigateway",
+	"elasticache",
+	"elasticmapreduce",
+	"rds",
+	"sns",
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -148,19 +265,6 @@ func SkipSweepError(err error) bool {
 	if tfawserr.ErrMessageContains(err, "RequestError", "send request failed") {
 		return true
 	}
-	// Ignore unsupported API calls
-	if tfawserr.ErrCodeEquals(err, "UnsupportedOperation") {
-		return true
-	}
-	// Ignore more unsupported API calls
-	// InvalidParameterValue: Use of cache security groups is not permitted in this API version for your account.
-	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "not permitted in this API version for your account") {
-		return true
-	}
-	// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
-	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Access Denied to API Version") {
-		return true
-	}
 	// GovCloud has endpoints that respond with (no message provided):
 	// AccessDeniedException:
 	// Since acceptance test sweepers are best effort and this response is very common,
@@ -168,20 +272,8 @@ func SkipSweepError(err error) bool {
 	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
 		return true
 	}
-	// Example: BadRequestException: vpc link not supported for region us-gov-west-1
-	if tfawserr.ErrMessageContains(err, "BadRequestException", "not supported") {
-		return true
-	}
-	// Example: InvalidAction: InvalidAction: Operation (ListPlatformApplications) is not supported in this region
-	if tfawserr.ErrMessageContains(err, "InvalidAction", "is not supported in this region") {
-		return true
-	}
-	// Example: InvalidAction: The action DescribeTransitGatewayAttachments is not valid for this web service
-	if tfawserr.ErrMessageContains(err, "InvalidAction", "is not valid") {
-		return true
-	}
-	// For example from GovCloud SES.SetActiveReceiptRuleSet.
-	if tfawserr.ErrMessageContains(err, "InvalidAction", "Unavailable Operation") {
+	// Ignore unsupported API calls
+	if tfresource.ServiceErrorCategoryEquals(err, skipSweepErrorServices, tfresource.ErrorCategoryUnsupportedInRegion) {
 		return true
 	}
 	// For example from us-west-2 Route53 key signing key
@@ -192,18 +284,6 @@ func SkipSweepError(err error) bool {
 	if tfawserr.ErrMessageContains(err, "KeySigningKeyInParentDSRecord", "Due to DNS lookup failure") {
 		return true
 	}
-	// For example from us-gov-west-1 EventBridge archive
-	if tfawserr.ErrMessageContains(err, "UnknownOperationException", "Operation is disabled in this region") {
-		return true
-	}
-	// For example from us-west-2 ECR public repository
-	if tfawserr.ErrMessageContains(err, "UnsupportedCommandException", "command is only supported in") {
-		return true
-	}
-	// For example from us-west-1 EMR studio
-	if tfawserr.ErrMessageContains(err, "ValidationException", "Account is not whitelisted to use this feature") {
-		return true
-	}
 	return false
 }
 
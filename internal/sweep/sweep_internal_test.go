@@ -0,0 +1,137 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestSkipSweepError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		// Missing endpoints, unsupported API calls, including service specific ones, and Route 53 DNSSEC errors sweeping can't resolve.
+		{Name: "missing endpoint", Err: awserr.New("RequestError", "send request failed", nil), Expected: true},
+		{Name: "unsupported operation", Err: awserr.New("UnsupportedOperation", "", nil), Expected: true},
+		{Name: "elasticache security groups", Err: awserr.New("InvalidParameterValue", "Use of cache security groups is not permitted in this API version for your account.", nil), Expected: true},
+		{Name: "rds global databases", Err: awserr.New("InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases", nil), Expected: true},
+		{Name: "GovCloud access denied", Err: awserr.New("AccessDeniedException", "", nil), Expected: true},
+		{Name: "apigateway vpc link", Err: awserr.New("BadRequestException", "vpc link not supported for region us-gov-west-1", nil), Expected: true},
+		{Name: "sns platform applications", Err: awserr.New("InvalidAction", "InvalidAction: Operation (ListPlatformApplications) is not supported in this region", nil), Expected: true},
+		{Name: "invalid action", Err: awserr.New("InvalidAction", "The action DescribeTransitGatewayAttachments is not valid for this web service", nil), Expected: true},
+		{Name: "unavailable operation", Err: awserr.New("InvalidAction", "Unavailable Operation", nil), Expected: true},
+		{Name: "route53 key signing key", Err: awserr.New("InvalidKeySigningKeyStatus", "The key-signing key cannot be deleted because it is active", nil), Expected: true},
+		{Name: "route53 DS record", Err: awserr.New("KeySigningKeyInParentDSRecord", "Due to DNS lookup failure", nil), Expected: true},
+		{Name: "events archive", Err: awserr.New("UnknownOperationException", "Operation is disabled in this region", nil), Expected: true},
+		{Name: "ecr public", Err: awserr.New("UnsupportedCommandException", "This command is only supported in us-east-1", nil), Expected: true},
+		{Name: "emr studio", Err: awserr.New("ValidationException", "Account is not whitelisted to use this feature", nil), Expected: true},
+
+		// Conditions that were not skipped.
+		{Name: "other error", Err: errors.New("test"), Expected: false},
+		{Name: "access denied", Err: awserr.New("AccessDenied", "", nil), Expected: false},
+		{Name: "unauthorized operation", Err: awserr.New("UnauthorizedOperation", "", nil), Expected: false},
+		{Name: "invalid action other message", Err: awserr.New("InvalidAction", "Missing required parameter", nil), Expected: false},
+		{Name: "unknown operation other message", Err: awserr.New("UnknownOperationException", "test", nil), Expected: false},
+		{Name: "invalid parameter value other message", Err: awserr.New("InvalidParameterValue", "Invalid engine", nil), Expected: false},
+		{Name: "throttled", Err: awserr.New("ThrottlingException", "Rate exceeded", nil), Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := SkipSweepError(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSweepResourceRecord(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	d := r.Data(nil)
+	d.SetId("vpc-12345678")
+	d.Set("name", "tf-acc-test-12345")
+
+	testCases := []struct {
+		Name     string
+		OptFns   []SweepResourceOption
+		Expected Record
+	}{
+		{
+			Name:     "no options",
+			Expected: Record{ID: "vpc-12345678", Name: "tf-acc-test-12345", Type: "unknown"},
+		},
+		{
+			Name:     "type name",
+			OptFns:   []SweepResourceOption{WithTypeName("aws_vpc")},
+			Expected: Record{ID: "vpc-12345678", Name: "tf-acc-test-12345", Type: "aws_vpc"},
+		},
+		{
+			Name:     "tags",
+			OptFns:   []SweepResourceOption{WithTypeName("aws_vpc"), WithTags(map[string]string{"Name": "tf-acc-test-12345"})},
+			Expected: Record{ID: "vpc-12345678", Name: "tf-acc-test-12345", Tags: map[string]string{"Name": "tf-acc-test-12345"}, Type: "aws_vpc"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := NewSweepResource(r, d, nil, testCase.OptFns...).record()
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+		})
+	}
+
+	opts := &Options{TagFilters: map[string][]*regexp.Regexp{"Name": {globRegexp("tf-acc-test-*")}}}
+
+	if record := NewSweepResource(r, d, nil, WithTags(map[string]string{"Name": "tf-acc-test-12345"})).record(); !opts.Matches([]string{record.ID}, record.Tags) {
+		t.Error("expected listed tags to match tag filter")
+	}
+}
+
+func TestSweepOrchestratorDeleteClient(t *testing.T) {
+	readOnly, deleteClient := &conns.AWSClient{}, &conns.AWSClient{}
+
+	sweeperDeleteClients[readOnly] = deleteClient
+	t.Cleanup(func() {
+		delete(sweeperDeleteClients, readOnly)
+	})
+
+	var got interface{}
+
+	r := &schema.Resource{
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			got = meta
+			return nil
+		},
+	}
+
+	d := r.Data(nil)
+	d.SetId("test")
+
+	if err := SweepOrchestrator([]*SweepResource{NewSweepResource(r, d, readOnly)}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != deleteClient {
+		t.Error("expected resource to be deleted with the read-only client's delete client")
+	}
+}
@@ -0,0 +1,253 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	DefaultTemplateVariableAccountID    = "account_id"
+	DefaultTemplateVariablePartition    = "partition"
+	DefaultTemplateVariableRegion       = "region"
+	DefaultTemplateVariableResourceType = "resource_type"
+	DefaultTemplateVariableTimestamp    = "timestamp"
+)
+
+func DefaultTemplateVariable_Values() []string {
+	return []string{
+		DefaultTemplateVariableAccountID,
+		DefaultTemplateVariablePartition,
+		DefaultTemplateVariableRegion,
+		DefaultTemplateVariableResourceType,
+		DefaultTemplateVariableTimestamp,
+	}
+}
+
+var defaultTemplateVariableRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// defaultTemplateVariableValueRegexps match the values each template variable may be replaced with.
+var defaultTemplateVariableValueRegexps = map[string]string{
+	DefaultTemplateVariableAccountID:    `[0-9]*`,
+	DefaultTemplateVariablePartition:    `aws[a-z-]*`,
+	DefaultTemplateVariableRegion:       `[a-z0-9-]*`,
+	DefaultTemplateVariableResourceType: `aws_[a-z0-9_]+`,
+	DefaultTemplateVariableTimestamp:    `[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}Z`,
+}
+
+// DefaultTemplateData contains the values of the variables in default tag value templates.
+type DefaultTemplateData struct {
+	AccountID    string
+	Partition    string
+	Region       string
+	ResourceType string
+	// Timestamp is the time, in RFC 3339 format, the tags are resolved at.
+	Timestamp string
+}
+
+func (data *DefaultTemplateData) value(variable string) string {
+	switch variable {
+	case DefaultTemplateVariableAccountID:
+		return data.AccountID
+	case DefaultTemplateVariablePartition:
+		return data.Partition
+	case DefaultTemplateVariableRegion:
+		return data.Region
+	case DefaultTemplateVariableResourceType:
+		return data.ResourceType
+	case DefaultTemplateVariableTimestamp:
+		return data.Timestamp
+	}
+
+	return ""
+}
+
+// ValidateDefaultTemplate returns an error if the tag value template contains unsupported variables.
+func ValidateDefaultTemplate(template string) error {
+	for _, match := range defaultTemplateVariableRegexp.FindAllStringSubmatch(template, -1) {
+		if _, ok := defaultTemplateVariableValueRegexps[match[1]]; !ok {
+			return fmt.Errorf("unsupported variable %q in tag value template %q, expected one of: %s", match[0], template, strings.Join(DefaultTemplateVariable_Values(), ", "))
+		}
+	}
+
+	return nil
+}
+
+// ValidateSetOnceKeys returns an error if a tag value template containing the `${timestamp}` variable,
+// which resolves to a different value in every plan, has a key that is not in the set-once keys.
+func (dc *DefaultConfig) ValidateSetOnceKeys() error {
+	if dc == nil {
+		return nil
+	}
+
+	keys := dc.Tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := dc.Tags[k]
+
+		if v == nil || v.Value == nil || !strings.Contains(*v.Value, "${"+DefaultTemplateVariableTimestamp+"}") {
+			continue
+		}
+
+		if _, ok := dc.SetOnceKeys[k]; !ok {
+			return fmt.Errorf("tag (%s): value template %q contains the ${%s} variable, so the tag key must be in set_once_keys", k, *v.Value, DefaultTemplateVariableTimestamp)
+		}
+	}
+
+	return nil
+}
+
+// isDefaultTemplate returns whether the tag value contains template variables.
+func isDefaultTemplate(value string) bool {
+	return defaultTemplateVariableRegexp.MatchString(value)
+}
+
+// defaultTemplateRegexp returns a regular expression matching the values the tag value template may resolve to.
+func defaultTemplateRegexp(template string) *regexp.Regexp {
+	var builder strings.Builder
+	last := 0
+
+	builder.WriteString("^")
+
+	for _, loc := range defaultTemplateVariableRegexp.FindAllStringSubmatchIndex(template, -1) {
+		builder.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		builder.WriteString(defaultTemplateVariableValueRegexps[template[loc[2]:loc[3]]])
+		last = loc[1]
+	}
+
+	builder.WriteString(regexp.QuoteMeta(template[last:]))
+	builder.WriteString("$")
+
+	return regexp.MustCompile(builder.String())
+}
+
+// DynamicKeys returns the keys of the DefaultConfig's Tags whose values are templates or are only set once.
+func (dc *DefaultConfig) DynamicKeys() []string {
+	if dc == nil {
+		return nil
+	}
+
+	var keys []string
+
+	for k, v := range dc.Tags {
+		if _, ok := dc.SetOnceKeys[k]; ok || (v != nil && v.Value != nil && isDefaultTemplate(*v.Value)) {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
+// Resolve returns a copy of the DefaultConfig with the variables in tag values replaced.
+// Tags with set-once keys keep their values from the prior tags, if present.
+func (dc *DefaultConfig) Resolve(data *DefaultTemplateData, priorTags KeyValueTags) *DefaultConfig {
+	if dc == nil || dc.Tags == nil {
+		return dc
+	}
+
+	result := &DefaultConfig{
+		SetOnceKeys:  dc.SetOnceKeys,
+		Tags:         make(KeyValueTags, len(dc.Tags)),
+		allTags:      dc.allTags,
+		resourceTags: dc.resourceTags,
+	}
+
+	for k, v := range dc.Tags {
+		if _, ok := dc.SetOnceKeys[k]; ok {
+			if priorValue, ok := priorTags[k]; ok && priorValue != nil && priorValue.Value != nil {
+				result.Tags[k] = priorValue
+				continue
+			}
+		}
+
+		if v == nil || v.Value == nil || !isDefaultTemplate(*v.Value) {
+			result.Tags[k] = v
+			continue
+		}
+
+		value := defaultTemplateVariableRegexp.ReplaceAllStringFunc(*v.Value, func(match string) string {
+			return data.value(match[2 : len(match)-1])
+		})
+
+		result.Tags[k] = &TagData{Value: &value}
+	}
+
+	return result
+}
+
+// ForResource returns a copy of the DefaultConfig for a resource with the specified tags and tags_all.
+// The values of templated and set-once tags are taken from tags_all, as planned or as last read, so that
+// resources merging the default tags into their tags on create use the planned values.
+// Tags with set-once keys are only treated as default tags if not set in tags and unchanged from tags_all.
+func (dc *DefaultConfig) ForResource(resourceTags, allTags KeyValueTags) *DefaultConfig {
+	if dc == nil || dc.Tags == nil {
+		return dc
+	}
+
+	result := &DefaultConfig{
+		SetOnceKeys:  dc.SetOnceKeys,
+		Tags:         make(KeyValueTags, len(dc.Tags)),
+		allTags:      allTags,
+		resourceTags: resourceTags,
+	}
+
+	for k, v := range dc.Tags {
+		result.Tags[k] = v
+	}
+
+	for _, k := range dc.DynamicKeys() {
+		if _, ok := resourceTags[k]; ok {
+			continue
+		}
+
+		if v, ok := allTags[k]; ok && v != nil && v.Value != nil && !isDefaultTemplate(*v.Value) {
+			result.Tags[k] = v
+		}
+	}
+
+	return result
+}
+
+// valueMatches returns whether the tag value may have been set from the DefaultConfig's tag with the same key.
+func (dc *DefaultConfig) valueMatches(k string, v *TagData) bool {
+	defaultVal, ok := dc.Tags[k]
+
+	if !ok {
+		return false
+	}
+
+	if _, ok := dc.SetOnceKeys[k]; ok {
+		// A set-once tag overridden by the resource's tags is not a default tag.
+		if _, ok := dc.resourceTags[k]; ok {
+			return false
+		}
+
+		if priorVal, ok := dc.allTags[k]; ok {
+			return v.Equal(priorVal)
+		}
+	}
+
+	if defaultVal != nil && defaultVal.Value != nil && isDefaultTemplate(*defaultVal.Value) {
+		return v != nil && v.Value != nil && defaultTemplateRegexp(*defaultVal.Value).MatchString(*v.Value)
+	}
+
+	return v.Equal(defaultVal)
+}
+
+type resourceTypeContextKey struct{}
+
+// ContextWithResourceType returns a copy of the context recording the Terraform resource type
+// default tag value templates are resolved for.
+func ContextWithResourceType(ctx context.Context, resourceType string) context.Context {
+	return context.WithValue(ctx, resourceTypeContextKey{}, resourceType)
+}
+
+// ResourceTypeFromContext returns the Terraform resource type recorded in the context, if any.
+func ResourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey{}).(string)
+
+	return v
+}
@@ -0,0 +1,316 @@
+package tags
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestValidateDefaultTemplate(t *testing.T) {
+	testCases := []struct {
+		template    string
+		expectError bool
+	}{
+		{template: "static"},
+		{template: "${resource_type}"},
+		{template: "${account_id}/${region}/${partition}"},
+		{template: "created ${timestamp}"},
+		{template: "${workspace}", expectError: true},
+		{template: "${resource_type}-${}", expectError: true},
+	}
+
+	for _, testCase := range testCases {
+		err := ValidateDefaultTemplate(testCase.template)
+
+		if got := err != nil; got != testCase.expectError {
+			t.Errorf("%s: got error %v, expected error %t", testCase.template, err, testCase.expectError)
+		}
+	}
+}
+
+func TestDefaultConfigValidateSetOnceKeys(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      *DefaultConfig
+		expectError bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:   "static",
+			config: &DefaultConfig{Tags: New(map[string]string{"Owner": "team"})},
+		},
+		{
+			name:   "template without timestamp",
+			config: &DefaultConfig{Tags: New(map[string]string{"Type": "${resource_type}"})},
+		},
+		{
+			name: "timestamp set once",
+			config: &DefaultConfig{
+				SetOnceKeys: New([]string{"CreatedAt"}),
+				Tags:        New(map[string]string{"CreatedAt": "${timestamp}"}),
+			},
+		},
+		{
+			name:        "timestamp not set once",
+			config:      &DefaultConfig{Tags: New(map[string]string{"CreatedAt": "created ${timestamp}"})},
+			expectError: true,
+		},
+		{
+			name: "timestamp with other key set once",
+			config: &DefaultConfig{
+				SetOnceKeys: New([]string{"Owner"}),
+				Tags:        New(map[string]string{"CreatedAt": "${timestamp}", "Owner": "team"}),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.config.ValidateSetOnceKeys()
+
+		if got := err != nil; got != testCase.expectError {
+			t.Errorf("%s: got error %v, expected error %t", testCase.name, err, testCase.expectError)
+		}
+	}
+}
+
+func TestDefaultConfigDynamicKeys(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		SetOnceKeys: New([]interface{}{"CreatedBy"}),
+		Tags: New(map[string]string{
+			"CreatedBy":    "terraform",
+			"Owner":        "finops",
+			"ResourceType": "${resource_type}",
+		}),
+	}
+
+	got := defaultConfig.DynamicKeys()
+	sort.Strings(got)
+
+	if want := []string{"CreatedBy", "ResourceType"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := (*DefaultConfig)(nil).DynamicKeys(); got != nil {
+		t.Errorf("got %v for nil DefaultConfig, want nil", got)
+	}
+}
+
+func TestDefaultConfigResolve(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		SetOnceKeys: New([]interface{}{"CreatedAt"}),
+		Tags: New(map[string]string{
+			"Account":   "${partition}:${account_id}:${region}",
+			"CreatedAt": "${timestamp}",
+			"Owner":     "finops",
+			"Type":      "${resource_type}",
+		}),
+	}
+	data := &DefaultTemplateData{
+		AccountID:    "123456789012",
+		Partition:    "aws",
+		Region:       "us-west-2",
+		ResourceType: "aws_vpc",
+		Timestamp:    "2021-10-01T12:00:00Z",
+	}
+
+	testCases := []struct {
+		name      string
+		priorTags KeyValueTags
+		want      KeyValueTags
+	}{
+		{
+			name: "create",
+			want: New(map[string]string{
+				"Account":   "aws:123456789012:us-west-2",
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+		},
+		{
+			name: "set once",
+			priorTags: New(map[string]string{
+				"CreatedAt": "2020-01-01T00:00:00Z",
+				"Owner":     "devops",
+				"Type":      "aws_subnet",
+			}),
+			want: New(map[string]string{
+				"Account":   "aws:123456789012:us-west-2",
+				"CreatedAt": "2020-01-01T00:00:00Z",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := defaultConfig.Resolve(data, testCase.priorTags)
+
+			if !got.Tags.Equal(testCase.want) {
+				t.Errorf("got %s, want %s", got.Tags, testCase.want)
+			}
+		})
+	}
+
+	if got := defaultConfig.Tags["Type"].Value; *got != "${resource_type}" {
+		t.Errorf("expected DefaultConfig not to be modified, got %s", *got)
+	}
+}
+
+func TestKeyValueTagsRemoveDefaultConfigTemplates(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		SetOnceKeys: New([]interface{}{"CreatedBy"}),
+		Tags: New(map[string]string{
+			"Account":   "${account_id}",
+			"CreatedAt": "${timestamp}",
+			"CreatedBy": "terraform",
+			"Type":      "${resource_type}",
+		}),
+	}
+
+	tags := New(map[string]string{
+		"Account":   "123456789012",
+		"CreatedAt": "yesterday",
+		"CreatedBy": "someone",
+		"Name":      "example",
+		"Type":      "aws_vpc",
+	})
+
+	// Without the resource's prior tags, a set-once tag is only a default tag if it has the default value.
+	got := tags.RemoveDefaultConfig(defaultConfig)
+	want := New(map[string]string{
+		"CreatedAt": "yesterday",
+		"CreatedBy": "someone",
+		"Name":      "example",
+	})
+
+	if !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestDefaultConfigForResource(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		SetOnceKeys: New([]interface{}{"CreatedAt", "CreatedBy"}),
+		Tags: New(map[string]string{
+			"CreatedAt": "${timestamp}",
+			"CreatedBy": "terraform",
+			"Owner":     "finops",
+			"Type":      "${resource_type}",
+		}),
+	}
+
+	testCases := []struct {
+		name         string
+		resourceTags KeyValueTags
+		allTags      KeyValueTags
+		tags         KeyValueTags
+		wantMerged   KeyValueTags
+		wantRemoved  KeyValueTags
+	}{
+		{
+			name: "planned",
+			allTags: New(map[string]string{
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"CreatedBy": "terraform",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			tags: New(map[string]string{
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"CreatedBy": "terraform",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			wantMerged: New(map[string]string{
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"CreatedBy": "terraform",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			wantRemoved: New(map[string]string{}),
+		},
+		{
+			name: "set once since changed",
+			allTags: New(map[string]string{
+				"CreatedAt": "2020-01-01T00:00:00Z",
+				"CreatedBy": "someone",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			tags: New(map[string]string{
+				"CreatedAt": "2020-01-01T00:00:00Z",
+				"CreatedBy": "someone else",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			wantMerged: New(map[string]string{
+				"CreatedAt": "2020-01-01T00:00:00Z",
+				"CreatedBy": "someone",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			wantRemoved: New(map[string]string{
+				"CreatedBy": "someone else",
+			}),
+		},
+		{
+			name:         "set once overridden",
+			resourceTags: New(map[string]string{"CreatedBy": "terraform"}),
+			allTags: New(map[string]string{
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"CreatedBy": "terraform",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			tags: New(map[string]string{
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"CreatedBy": "terraform",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			wantMerged: New(map[string]string{
+				"CreatedAt": "2021-10-01T12:00:00Z",
+				"CreatedBy": "terraform",
+				"Owner":     "finops",
+				"Type":      "aws_vpc",
+			}),
+			wantRemoved: New(map[string]string{
+				"CreatedBy": "terraform",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dc := defaultConfig.ForResource(testCase.resourceTags, testCase.allTags)
+
+			if got := dc.MergeTags(testCase.resourceTags); !got.Equal(testCase.wantMerged) {
+				t.Errorf("merged: got %s, want %s", got, testCase.wantMerged)
+			}
+
+			if got := testCase.tags.RemoveDefaultConfig(dc); !got.Equal(testCase.wantRemoved) {
+				t.Errorf("removed: got %s, want %s", got, testCase.wantRemoved)
+			}
+		})
+	}
+
+	if got := defaultConfig.Tags["Type"].Value; *got != "${resource_type}" {
+		t.Errorf("expected DefaultConfig not to be modified, got %s", *got)
+	}
+}
+
+func TestResourceTypeFromContext(t *testing.T) {
+	if got := ResourceTypeFromContext(context.Background()); got != "" {
+		t.Errorf("got %q, want empty", got)
+	}
+
+	if got, want := ResourceTypeFromContext(ContextWithResourceType(context.Background(), "aws_vpc")), "aws_vpc"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -20,14 +20,27 @@ const (
 )
 
 // DefaultConfig contains tags to default across all resources.
+// Tag values may be templates, which are resolved for each resource.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	// SetOnceKeys are the keys of tags whose values are only set when a resource is created.
+	SetOnceKeys KeyValueTags
+	Tags        KeyValueTags
+
+	// allTags and resourceTags are the tags_all and tags of the resource the configuration is for, if any.
+	allTags      KeyValueTags
+	resourceTags KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
-	Keys        KeyValueTags
-	KeyPrefixes KeyValueTags
+	// CaseInsensitive is whether Keys, KeyPrefixes and Values match regardless of case.
+	// KeyPatterns and ValuePatterns must be compiled with the "i" flag to do the same.
+	CaseInsensitive bool
+	KeyPatterns     []*regexp.Regexp
+	Keys            KeyValueTags
+	KeyPrefixes     KeyValueTags
+	ValuePatterns   []*regexp.Regexp
+	Values          []string
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -94,12 +107,68 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 		return tags
 	}
 
-	result := tags.IgnorePrefixes(config.KeyPrefixes)
-	result = result.Ignore(config.Keys)
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if config.ignores(k, v) {
+			continue
+		}
+
+		result[k] = v
+	}
 
 	return result
 }
 
+// ignores returns whether the configuration removes the tag.
+func (config *IgnoreConfig) ignores(k string, v *TagData) bool {
+	equal := func(s, t string) bool { return s == t }
+	hasPrefix := strings.HasPrefix
+
+	if config.CaseInsensitive {
+		equal = strings.EqualFold
+		hasPrefix = func(s, prefix string) bool {
+			return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+		}
+	}
+
+	for key := range config.Keys {
+		if equal(k, key) {
+			return true
+		}
+	}
+
+	for prefix := range config.KeyPrefixes {
+		if hasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	for _, re := range config.KeyPatterns {
+		if re.MatchString(k) {
+			return true
+		}
+	}
+
+	if v == nil || v.Value == nil {
+		return false
+	}
+
+	for _, value := range config.Values {
+		if equal(*v.Value, value) {
+			return true
+		}
+	}
+
+	for _, re := range config.ValuePatterns {
+		if re.MatchString(*v.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IgnoreElasticbeanstalk returns non-AWS and non-Elasticbeanstalk tag keys.
 func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	result := make(KeyValueTags)
@@ -472,6 +541,8 @@ func (tags KeyValueTags) Hash() int {
 // however, if all tags present in the DefaultConfig object are equivalent to those
 // in the given KeyValueTags, then the KeyValueTags are returned, effectively
 // bypassing the need to remove differing tags.
+// Values of tags with set-once keys, or with template values any value they may resolve to,
+// are equivalent to those in the DefaultConfig.
 func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
@@ -480,7 +551,7 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		if !dc.valueMatches(k, v) {
 			result[k] = v
 		}
 	}
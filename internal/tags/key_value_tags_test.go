@@ -1,6 +1,7 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -533,6 +534,110 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "keys case sensitive",
+			tags: New(map[string]string{
+				"Key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+			},
+			want: map[string]string{
+				"Key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys and key prefixes case insensitive",
+			tags: New(map[string]string{
+				"KEY1":  "value1",
+				"Other": "value2",
+				"key3":  "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				CaseInsensitive: true,
+				Keys: New([]string{
+					"Key1",
+				}),
+				KeyPrefixes: New([]string{
+					"oTH",
+				}),
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "key patterns",
+			tags: New(map[string]string{
+				"securitytool:scan-id": "value1",
+				"SecurityTool:Owner":   "value2",
+				"key3":                 "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^securitytool:`),
+				},
+			},
+			want: map[string]string{
+				"SecurityTool:Owner": "value2",
+				"key3":               "value3",
+			},
+		},
+		{
+			name: "values",
+			tags: New(map[string]string{
+				"managed-by": "securitytool",
+				"ManagedBy":  "SecurityTool",
+				"key3":       "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Values: []string{
+					"securitytool",
+				},
+			},
+			want: map[string]string{
+				"ManagedBy": "SecurityTool",
+				"key3":      "value3",
+			},
+		},
+		{
+			name: "values case insensitive",
+			tags: New(map[string]string{
+				"managed-by": "securitytool",
+				"ManagedBy":  "SecurityTool",
+				"key3":       "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				CaseInsensitive: true,
+				Values: []string{
+					"securitytool",
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "value patterns",
+			tags: New(map[string]string{
+				"managed-by": "securitytool-v2",
+				"key2":       "value2",
+				"key3":       "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValuePatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^securitytool-`),
+					regexp.MustCompile(`2$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
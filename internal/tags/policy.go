@@ -0,0 +1,189 @@
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	PolicyKeyCaseCamel  = "camel"
+	PolicyKeyCaseLower  = "lower"
+	PolicyKeyCasePascal = "pascal"
+	PolicyKeyCaseUpper  = "upper"
+)
+
+func PolicyKeyCase_Values() []string {
+	return []string{
+		PolicyKeyCaseCamel,
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseUpper,
+	}
+}
+
+var (
+	policyKeyCaseCamelRegexp  = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+	policyKeyCasePascalRegexp = regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+)
+
+// PolicyConfig contains rules that resource tags must follow across all resources.
+type PolicyConfig struct {
+	KeyCase       string
+	KeyRules      map[string]*PolicyKeyRule
+	RequiredKeys  []string
+	ValuePatterns map[string]*regexp.Regexp
+	WarnOnly      bool
+}
+
+// PolicyKeyRule contains the rules of an AWS Organizations tag policy for a tag key,
+// which is compared case-insensitively.
+type PolicyKeyRule struct {
+	// EnforcedFor are the resource types, such as "ec2:instance", AWS rejects non-compliant tags for.
+	EnforcedFor []string
+	// Key is the tag key in the case it must be in.
+	Key string
+	// Values are the allowed tag values, which may contain a "*" wildcard. If empty, any value is allowed.
+	Values []string
+}
+
+// organizationsTagPolicy is the content of an AWS Organizations effective tag policy.
+// Reference: https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_tag-policies-syntax-reference.html
+type organizationsTagPolicy struct {
+	Tags map[string]struct {
+		EnforcedFor []string `json:"enforced_for"`
+		TagKey      string   `json:"tag_key"`
+		TagValue    []string `json:"tag_value"`
+	} `json:"tags"`
+}
+
+// OrganizationsPolicyKeyRules returns the rules, by lower case tag key, of an AWS Organizations effective tag policy.
+func OrganizationsPolicyKeyRules(content string) (map[string]*PolicyKeyRule, error) {
+	var policy organizationsTagPolicy
+
+	if err := json.Unmarshal([]byte(content), &policy); err != nil {
+		return nil, fmt.Errorf("error parsing AWS Organizations tag policy: %w", err)
+	}
+
+	rules := make(map[string]*PolicyKeyRule, len(policy.Tags))
+
+	for k, v := range policy.Tags {
+		key := v.TagKey
+
+		if key == "" {
+			key = k
+		}
+
+		rules[strings.ToLower(key)] = &PolicyKeyRule{
+			EnforcedFor: v.EnforcedFor,
+			Key:         key,
+			Values:      v.TagValue,
+		}
+	}
+
+	return rules, nil
+}
+
+// Allows returns whether the rule allows the tag value.
+func (r *PolicyKeyRule) Allows(value string) bool {
+	if len(r.Values) == 0 {
+		return true
+	}
+
+	for _, v := range r.Values {
+		if parts := strings.SplitN(v, "*", 2); len(parts) == 2 {
+			if strings.HasPrefix(value, parts[0]) && strings.HasSuffix(value[len(parts[0]):], parts[1]) {
+				return true
+			}
+		} else if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Violations returns a description of each of the PolicyConfig's rules the tags do not follow.
+// AWS tags are not checked and tags with a nil value, such as those not known until apply, only need to be present.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []string
+
+	for _, k := range pc.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			violations = append(violations, fmt.Sprintf("required tag %q is missing", k))
+		}
+	}
+
+	tags = tags.IgnoreAWS()
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if !policyKeyCaseMatches(pc.KeyCase, k) {
+			violations = append(violations, fmt.Sprintf("tag key %q is not %s case", k, pc.KeyCase))
+		}
+
+		rule := pc.KeyRules[strings.ToLower(k)]
+
+		if rule != nil && k != rule.Key {
+			violations = append(violations, fmt.Sprintf("tag key %q is not in the case of %q in the AWS Organizations tag policy", k, rule.Key))
+		}
+
+		if tags[k] == nil || tags[k].Value == nil {
+			continue
+		}
+
+		v := *tags[k].Value
+
+		if re, ok := pc.ValuePatterns[k]; ok && !re.MatchString(v) {
+			violations = append(violations, fmt.Sprintf("tag %q value %q does not match %q", k, v, re))
+		}
+
+		if rule != nil && !rule.Allows(v) {
+			violation := fmt.Sprintf("tag %q value %q is not allowed by the AWS Organizations tag policy", k, v)
+
+			if len(rule.EnforcedFor) > 0 {
+				violation += fmt.Sprintf(" (enforced for %s)", strings.Join(rule.EnforcedFor, ", "))
+			}
+
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
+
+// policyKeyCaseMatches returns whether each part of a tag key, separated by ':' or '/', is in the specified case.
+func policyKeyCaseMatches(keyCase, key string) bool {
+	switch keyCase {
+	case PolicyKeyCaseLower:
+		return key == strings.ToLower(key)
+	case PolicyKeyCaseUpper:
+		return key == strings.ToUpper(key)
+	}
+
+	var re *regexp.Regexp
+
+	switch keyCase {
+	case PolicyKeyCaseCamel:
+		re = policyKeyCaseCamelRegexp
+	case PolicyKeyCasePascal:
+		re = policyKeyCasePascalRegexp
+	default:
+		return true
+	}
+
+	for _, part := range strings.FieldsFunc(key, func(r rune) bool { return r == ':' || r == '/' }) {
+		if !re.MatchString(part) {
+			return false
+		}
+	}
+
+	return true
+}
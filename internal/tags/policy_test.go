@@ -0,0 +1,182 @@
+package tags
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         New(map[string]string{"key1": "value1"}),
+		},
+		{
+			name:         "required keys present",
+			policyConfig: &PolicyConfig{RequiredKeys: []string{"CostCenter", "Owner"}},
+			tags:         New(map[string]string{"CostCenter": "1234", "Owner": "finops"}),
+		},
+		{
+			name:         "required keys missing",
+			policyConfig: &PolicyConfig{RequiredKeys: []string{"CostCenter", "Owner"}},
+			tags:         New(map[string]string{"costcenter": "1234"}),
+			want: []string{
+				`required tag "CostCenter" is missing`,
+				`required tag "Owner" is missing`,
+			},
+		},
+		{
+			name: "value patterns",
+			policyConfig: &PolicyConfig{
+				ValuePatterns: map[string]*regexp.Regexp{
+					"CostCenter": regexp.MustCompile(`^[0-9]{4}$`),
+					"Owner":      regexp.MustCompile(`^[a-z]+$`),
+				},
+			},
+			tags: New(map[string]string{"CostCenter": "12345", "Owner": "finops"}),
+			want: []string{
+				`tag "CostCenter" value "12345" does not match "^[0-9]{4}$"`,
+			},
+		},
+		{
+			name: "unknown value",
+			policyConfig: &PolicyConfig{
+				RequiredKeys:  []string{"CostCenter"},
+				ValuePatterns: map[string]*regexp.Regexp{"CostCenter": regexp.MustCompile(`^[0-9]{4}$`)},
+			},
+			tags: KeyValueTags{"CostCenter": nil},
+		},
+		{
+			name:         "pascal case",
+			policyConfig: &PolicyConfig{KeyCase: PolicyKeyCasePascal},
+			tags:         New(map[string]string{"CostCenter": "1234", "team:Owner": "finops", "aws:cloudformation:stack-name": "stack"}),
+			want: []string{
+				`tag key "team:Owner" is not pascal case`,
+			},
+		},
+		{
+			name:         "camel case",
+			policyConfig: &PolicyConfig{KeyCase: PolicyKeyCaseCamel},
+			tags:         New(map[string]string{"costCenter": "1234", "Owner": "finops"}),
+			want: []string{
+				`tag key "Owner" is not camel case`,
+			},
+		},
+		{
+			name:         "lower case",
+			policyConfig: &PolicyConfig{KeyCase: PolicyKeyCaseLower},
+			tags:         New(map[string]string{"cost-center": "1234", "Owner": "finops"}),
+			want: []string{
+				`tag key "Owner" is not lower case`,
+			},
+		},
+		{
+			name:         "upper case",
+			policyConfig: &PolicyConfig{KeyCase: PolicyKeyCaseUpper},
+			tags:         New(map[string]string{"COST_CENTER": "1234", "Owner": "finops"}),
+			want: []string{
+				`tag key "Owner" is not upper case`,
+			},
+		},
+		{
+			name: "organizations policy",
+			policyConfig: &PolicyConfig{
+				KeyRules: map[string]*PolicyKeyRule{
+					"costcenter": {Key: "CostCenter", Values: []string{"100", "2*"}, EnforcedFor: []string{"ec2:instance"}},
+					"owner":      {Key: "Owner"},
+				},
+			},
+			tags: New(map[string]string{"costCenter": "300", "Owner": "finops", "Project": "alpha"}),
+			want: []string{
+				`tag key "costCenter" is not in the case of "CostCenter" in the AWS Organizations tag policy`,
+				`tag "costCenter" value "300" is not allowed by the AWS Organizations tag policy (enforced for ec2:instance)`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestOrganizationsPolicyKeyRules(t *testing.T) {
+	content := `{
+  "tags": {
+    "costcenter": {
+      "tag_key": "CostCenter",
+      "tag_value": ["100", "200*"],
+      "enforced_for": ["ec2:instance", "secretsmanager:*"]
+    },
+    "project": {
+      "tag_key": "Project"
+    }
+  }
+}`
+
+	got, err := OrganizationsPolicyKeyRules(content)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]*PolicyKeyRule{
+		"costcenter": {
+			EnforcedFor: []string{"ec2:instance", "secretsmanager:*"},
+			Key:         "CostCenter",
+			Values:      []string{"100", "200*"},
+		},
+		"project": {
+			Key: "Project",
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := OrganizationsPolicyKeyRules("{"); err == nil {
+		t.Error("expected error parsing invalid policy, got none")
+	}
+}
+
+func TestPolicyKeyRuleAllows(t *testing.T) {
+	rule := &PolicyKeyRule{Values: []string{"100", "200*", "*@example.com", "a*z"}}
+
+	testCases := []struct {
+		value string
+		want  bool
+	}{
+		{value: "100", want: true},
+		{value: "1000", want: false},
+		{value: "200", want: true},
+		{value: "2001", want: true},
+		{value: "team@example.com", want: true},
+		{value: "team@example.org", want: false},
+		{value: "az", want: true},
+		{value: "abcz", want: true},
+		{value: "abc", want: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := rule.Allows(testCase.value); got != testCase.want {
+			t.Errorf("%s: got %t, want %t", testCase.value, got, testCase.want)
+		}
+	}
+
+	if !(&PolicyKeyRule{}).Allows("any") {
+		t.Error("expected rule without values to allow any value")
+	}
+}
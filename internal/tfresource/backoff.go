@@ -0,0 +1,248 @@
+package tfresource
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	defaultBackoffInitial    = 1 * time.Second
+	defaultBackoffMax        = 30 * time.Second
+	defaultBackoffMultiplier = 2.0
+	defaultNotFoundChecks    = 20
+)
+
+// Clock is the source of time used when waiting with a Backoff.
+// Tests replace the system clock to make waits deterministic.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// Rand is the source of randomness used for jitter.
+// *rand.Rand satisfies this interface.
+type Rand interface {
+	Int63n(n int64) int64
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+type globalRand struct{}
+
+func (globalRand) Int63n(n int64) int64 {
+	return rand.Int63n(n)
+}
+
+// Backoff configures exponential backoff between attempts.
+// Before attempt n (counting from 0 for the first retry), the delay is Initial * Multiplier^n, capped at Max.
+// With full jitter (the default), a random duration between 0 and that delay is waited instead,
+// which spreads out the requests of many resources waiting in parallel.
+type Backoff struct {
+	Initial    time.Duration // Delay before the first retry. Defaults to 1 second.
+	Max        time.Duration // Maximum delay. Defaults to 30 seconds.
+	Multiplier float64       // Factor by which the delay increases after each attempt. Defaults to 2.
+	NoJitter   bool          // Wait the full delay instead of a random duration up to the delay.
+
+	Clock Clock // Defaults to the system clock.
+	Rand  Rand  // Defaults to the math/rand global source.
+}
+
+// Delay returns the delay, before jitter, before the specified retry attempt (counting from 0).
+func (b Backoff) Delay(attempt int) time.Duration {
+	initial, max, multiplier := b.Initial, b.Max, b.Multiplier
+
+	if initial <= 0 {
+		initial = defaultBackoffInitial
+	}
+
+	if max <= 0 {
+		max = defaultBackoffMax
+	}
+
+	if multiplier < 1 {
+		multiplier = defaultBackoffMultiplier
+	}
+
+	delay := float64(initial)
+
+	for i := 0; i < attempt && delay < float64(max); i++ {
+		delay *= multiplier
+	}
+
+	if delay > float64(max) {
+		return max
+	}
+
+	return time.Duration(delay)
+}
+
+// Wait returns the duration to wait before the specified retry attempt (counting from 0), including jitter.
+func (b Backoff) Wait(attempt int) time.Duration {
+	delay := b.Delay(attempt)
+
+	if b.NoJitter || delay <= 0 {
+		return delay
+	}
+
+	return time.Duration(b.rand().Int63n(int64(delay)))
+}
+
+func (b Backoff) clock() Clock {
+	if b.Clock == nil {
+		return systemClock{}
+	}
+
+	return b.Clock
+}
+
+func (b Backoff) rand() Rand {
+	if b.Rand == nil {
+		return globalRand{}
+	}
+
+	return b.Rand
+}
+
+// sleep waits for the specified duration or until the context is done.
+func (b Backoff) sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.clock().After(d):
+		return nil
+	}
+}
+
+// WaitForStateContext watches an object and waits for it to achieve the state specified in `conf`,
+// waiting between refreshes using `backoff` instead of StateChangeConf's MinTimeout and PollInterval.
+// The semantics and returned errors otherwise match resource.StateChangeConf's WaitForStateContext:
+// an error from the Refresh function or an unexpected state are returned immediately,
+// a nil result is allowed NotFoundChecks times, and a resource.TimeoutError is returned once `conf.Timeout` has elapsed.
+func WaitForStateContext(ctx context.Context, conf *resource.StateChangeConf, backoff Backoff) (interface{}, error) {
+	log.Printf("[DEBUG] Waiting for state to become: %s", conf.Target)
+
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = defaultNotFoundChecks
+	}
+
+	continuousTargetOccurence := conf.ContinuousTargetOccurence
+	if continuousTargetOccurence == 0 {
+		continuousTargetOccurence = 1
+	}
+
+	clock := backoff.clock()
+	deadline := clock.Now().Add(conf.Timeout)
+
+	if err := backoff.sleep(ctx, minDuration(conf.Delay, deadline.Sub(clock.Now()))); err != nil {
+		return nil, err
+	}
+
+	var lastState string
+	notFoundTick := 0
+	targetOccurence := 0
+
+	for attempt := 0; ; {
+		res, currentState, err := conf.Refresh()
+
+		if err != nil {
+			return res, err
+		}
+
+		lastState = currentState
+
+		if res == nil && len(conf.Target) == 0 {
+			// Waiting for the absence of a thing.
+			targetOccurence++
+			if targetOccurence == continuousTargetOccurence {
+				return res, nil
+			}
+		} else if res == nil {
+			notFoundTick++
+			if notFoundTick > notFoundChecks {
+				return res, &resource.NotFoundError{
+					Retries: notFoundTick,
+				}
+			}
+		} else {
+			notFoundTick = 0
+			found := false
+
+			for _, allowed := range conf.Target {
+				if currentState == allowed {
+					found = true
+					targetOccurence++
+					if targetOccurence == continuousTargetOccurence {
+						return res, nil
+					}
+				}
+			}
+
+			for _, allowed := range conf.Pending {
+				if currentState == allowed {
+					found = true
+					targetOccurence = 0
+					break
+				}
+			}
+
+			if !found && len(conf.Pending) > 0 {
+				return res, &resource.UnexpectedStateError{
+					State:         currentState,
+					ExpectedState: conf.Target,
+				}
+			}
+		}
+
+		remaining := deadline.Sub(clock.Now())
+
+		if remaining <= 0 {
+			log.Printf("[WARN] WaitForState timeout after %s", conf.Timeout)
+
+			return nil, &resource.TimeoutError{
+				LastState:     lastState,
+				Timeout:       conf.Timeout,
+				ExpectedState: conf.Target,
+			}
+		}
+
+		// Back off between refreshes, except when waiting for the target state to reoccur.
+		wait := backoff.Wait(attempt)
+		if targetOccurence == 0 {
+			attempt++
+		}
+
+		// Always refresh once more at the deadline.
+		wait = minDuration(wait, remaining)
+
+		log.Printf("[TRACE] Waiting %s before next try", wait)
+
+		if err := backoff.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < b {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,390 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// fakeClock is a Clock whose time only advances when waited on.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+// halfRand returns half of the requested range.
+type halfRand struct{}
+
+func (halfRand) Int63n(n int64) int64 {
+	return n / 2
+}
+
+func equalDurations(a, b []time.Duration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestBackoffDelay(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Backoff  tfresource.Backoff
+		Expected []time.Duration
+	}{
+		{
+			Name:     "defaults",
+			Backoff:  tfresource.Backoff{},
+			Expected: []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second},
+		},
+		{
+			Name: "configured",
+			Backoff: tfresource.Backoff{
+				Initial:    100 * time.Millisecond,
+				Max:        1 * time.Second,
+				Multiplier: 3,
+			},
+			Expected: []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, 1 * time.Second},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			var got []time.Duration
+
+			for i := range testCase.Expected {
+				got = append(got, testCase.Backoff.Delay(i))
+			}
+
+			if !equalDurations(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestBackoffDelayLargeAttempt(t *testing.T) {
+	backoff := tfresource.Backoff{Max: 5 * time.Minute}
+
+	if got, expected := backoff.Delay(1000), 5*time.Minute; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestBackoffWait(t *testing.T) {
+	backoff := tfresource.Backoff{
+		Initial: 2 * time.Second,
+		Rand:    halfRand{},
+	}
+
+	if got, expected := backoff.Wait(1), 2*time.Second; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	backoff.NoJitter = true
+
+	if got, expected := backoff.Wait(1), 4*time.Second; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestWaitForStateContext(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		States         []string
+		Target         []string
+		Continuous     int
+		NotFound       bool
+		Err            error
+		Timeout        time.Duration
+		ExpectedSleeps []time.Duration
+		ExpectedError  func(error) bool
+	}{
+		{
+			Name:           "target reached",
+			States:         []string{"pending", "pending", "pending", "done"},
+			Target:         []string{"done"},
+			Timeout:        10 * time.Minute,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond, 1 * time.Second, 2 * time.Second},
+		},
+		{
+			Name:           "continuous target occurence",
+			States:         []string{"pending", "done", "done", "done"},
+			Target:         []string{"done"},
+			Continuous:     3,
+			Timeout:        10 * time.Minute,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond, 1 * time.Second, 1 * time.Second},
+		},
+		{
+			Name:           "capped",
+			States:         []string{"pending", "pending", "pending", "pending", "pending", "done"},
+			Target:         []string{"done"},
+			Timeout:        10 * time.Minute,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond, 1 * time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second},
+		},
+		{
+			Name:           "timeout",
+			States:         []string{"pending", "pending", "pending", "pending", "pending", "pending"},
+			Target:         []string{"done"},
+			Timeout:        10 * time.Second,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond, 1 * time.Second, 2 * time.Second, 1500 * time.Millisecond},
+			ExpectedError: func(err error) bool {
+				var e *resource.TimeoutError
+				return errors.As(err, &e) && e.LastState == "pending"
+			},
+		},
+		{
+			Name:           "unexpected state",
+			States:         []string{"pending", "failed"},
+			Target:         []string{"done"},
+			Timeout:        10 * time.Minute,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond},
+			ExpectedError: func(err error) bool {
+				var e *resource.UnexpectedStateError
+				return errors.As(err, &e) && e.State == "failed"
+			},
+		},
+		{
+			Name:           "refresh error",
+			States:         []string{"pending"},
+			Target:         []string{"done"},
+			Err:            awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Timeout:        10 * time.Minute,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond},
+			ExpectedError: func(err error) bool {
+				var e awserr.Error
+				return errors.As(err, &e) && e.Code() == "ThrottlingException"
+			},
+		},
+		{
+			Name:           "deleted",
+			States:         []string{"deleting", "deleting"},
+			NotFound:       true,
+			Timeout:        10 * time.Minute,
+			ExpectedSleeps: []time.Duration{5 * time.Second, 500 * time.Millisecond, 1 * time.Second},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			clock := newFakeClock()
+			calls := 0
+
+			conf := &resource.StateChangeConf{
+				Pending: []string{"pending", "deleting"},
+				Target:  testCase.Target,
+				Refresh: func() (interface{}, string, error) {
+					defer func() { calls++ }()
+
+					if calls < len(testCase.States) {
+						return testCase.States[calls], testCase.States[calls], nil
+					}
+
+					if testCase.Err != nil {
+						return nil, "", testCase.Err
+					}
+
+					if testCase.NotFound {
+						return nil, "", nil
+					}
+
+					return testCase.States[len(testCase.States)-1], testCase.States[len(testCase.States)-1], nil
+				},
+				Timeout:                   testCase.Timeout,
+				Delay:                     5 * time.Second,
+				ContinuousTargetOccurence: testCase.Continuous,
+			}
+			backoff := tfresource.Backoff{
+				Initial:  500 * time.Millisecond,
+				Max:      3 * time.Second,
+				NoJitter: true,
+				Clock:    clock,
+			}
+
+			_, err := tfresource.WaitForStateContext(context.Background(), conf, backoff)
+
+			if testCase.ExpectedError == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != nil && !testCase.ExpectedError(err) {
+				t.Fatalf("unexpected error: %#v", err)
+			}
+
+			if !equalDurations(clock.sleeps, testCase.ExpectedSleeps) {
+				t.Errorf("got sleeps %v, expected %v", clock.sleeps, testCase.ExpectedSleeps)
+			}
+		})
+	}
+}
+
+func TestWaitForStateContextJitter(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+
+	conf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			calls++
+
+			if calls < 4 {
+				return "pending", "pending", nil
+			}
+
+			return "done", "done", nil
+		},
+		Timeout: 10 * time.Minute,
+	}
+	backoff := tfresource.Backoff{
+		Initial: 1 * time.Second,
+		Clock:   clock,
+		Rand:    halfRand{},
+	}
+
+	if _, err := tfresource.WaitForStateContext(context.Background(), conf, backoff); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []time.Duration{500 * time.Millisecond, 1 * time.Second, 2 * time.Second}; !equalDurations(clock.sleeps, expected) {
+		t.Errorf("got sleeps %v, expected %v", clock.sleeps, expected)
+	}
+}
+
+func TestWaitForStateContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	conf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			return "pending", "pending", nil
+		},
+		Timeout: 10 * time.Minute,
+	}
+
+	_, err := tfresource.WaitForStateContext(ctx, conf, tfresource.Backoff{})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestWaitUntilContextBackoff(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+
+	err := tfresource.WaitUntilContext(context.Background(), 1*time.Minute, func() (bool, error) {
+		calls++
+
+		return calls == 3, nil
+	}, tfresource.WaitOpts{
+		Backoff: &tfresource.Backoff{
+			Initial:  1 * time.Second,
+			NoJitter: true,
+			Clock:    clock,
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []time.Duration{1 * time.Second, 2 * time.Second}; !equalDurations(clock.sleeps, expected) {
+		t.Errorf("got sleeps %v, expected %v", clock.sleeps, expected)
+	}
+}
+
+func TestRetryWhenWithBackoffContext(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+
+	output, err := tfresource.RetryWhenWithBackoffContext(context.Background(), 1*time.Minute, tfresource.Backoff{
+		Initial:  1 * time.Second,
+		Max:      3 * time.Second,
+		NoJitter: true,
+		Clock:    clock,
+	}, func() (interface{}, error) {
+		calls++
+
+		if calls < 5 {
+			return nil, awserr.New("ThrottlingException", "Rate exceeded", nil)
+		}
+
+		return calls, nil
+	}, func(err error) (bool, error) {
+		var e awserr.Error
+		if errors.As(err, &e) && e.Code() == "ThrottlingException" {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if output.(int) != 5 {
+		t.Errorf("got output %v, expected 5", output)
+	}
+
+	if expected := []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second}; !equalDurations(clock.sleeps, expected) {
+		t.Errorf("got sleeps %v, expected %v", clock.sleeps, expected)
+	}
+}
+
+func TestRetryWithBackoffContextTimeout(t *testing.T) {
+	clock := newFakeClock()
+	calls := 0
+
+	err := tfresource.RetryWithBackoffContext(context.Background(), 10*time.Second, tfresource.Backoff{
+		Initial:  2 * time.Second,
+		NoJitter: true,
+		Clock:    clock,
+	}, func() *resource.RetryError {
+		calls++
+
+		return resource.RetryableError(errors.New("not yet"))
+	})
+
+	if err == nil || err.Error() != "not yet" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Refreshes at 0s, 2s, 6s and at the 10s deadline.
+	if calls != 4 {
+		t.Errorf("got %d calls, expected 4", calls)
+	}
+}
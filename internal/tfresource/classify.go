@@ -0,0 +1,269 @@
+package tfresource
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrorCategory is a category of AWS API error.
+type ErrorCategory string
+
+const (
+	ErrorCategoryNone                ErrorCategory = ""
+	ErrorCategoryAccessDenied        ErrorCategory = "AccessDenied"
+	ErrorCategoryConflict            ErrorCategory = "Conflict"
+	ErrorCategoryNotFound            ErrorCategory = "NotFound"
+	ErrorCategoryQuotaExceeded       ErrorCategory = "QuotaExceeded"
+	ErrorCategoryThrottled           ErrorCategory = "Throttled"
+	ErrorCategoryUnsupportedInRegion ErrorCategory = "UnsupportedInRegion"
+)
+
+// ErrorClassification assigns AWS errors with a matching code and message to a category.
+type ErrorClassification struct {
+	// Code is the error code. `*` matches any characters, e.g. `*.NotFound`. An empty code matches any error.
+	Code string
+	// Message is a substring of the error message. An empty message matches any message.
+	Message  string
+	Category ErrorCategory
+}
+
+type errorClassifier struct {
+	ErrorClassification
+
+	codeRegexp   *regexp.Regexp // Matches the code of an awserr.Error.
+	stringRegexp *regexp.Regexp // Matches the code in an error string.
+}
+
+func newErrorClassifier(classification ErrorClassification) *errorClassifier {
+	c := &errorClassifier{
+		ErrorClassification: classification,
+	}
+
+	if classification.Code != "" {
+		parts := strings.Split(classification.Code, "*")
+
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+
+		code := strings.Join(parts, `[A-Za-z0-9.]*`)
+
+		c.codeRegexp = regexp.MustCompile(`^` + code + `$`)
+		// In error strings, the code is followed by a colon, e.g. "ThrottlingException: Rate exceeded".
+		c.stringRegexp = regexp.MustCompile(`(^|[^A-Za-z0-9.])` + code + `:`)
+	}
+
+	return c
+}
+
+func (c *errorClassifier) matches(err error) bool {
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) {
+		if c.codeRegexp != nil && !c.codeRegexp.MatchString(awsErr.Code()) {
+			return false
+		}
+
+		return strings.Contains(awsErr.Message(), c.Message)
+	}
+
+	// Errors such as those passed to acceptance test ErrorCheck functions are only available as strings.
+	s := err.Error()
+
+	if c.stringRegexp != nil && !c.stringRegexp.MatchString(s) {
+		return false
+	}
+
+	return strings.Contains(s, c.Message)
+}
+
+var (
+	commonErrorClassifiers  []*errorClassifier
+	serviceErrorClassifiers = make(map[string][]*errorClassifier)
+)
+
+func init() {
+	for _, classification := range commonErrorClassifications {
+		commonErrorClassifiers = append(commonErrorClassifiers, newErrorClassifier(classification))
+	}
+
+	for service, classifications := range serviceErrorClassifications {
+		for _, classification := range classifications {
+			serviceErrorClassifiers[service] = append(serviceErrorClassifiers[service], newErrorClassifier(classification))
+		}
+	}
+}
+
+// ClassifyError returns the category of an AWS API error.
+// The classifications of the specified services, identified by their AWS Go SDK endpoint ID, are checked before
+// the classifications common to all services. Without services, only the common classifications are checked.
+// A resource.NotFoundError is in the NotFound category.
+func ClassifyError(err error, services ...string) ErrorCategory {
+	if err == nil {
+		return ErrorCategoryNone
+	}
+
+	if NotFound(err) {
+		return ErrorCategoryNotFound
+	}
+
+	for _, service := range services {
+		for _, c := range serviceErrorClassifiers[service] {
+			if c.matches(err) {
+				return c.Category
+			}
+		}
+	}
+
+	for _, c := range commonErrorClassifiers {
+		if c.matches(err) {
+			return c.Category
+		}
+	}
+
+	return ErrorCategoryNone
+}
+
+// ErrorCategoryEquals returns true if the error is in one of the specified categories.
+// Only the classifications common to all services are checked.
+func ErrorCategoryEquals(err error, categories ...ErrorCategory) bool {
+	return ServiceErrorCategoryEquals(err, nil, categories...)
+}
+
+// ServiceErrorCategoryEquals returns true if the error is in one of the specified categories,
+// checking the classifications of the specified services before the common classifications.
+func ServiceErrorCategoryEquals(err error, services []string, categories ...ErrorCategory) bool {
+	category := ClassifyError(err, services...)
+
+	if category == ErrorCategoryNone {
+		return false
+	}
+
+	for _, c := range categories {
+		if category == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccessDenied returns true if the error represents an "access denied" condition.
+func AccessDenied(err error) bool {
+	return ErrorCategoryEquals(err, ErrorCategoryAccessDenied)
+}
+
+// Conflict returns true if the error represents a conflict with the current state of a resource,
+// such as a resource that is in use or being modified.
+func Conflict(err error) bool {
+	return ErrorCategoryEquals(err, ErrorCategoryConflict)
+}
+
+// QuotaExceeded returns true if the error represents an exceeded service quota.
+func QuotaExceeded(err error) bool {
+	return ErrorCategoryEquals(err, ErrorCategoryQuotaExceeded)
+}
+
+// Throttled returns true if the error represents a throttled request.
+func Throttled(err error) bool {
+	return ErrorCategoryEquals(err, ErrorCategoryThrottled)
+}
+
+// UnsupportedInRegion returns true if the error represents an operation or feature that is unavailable
+// in the region, partition or account.
+func UnsupportedInRegion(err error) bool {
+	return ErrorCategoryEquals(err, ErrorCategoryUnsupportedInRegion)
+}
+
+// commonErrorClassifications classify errors returned by any service.
+// Classifications are checked in order and the first match wins.
+var commonErrorClassifications = []ErrorClassification{
+	{Code: "RequestLimitExceeded", Category: ErrorCategoryThrottled},
+	{Code: "RequestThrottled", Category: ErrorCategoryThrottled},
+	{Code: "Throttling", Category: ErrorCategoryThrottled},
+	{Code: "ThrottlingException", Category: ErrorCategoryThrottled},
+	{Code: "TooManyRequestsException", Category: ErrorCategoryThrottled},
+	{Message: "Rate exceeded", Category: ErrorCategoryThrottled},
+
+	{Code: "AccessDenied", Category: ErrorCategoryAccessDenied},
+	{Code: "AccessDeniedException", Category: ErrorCategoryAccessDenied},
+	{Code: "UnauthorizedOperation", Category: ErrorCategoryAccessDenied},
+
+	// Example: InvalidAction: The action DescribeTransitGatewayAttachments is not valid for this web service
+	{Code: "InvalidAction", Message: "is not valid", Category: ErrorCategoryUnsupportedInRegion},
+	// For example from GovCloud SES.SetActiveReceiptRuleSet.
+	{Code: "InvalidAction", Message: "Unavailable Operation", Category: ErrorCategoryUnsupportedInRegion},
+	// For example from us-gov-west-1 EventBridge archive
+	{Code: "UnknownOperationException", Message: "Operation is disabled in this region", Category: ErrorCategoryUnsupportedInRegion},
+	{Code: "UnsupportedOperation", Category: ErrorCategoryUnsupportedInRegion},
+	// Example: InvalidInputException: Unknown operation
+	{Message: "Unknown operation", Category: ErrorCategoryUnsupportedInRegion},
+	// Example: ValidationException: Feature is not supported in this region
+	{Message: "is not supported in this", Category: ErrorCategoryUnsupportedInRegion},
+	{Message: "is currently not supported", Category: ErrorCategoryUnsupportedInRegion},
+	// Error strings, such as those passed to acceptance test ErrorCheck functions, contain the code,
+	// so these match any error string with the code but only AWS errors with the code in their message.
+	{Message: "InvalidAction", Category: ErrorCategoryUnsupportedInRegion},
+	{Message: "UnknownOperationException", Category: ErrorCategoryUnsupportedInRegion},
+
+	{Code: "*.NotFound", Category: ErrorCategoryNotFound},
+	{Code: "*NotFound", Category: ErrorCategoryNotFound},
+	{Code: "*NotFoundException", Category: ErrorCategoryNotFound},
+	{Code: "NoSuch*", Category: ErrorCategoryNotFound},
+
+	{Code: "*LimitExceeded", Category: ErrorCategoryQuotaExceeded},
+	{Code: "*LimitExceededException", Category: ErrorCategoryQuotaExceeded},
+	{Code: "QuotaExceededException", Category: ErrorCategoryQuotaExceeded},
+	{Code: "ServiceQuotaExceededException", Category: ErrorCategoryQuotaExceeded},
+
+	{Code: "ConcurrentModificationException", Category: ErrorCategoryConflict},
+	{Code: "ConflictException", Category: ErrorCategoryConflict},
+	{Code: "DependencyViolation", Category: ErrorCategoryConflict},
+	{Code: "ResourceConflictException", Category: ErrorCategoryConflict},
+	{Code: "ResourceInUse", Category: ErrorCategoryConflict},
+	{Code: "ResourceInUseException", Category: ErrorCategoryConflict},
+}
+
+// serviceErrorClassifications classify errors specific to a service, keyed by AWS Go SDK endpoint ID.
+// Classifications are checked in order, before commonErrorClassifications, and the first match wins.
+var serviceErrorClassifications = map[string][]ErrorClassification{
+	"apigateway": {
+		// Example: BadRequestException: vpc link not supported for region us-gov-west-1
+		{Code: "BadRequestException", Message: "not supported", Category: ErrorCategoryUnsupportedInRegion},
+		{Code: "TooManyRequestsException", Category: ErrorCategoryThrottled},
+	},
+	"api.ecr-public": {
+		// For example from us-west-2 ECR public repository
+		{Code: "UnsupportedCommandException", Message: "command is only supported in", Category: ErrorCategoryUnsupportedInRegion},
+	},
+	"dynamodb": {
+		{Code: "ProvisionedThroughputExceededException", Category: ErrorCategoryThrottled},
+	},
+	"elasticache": {
+		// InvalidParameterValue: Use of cache security groups is not permitted in this API version for your account.
+		{Code: "InvalidParameterValue", Message: "not permitted in this API version for your account", Category: ErrorCategoryUnsupportedInRegion},
+	},
+	"elasticmapreduce": {
+		// For example from us-west-1 EMR studio
+		{Code: "ValidationException", Message: "Account is not whitelisted to use this feature", Category: ErrorCategoryUnsupportedInRegion},
+	},
+	"iam": {
+		{Code: "DeleteConflict", Category: ErrorCategoryConflict},
+	},
+	"rds": {
+		// InvalidParameterValue: Access Denied to API Version: APIGlobalDatabases
+		{Code: "InvalidParameterValue", Message: "Access Denied to API Version", Category: ErrorCategoryUnsupportedInRegion},
+	},
+	"s3": {
+		{Code: "OperationAborted", Category: ErrorCategoryConflict},
+		{Code: "SlowDown", Category: ErrorCategoryThrottled},
+		{Code: "TooManyBuckets", Category: ErrorCategoryQuotaExceeded},
+	},
+	"sns": {
+		// Example: InvalidAction: InvalidAction: Operation (ListPlatformApplications) is not supported in this region
+		{Code: "InvalidAction", Message: "is not supported in this region", Category: ErrorCategoryUnsupportedInRegion},
+	},
+}
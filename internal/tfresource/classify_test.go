@@ -0,0 +1,192 @@
+package tfresource_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestClassifyError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Services []string
+		Expected tfresource.ErrorCategory
+	}{
+		{
+			Name:     "nil error",
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "other error",
+			Err:      errors.New("test"),
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "other AWS error",
+			Err:      awserr.New("InvalidParameterValueException", "test", nil),
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "NotFoundError",
+			Err:      &resource.NotFoundError{},
+			Expected: tfresource.ErrorCategoryNotFound,
+		},
+		{
+			Name:     "wrapped NotFoundError",
+			Err:      fmt.Errorf("test: %w", &resource.NotFoundError{}),
+			Expected: tfresource.ErrorCategoryNotFound,
+		},
+		{
+			Name:     "NotFoundException",
+			Err:      awserr.New("ResourceNotFoundException", "test", nil),
+			Expected: tfresource.ErrorCategoryNotFound,
+		},
+		{
+			Name:     "EC2 NotFound",
+			Err:      awserr.New("InvalidVpcID.NotFound", "test", nil),
+			Expected: tfresource.ErrorCategoryNotFound,
+		},
+		{
+			Name:     "NoSuch",
+			Err:      awserr.New("NoSuchEntity", "test", nil),
+			Expected: tfresource.ErrorCategoryNotFound,
+		},
+		{
+			Name:     "throttled",
+			Err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected: tfresource.ErrorCategoryThrottled,
+		},
+		{
+			Name:     "request limit is throttling, not quota",
+			Err:      awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			Expected: tfresource.ErrorCategoryThrottled,
+		},
+		{
+			Name:     "wrapped throttled",
+			Err:      fmt.Errorf("error deleting: %w", awserr.New("Throttling", "test", nil)),
+			Expected: tfresource.ErrorCategoryThrottled,
+		},
+		{
+			Name:     "quota exceeded",
+			Err:      awserr.New("VpcLimitExceeded", "The maximum number of VPCs has been reached.", nil),
+			Expected: tfresource.ErrorCategoryQuotaExceeded,
+		},
+		{
+			Name:     "access denied",
+			Err:      awserr.New("AccessDeniedException", "", nil),
+			Expected: tfresource.ErrorCategoryAccessDenied,
+		},
+		{
+			Name:     "conflict",
+			Err:      awserr.New("ResourceInUseException", "test", nil),
+			Expected: tfresource.ErrorCategoryConflict,
+		},
+		{
+			Name:     "unsupported action",
+			Err:      awserr.New("InvalidAction", "The action DescribeTransitGatewayAttachments is not valid for this web service", nil),
+			Expected: tfresource.ErrorCategoryUnsupportedInRegion,
+		},
+		{
+			Name:     "unsupported action other message",
+			Err:      awserr.New("InvalidAction", "Missing required parameter", nil),
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "missing endpoint",
+			Err:      awserr.New("RequestError", "send request failed", nil),
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "service specific",
+			Err:      awserr.New("InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases", nil),
+			Services: []string{"rds"},
+			Expected: tfresource.ErrorCategoryUnsupportedInRegion,
+		},
+		{
+			Name:     "service specific other service",
+			Err:      awserr.New("InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases", nil),
+			Services: []string{"ec2"},
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "service specific without services",
+			Err:      awserr.New("InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases", nil),
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "service specific message mismatch",
+			Err:      awserr.New("InvalidParameterValue", "Invalid engine", nil),
+			Services: []string{"rds"},
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "error string",
+			Err:      errors.New("error creating Example Thing: UnknownOperationException: Operation is disabled in this region\n\tstatus code: 400"),
+			Expected: tfresource.ErrorCategoryUnsupportedInRegion,
+		},
+		{
+			Name:     "error string invalid action",
+			Err:      errors.New("error creating Example Thing: InvalidAction: Missing required parameter"),
+			Expected: tfresource.ErrorCategoryUnsupportedInRegion,
+		},
+		{
+			Name:     "error string code suffix",
+			Err:      errors.New("error reading Example Thing: XAccessDenied: test"),
+			Expected: tfresource.ErrorCategoryNone,
+		},
+		{
+			Name:     "error string throttled",
+			Err:      errors.New("error deleting resource: ThrottlingException: Rate exceeded"),
+			Expected: tfresource.ErrorCategoryThrottled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tfresource.ClassifyError(testCase.Err, testCase.Services...); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestErrorCategoryEquals(t *testing.T) {
+	err := awserr.New("ConflictException", "test", nil)
+
+	if !tfresource.ErrorCategoryEquals(err, tfresource.ErrorCategoryThrottled, tfresource.ErrorCategoryConflict) {
+		t.Error("expected error category to equal Conflict")
+	}
+
+	if tfresource.ErrorCategoryEquals(err, tfresource.ErrorCategoryThrottled) {
+		t.Error("expected error category not to equal Throttled")
+	}
+
+	if tfresource.ErrorCategoryEquals(errors.New("test"), tfresource.ErrorCategoryNone) {
+		t.Error("expected unclassified error not to equal any category")
+	}
+
+	if !tfresource.Conflict(err) {
+		t.Error("expected conflict")
+	}
+}
+
+func TestServiceErrorCategoryEquals(t *testing.T) {
+	err := awserr.New("InvalidParameterValue", "Access Denied to API Version: APIGlobalDatabases", nil)
+
+	if tfresource.ErrorCategoryEquals(err, tfresource.ErrorCategoryUnsupportedInRegion) {
+		t.Error("expected service specific error not to equal UnsupportedInRegion without services")
+	}
+
+	if tfresource.ServiceErrorCategoryEquals(err, []string{"ec2"}, tfresource.ErrorCategoryUnsupportedInRegion) {
+		t.Error("expected service specific error not to equal UnsupportedInRegion for other services")
+	}
+
+	if !tfresource.ServiceErrorCategoryEquals(err, []string{"ec2", "rds"}, tfresource.ErrorCategoryUnsupportedInRegion) {
+		t.Error("expected service specific error to equal UnsupportedInRegion for the service")
+	}
+}
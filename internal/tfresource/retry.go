@@ -19,9 +19,31 @@ type Retryable func(error) (bool, error)
 // RetryWhenContext retries the function `f` when the error it returns satisfies `predicate`.
 // `f` is retried until `timeout` expires.
 func RetryWhenContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	return retryWhen(f, retryable, func(retryFunc resource.RetryFunc) error {
+		return resource.Retry(timeout, retryFunc) // nosemgrep: helper-schema-resource-Retry-without-TimeoutError-check
+	})
+}
+
+// RetryWhenWithBackoffContext retries the function `f` when the error it returns satisfies `predicate`,
+// waiting between attempts using `backoff`.
+// `f` is retried until `timeout` expires.
+func RetryWhenWithBackoffContext(ctx context.Context, timeout time.Duration, backoff Backoff, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	return retryWhen(f, retryable, func(retryFunc resource.RetryFunc) error {
+		return RetryWithBackoffContext(ctx, timeout, backoff, retryFunc)
+	})
+}
+
+// RetryWhenWithBackoff retries the function `f` when the error it returns satisfies `predicate`,
+// waiting between attempts using `backoff`.
+// `f` is retried until `timeout` expires.
+func RetryWhenWithBackoff(timeout time.Duration, backoff Backoff, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	return RetryWhenWithBackoffContext(context.Background(), timeout, backoff, f, retryable)
+}
+
+func retryWhen(f func() (interface{}, error), retryable Retryable, retry func(resource.RetryFunc) error) (interface{}, error) {
 	var output interface{}
 
-	err := resource.Retry(timeout, func() *resource.RetryError { // nosemgrep: helper-schema-resource-Retry-without-TimeoutError-check
+	err := retry(func() *resource.RetryError {
 		var err error
 
 		output, err = f()
@@ -71,6 +93,22 @@ func RetryWhenAWSErrCodeEquals(timeout time.Duration, f func() (interface{}, err
 	return RetryWhenAWSErrCodeEqualsContext(context.Background(), timeout, f, codes...)
 }
 
+// RetryWhenErrorCategoryContext retries the specified function when it returns an error in one of the specified categories.
+func RetryWhenErrorCategoryContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error), categories ...ErrorCategory) (interface{}, error) {
+	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
+		if ErrorCategoryEquals(err, categories...) {
+			return true, err
+		}
+
+		return false, err
+	})
+}
+
+// RetryWhenErrorCategory retries the specified function when it returns an error in one of the specified categories.
+func RetryWhenErrorCategory(timeout time.Duration, f func() (interface{}, error), categories ...ErrorCategory) (interface{}, error) {
+	return RetryWhenErrorCategoryContext(context.Background(), timeout, f, categories...)
+}
+
 // RetryWhenNotFoundContext retries the specified function when it returns a resource.NotFoundError.
 func RetryWhenNotFoundContext(ctx context.Context, timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
 	return RetryWhenContext(ctx, timeout, f, func(err error) (bool, error) {
@@ -103,11 +141,56 @@ func RetryWhenNewResourceNotFound(timeout time.Duration, f func() (interface{},
 	return RetryWhenNewResourceNotFoundContext(context.Background(), timeout, f, isNewResource)
 }
 
+// RetryWithBackoffContext retries the function `f` until it no longer returns a retryable error,
+// waiting between attempts using `backoff`.
+// `f` is retried until `timeout` expires.
+func RetryWithBackoffContext(ctx context.Context, timeout time.Duration, backoff Backoff, f resource.RetryFunc) error {
+	return retryContext(f, func(c *resource.StateChangeConf) error {
+		c.Timeout = timeout
+
+		_, err := WaitForStateContext(ctx, c, backoff)
+
+		return err
+	})
+}
+
 // RetryConfigContext allows configuration of StateChangeConf's various time arguments.
 // This is especially useful for AWS services that are prone to throttling, such as Route53, where
 // the default durations cause problems. To not use a StateChangeConf argument and revert to the
 // default, pass in a zero value (i.e., 0*time.Second).
 func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration, f resource.RetryFunc) error {
+	return retryContext(f, func(c *resource.StateChangeConf) error {
+		c.Timeout = timeout
+
+		if delay.Milliseconds() > 0 {
+			c.Delay = delay
+		}
+
+		if delayRand.Milliseconds() > 0 {
+			// Hitting the API at exactly the same time on each iteration of the retry is more likely to
+			// cause Throttling problems. We introduce randomness in order to help AWS be happier.
+			rand.Seed(time.Now().UTC().UnixNano())
+
+			c.Delay = time.Duration(rand.Int63n(delayRand.Milliseconds())) * time.Millisecond
+		}
+
+		if minTimeout.Milliseconds() > 0 {
+			c.MinTimeout = minTimeout
+		}
+
+		if pollInterval.Milliseconds() > 0 {
+			c.PollInterval = pollInterval
+		}
+
+		_, err := c.WaitForStateContext(ctx)
+
+		return err
+	})
+}
+
+// retryContext retries the function `f` until it no longer returns a retryable error.
+// `wait` configures the timing of and waits on the StateChangeConf that calls `f`.
+func retryContext(f resource.RetryFunc, wait func(*resource.StateChangeConf) error) error {
 	// These are used to pull the error out of the function; need a mutex to
 	// avoid a data race.
 	var resultErr error
@@ -116,7 +199,6 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 	c := &resource.StateChangeConf{
 		Pending: []string{"retryableerror"},
 		Target:  []string{"success"},
-		Timeout: timeout,
 		Refresh: func() (interface{}, string, error) {
 			rerr := f()
 
@@ -138,27 +220,7 @@ func RetryConfigContext(ctx context.Context, delay time.Duration, delayRand time
 		},
 	}
 
-	if delay.Milliseconds() > 0 {
-		c.Delay = delay
-	}
-
-	if delayRand.Milliseconds() > 0 {
-		// Hitting the API at exactly the same time on each iteration of the retry is more likely to
-		// cause Throttling problems. We introduce randomness in order to help AWS be happier.
-		rand.Seed(time.Now().UTC().UnixNano())
-
-		c.Delay = time.Duration(rand.Int63n(delayRand.Milliseconds())) * time.Millisecond
-	}
-
-	if minTimeout.Milliseconds() > 0 {
-		c.MinTimeout = minTimeout
-	}
-
-	if pollInterval.Milliseconds() > 0 {
-		c.PollInterval = pollInterval
-	}
-
-	_, waitErr := c.WaitForStateContext(ctx)
+	waitErr := wait(c)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
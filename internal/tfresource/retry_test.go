@@ -75,6 +75,68 @@ func TestRetryWhenAWSErrCodeEquals(t *testing.T) {
 	}
 }
 
+func TestRetryWhenErrorCategory(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name        string
+		F           func() (interface{}, error)
+		ExpectError bool
+	}{
+		{
+			Name: "no error",
+			F: func() (interface{}, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "non-retryable other error",
+			F: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "non-retryable AWS error",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("AccessDeniedException", "Testing", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error timeout",
+			F: func() (interface{}, error) {
+				return nil, awserr.New("ThrottlingException", "Rate exceeded", nil)
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "retryable AWS error success",
+			F: func() (interface{}, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, awserr.New("ResourceInUseException", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := tfresource.RetryWhenErrorCategory(5*time.Second, testCase.F, tfresource.ErrorCategoryThrottled, tfresource.ErrorCategoryConflict)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestRetryWhenNewResourceNotFound(t *testing.T) {
 	var retryCount int32
 
@@ -8,6 +8,7 @@ import (
 )
 
 type WaitOpts struct {
+	Backoff                   *Backoff      // Wait between checks using exponential backoff with jitter. Overrides MinTimeout and PollInterval.
 	ContinuousTargetOccurence int           // Number of times the target state has to occur continuously.
 	Delay                     time.Duration // Wait this time before starting checks.
 	MinTimeout                time.Duration // Smallest time to wait before refreshes.
@@ -24,6 +25,7 @@ const (
 // If `f` returns an error, return immediately with that error.
 // If `timeout` is exceeded before `f` returns `true`, return an error.
 // Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
+// If `opts.Backoff` is set, the backoff is capped and jittered as configured.
 func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	refresh := func() (interface{}, string, error) {
 		done, err := f()
@@ -50,7 +52,13 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 		PollInterval:              opts.PollInterval,
 	}
 
-	_, err := stateConf.WaitForStateContext(ctx)
+	var err error
+
+	if opts.Backoff != nil {
+		_, err = WaitForStateContext(ctx, stateConf, *opts.Backoff)
+	} else {
+		_, err = stateConf.WaitForStateContext(ctx)
+	}
 
 	return err
 }
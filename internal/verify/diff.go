@@ -3,6 +3,9 @@ package verify
 import (
 	"context"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +21,8 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultTagsConfig := resolveDefaultTags(ctx, diff, meta.(*conns.AWSClient))
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
@@ -30,6 +33,10 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	if err := checkTagPolicy(diff, allTags, meta.(*conns.AWSClient).TagPolicyConfig); err != nil {
+		return err
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -53,6 +60,138 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// resolveDefaultTags returns the provider-level default tags with the variables in their values replaced
+// and, for existing resources, the prior values of tags with set-once keys.
+func resolveDefaultTags(ctx context.Context, diff *schema.ResourceDiff, client *conns.AWSClient) *tftags.DefaultConfig {
+	if len(client.DefaultTagsConfig.DynamicKeys()) == 0 {
+		return client.DefaultTagsConfig
+	}
+
+	var priorTags tftags.KeyValueTags
+
+	if diff.Id() != "" {
+		o, _ := diff.GetChange("tags_all")
+		priorTags = tftags.New(o)
+	}
+
+	data := &tftags.DefaultTemplateData{
+		AccountID:    client.AccountID,
+		Partition:    client.Partition,
+		Region:       client.Region,
+		ResourceType: tftags.ResourceTypeFromContext(ctx),
+		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+	}
+
+	return client.DefaultTagsConfig.Resolve(data, priorTags)
+}
+
+// unknownVariableValue is the value of tags not known until apply.
+// Reference: github.com/hashicorp/terraform-plugin-sdk/v2/internal/configs/hcl2shim.UnknownVariableValue
+const unknownVariableValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// checkTagPolicy returns an error if new or changed tags do not follow the provider-level tag policy,
+// or only logs a warning if the policy is warn-only.
+func checkTagPolicy(diff *schema.ResourceDiff, allTags tftags.KeyValueTags, tagPolicyConfig *tftags.PolicyConfig) error {
+	if tagPolicyConfig == nil || !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	if o, _ := diff.GetChange("tags_all"); diff.Id() != "" && allTags.Equal(tftags.New(o)) {
+		return nil
+	}
+
+	policyTags := make(tftags.KeyValueTags, len(allTags))
+
+	for k, v := range allTags {
+		if v != nil && aws.StringValue(v.Value) == unknownVariableValue {
+			v = nil
+		}
+
+		policyTags[k] = v
+	}
+
+	violations := tagPolicyConfig.Violations(policyTags)
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	if tagPolicyConfig.WarnOnly {
+		log.Printf("[WARN] \"tags_all\" do not follow the \"tag_policy\" configuration block of the provider: %s", strings.Join(violations, "; "))
+
+		return nil
+	}
+
+	return fmt.Errorf(`"tags_all" do not follow the "tag_policy" configuration block of the provider: %s`, strings.Join(violations, "; "))
+}
+
+// NameLengthDiff returns a CustomizeDiffFunc that flags a new resource name,
+// configured or generated from a name prefix and the provider-level naming configuration,
+// longer than the service's maximum length or that configured at the provider-level.
+func NameLengthDiff(nameKey, namePrefixKey string, maxLength int) schema.CustomizeDiffFunc {
+	return nameLengthDiff(nameKey, namePrefixKey, "", "", maxLength)
+}
+
+// NameWithSuffixLengthDiff returns a CustomizeDiffFunc as NameLengthDiff does
+// for resources whose generated names end with a fixed suffix.
+func NameWithSuffixLengthDiff(nameKey, namePrefixKey, nameSuffix string, maxLength int) schema.CustomizeDiffFunc {
+	return nameLengthDiff(nameKey, namePrefixKey, "", nameSuffix, maxLength)
+}
+
+// NameWithDefaultPrefixLengthDiff returns a CustomizeDiffFunc as NameLengthDiff does
+// for resources whose generated names always start with a fixed prefix,
+// followed by any prefix configured at the provider-level.
+func NameWithDefaultPrefixLengthDiff(nameKey, namePrefixKey, defaultNamePrefix string, maxLength int) schema.CustomizeDiffFunc {
+	return nameLengthDiff(nameKey, namePrefixKey, defaultNamePrefix, "", maxLength)
+}
+
+func nameLengthDiff(nameKey, namePrefixKey, defaultNamePrefix, nameSuffix string, maxLength int) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" && !diff.HasChange(nameKey) {
+			return nil
+		}
+
+		config := diff.GetRawConfig()
+
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		// Names that depend on values not yet known are validated when they are.
+		name, namePrefix := config.GetAttr(nameKey), config.GetAttr(namePrefixKey)
+
+		if !name.IsKnown() || !namePrefix.IsKnown() {
+			return nil
+		}
+
+		var n, np string
+
+		if !name.IsNull() {
+			n = name.AsString()
+		}
+
+		if !namePrefix.IsNull() {
+			np = namePrefix.AsString()
+		}
+
+		namingConfig := meta.(*conns.AWSClient).NamingConfig
+
+		if np == "" && defaultNamePrefix != "" {
+			np = defaultNamePrefix + namingConfig.GetPrefix()
+		}
+
+		if err := namingConfig.ValidateNameLength(namingConfig.NameWithSuffix(n, np, nameSuffix), maxLength); err != nil {
+			if n == "" {
+				return fmt.Errorf("generated %s %w", nameKey, err)
+			}
+
+			return fmt.Errorf("%s %w", nameKey, err)
+		}
+
+		return nil
+	}
+}
+
 // SuppressEquivalentTypeStringBoolean provides custom difference suppression for TypeString booleans
 // Some arguments require three values: true, false, and "" (unspecified), but
 // confusing behavior exists when converting bare true/false values with state.
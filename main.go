@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -17,6 +18,8 @@ func main() {
 
 	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
 
+	defer conns.StopAPICallTracing()
+
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
 